@@ -45,6 +45,9 @@ import (
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeClient "github.com/maticnetwork/heimdall/upgrade/client"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -78,7 +81,8 @@ var (
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		gov.NewAppModuleBasic(paramsClient.ProposalHandler, upgradeClient.ProposalHandler, upgradeClient.CancelProposalHandler),
+		upgrade.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -116,6 +120,7 @@ type HeimdallApp struct {
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
 	SlashingKeeper    slashing.Keeper
+	UpgradeKeeper     upgrade.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -179,6 +184,26 @@ func (d ModuleCommunicator) SendCoins(ctx sdk.Context, fromAddr types.HeimdallAd
 	return d.App.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// DividendAccountsChanged marks account root in checkpoint module stale for changed dividend account
+func (d ModuleCommunicator) DividendAccountsChanged(ctx sdk.Context, user types.HeimdallAddress) {
+	d.App.CheckpointKeeper.MarkAccountRootDirty(ctx, user)
+}
+
+// GetDividendAccountByAddress returns dividend account of user
+func (d ModuleCommunicator) GetDividendAccountByAddress(ctx sdk.Context, address types.HeimdallAddress) (types.DividendAccount, error) {
+	return d.App.TopupKeeper.GetDividendAccountByAddress(ctx, address)
+}
+
+// UpdateAccountRoot persists account root in checkpoint module
+func (d ModuleCommunicator) UpdateAccountRoot(ctx sdk.Context) {
+	d.App.CheckpointKeeper.UpdateAccountRoot(ctx)
+}
+
+// GetAccountTreeProof returns dividend account proof from account tree in checkpoint module
+func (d ModuleCommunicator) GetAccountTreeProof(ctx sdk.Context, rootChain string, user types.HeimdallAddress) ([]byte, uint64, error) {
+	return d.App.CheckpointKeeper.GetAccountTreeProof(ctx, rootChain, user)
+}
+
 // Create ValidatorSigningInfo used by slashing module
 func (d ModuleCommunicator) CreateValiatorSigningInfo(ctx sdk.Context, valID types.ValidatorID, valSigningInfo types.ValidatorSigningInfo) {
 	d.App.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, valSigningInfo)
@@ -329,11 +354,19 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	// upgrade keeper, upgrade state is kept in gov store as mounting new store would change app hash
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[govTypes.StoreKey],
+		upgradeTypes.DefaultCodespace,
+	)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -382,8 +415,14 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.ChainKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		moduleCommunicator,
 	)
 
+	// register upgrade handlers
+	app.UpgradeKeeper.SetUpgradeHandler(checkpointTypes.UpgradeName, func(ctx sdk.Context, _ upgradeTypes.Plan) {
+		app.CheckpointKeeper.ActivateUpgrade(ctx)
+	})
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -422,6 +461,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 
 	// register message routes and query routes
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.QueryRouter().AddRoute(upgradeTypes.QuerierRoute, upgrade.NewQuerier(app.UpgradeKeeper))
 
 	// side router
 	app.sideRouter = types.NewSideRouter()
@@ -551,6 +591,9 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 
 // BeginBlocker application updates every begin block
 func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// apply upgrade before modules begin block, so they see migrated state
+	upgrade.BeginBlocker(ctx, app.UpgradeKeeper)
+
 	app.AccountKeeper.SetBlockProposer(
 		ctx,
 		types.BytesToHeimdallAddress(req.Header.GetProposerAddress()),
@@ -620,11 +663,12 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	}
 
 	// end block
-	app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
 
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
+		Events:           res.Events,
 	}
 }
 
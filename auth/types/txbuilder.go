@@ -204,6 +204,19 @@ func (bldr TxBuilder) Sign(privKey secp256k1.PrivKeySecp256k1, msg StdSignMsg) (
 	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
 }
 
+// SignFunc returns signature of keccak256 hash of sign bytes, for keys kept outside of the process
+type SignFunc func(hash []byte) ([]byte, error)
+
+// SignWithFunc signs a transaction with signature returned by sign function
+func (bldr TxBuilder) SignWithFunc(sign SignFunc, msg StdSignMsg) ([]byte, error) {
+	sig, err := sign(crypto.Keccak256(msg.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.txEncoder(NewStdTx(msg.Msg, sig, msg.Memo))
+}
+
 // SignWithPassphrase signs a transaction given a name, passphrase, and a single message to
 // signed. An error is returned if signing fails.
 func (bldr TxBuilder) SignWithPassphrase(name, passphrase string, msg StdSignMsg) ([]byte, error) {
@@ -226,6 +239,17 @@ func (bldr TxBuilder) BuildAndSign(privKey secp256k1.PrivKeySecp256k1, msgs []sd
 	return bldr.Sign(privKey, stdMsg)
 }
 
+// BuildAndSignWithFunc builds a single message to be signed, and signs a transaction
+// with the built message given a sign function and a set of messages.
+func (bldr TxBuilder) BuildAndSignWithFunc(sign SignFunc, msgs []sdk.Msg) ([]byte, error) {
+	stdMsg, err := bldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bldr.SignWithFunc(sign, stdMsg)
+}
+
 // BuildAndSignWithPassphrase builds a single message to be signed, and signs a transaction
 // with the built message given a name, passphrase, and a set of messages.
 func (bldr TxBuilder) BuildAndSignWithPassphrase(name, passphrase string, msgs []sdk.Msg) ([]byte, error) {
@@ -296,6 +320,29 @@ func (bldr TxBuilder) SignStdTx(privKey secp256k1.PrivKeySecp256k1, stdTx StdTx,
 	return
 }
 
+// SignStdTxWithFunc signs a StdTx with signature returned by sign function and returns a copy of it.
+func (bldr TxBuilder) SignStdTxWithFunc(sign SignFunc, stdTx StdTx) (signedStdTx StdTx, err error) {
+	if bldr.chainID == "" {
+		return StdTx{}, fmt.Errorf("chain ID required but not specified")
+	}
+
+	signMsg := StdSignMsg{
+		ChainID:       bldr.chainID,
+		AccountNumber: bldr.accountNumber,
+		Sequence:      bldr.sequence,
+		Memo:          stdTx.Memo,
+		Msg:           stdTx.Msg, // allow only one message
+	}
+
+	sig, err := sign(crypto.Keccak256(signMsg.Bytes()))
+	if err != nil {
+		return
+	}
+
+	signedStdTx = NewStdTx(signMsg.Msg, sig, signMsg.Memo)
+	return
+}
+
 // GetStdTxBytes get tx bytes
 func (bldr TxBuilder) GetStdTxBytes(stdTx StdTx) (result []byte, err error) {
 	return bldr.txEncoder(stdTx)
@@ -69,7 +69,7 @@ func SideHandleMsgSpan(ctx sdk.Context, k Keeper, msg types.MsgProposeSpan, cont
 	}
 
 	// fetch current child block
-	childBlock, err := contractCaller.GetMaticChainBlock(nil)
+	childBlock, err := contractCaller.GetMaticChainBlock(ctx.Context(), nil)
 	if err != nil {
 		k.Logger(ctx).Error("Error fetching current child block", "error", err)
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
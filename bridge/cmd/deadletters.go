@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// deadLettersCmd groups commands on dead-lettered tasks of running bridge
+var deadLettersCmd = &cobra.Command{
+	Use:   "dead-letters",
+	Short: "List and replay dead-lettered bridge tasks",
+}
+
+// listDeadLettersCmd prints dead-lettered tasks
+var listDeadLettersCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List dead-lettered tasks with failure reason, payload and attempts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		deadLetters, err := fetchDeadLetters(helper.GetConfig().BridgeAdminAddr)
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(deadLetters)
+	},
+}
+
+// replayDeadLettersCmd publishes dead-lettered tasks again
+var replayDeadLettersCmd = &cobra.Command{
+	Use:   "replay [uuid...]",
+	Short: "Replay dead-lettered tasks, all of them if no uuid is given",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr := helper.GetConfig().BridgeAdminAddr
+
+		uuids := args
+		if len(uuids) == 0 {
+			deadLetters, err := fetchDeadLetters(addr)
+			if err != nil {
+				return err
+			}
+			for _, deadLetter := range deadLetters {
+				uuids = append(uuids, deadLetter.UUID)
+			}
+		}
+
+		for _, uuid := range uuids {
+			if err := replayDeadLetter(addr, uuid); err != nil {
+				return fmt.Errorf("replaying task %s: %v", uuid, err)
+			}
+			fmt.Println("Replayed task", uuid)
+		}
+		return nil
+	},
+}
+
+// adminClient calls admin endpoint of running bridge
+var adminClient = &http.Client{Timeout: 30 * time.Second}
+
+// fetchDeadLetters reads dead-lettered tasks from admin endpoint of bridge running at addr
+func fetchDeadLetters(addr string) ([]queue.DeadLetter, error) {
+	if addr == "" {
+		return nil, fmt.Errorf("bridge admin endpoint is disabled")
+	}
+
+	resp, err := adminClient.Get(fmt.Sprintf("http://%s/dead-letters", addr))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing dead-lettered tasks failed with status %s", resp.Status)
+	}
+
+	var deadLetters []queue.DeadLetter
+	if err := json.NewDecoder(resp.Body).Decode(&deadLetters); err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// replayDeadLetter asks bridge running at addr to replay dead-lettered task
+func replayDeadLetter(addr string, uuid string) error {
+	if addr == "" {
+		return fmt.Errorf("bridge admin endpoint is disabled")
+	}
+
+	resp, err := adminClient.Post(fmt.Sprintf("http://%s/dead-letters?uuid=%s", addr, url.QueryEscape(uuid)), "application/json", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("status %s: %s", resp.Status, body)
+	}
+	return nil
+}
+
+func init() {
+	deadLettersCmd.AddCommand(listDeadLettersCmd, replayDeadLettersCmd)
+	rootCmd.AddCommand(deadLettersCmd)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// startMetricsServer serves prometheus metrics of bridge listeners and processors on addr
+func startMetricsServer(addr string, logger log.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		logger.Info("Serving bridge metrics", "addr", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Error while serving bridge metrics", "error", err)
+		}
+	}()
+
+	return server
+}
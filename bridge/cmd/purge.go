@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
-	"github.com/spf13/cobra"
-	"github.com/streadway/amqp"
 )
 
 // purgeCmd represents the reset of queue
@@ -17,24 +20,36 @@ var purgeCmd = &cobra.Command{
 	},
 }
 
-func purgeQueue() {
-	var logger = helper.Logger.With("module", "bridge/cmd/")
-	dialer := helper.GetConfig().AmqpURL
+// queueURL returns broker url of configured queue backend
+func queueURL(kind string) string {
+	if kind == queue.BackendNATS {
+		return helper.GetConfig().NatsURL
+	}
+	return helper.GetConfig().AmqpURL
+}
 
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
+// queueDB returns bridge db for embedded queue backend
+func queueDB(kind string) *leveldb.DB {
+	if kind != queue.BackendLevelDB {
+		return nil
 	}
+	return util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))
+}
+
+func purgeQueue() {
+	var logger = helper.Logger.With("module", "bridge/cmd/")
+	kind := helper.GetConfig().BridgeQueue
 
-	// initialize exchange
-	channel, err := conn.Channel()
+	backends, err := queue.NewLaneBackends(kind, queueURL(kind), queueDB(kind))
 	if err != nil {
 		panic(err)
 	}
 
-	if _, err := channel.QueuePurge(queue.QueueName, false); err != nil {
-		logger.Error("purgeQueue | QueuePurge", "Error", err)
+	for i, backend := range backends {
+		if err := backend.Purge(); err != nil {
+			logger.Error("purgeQueue | Purge", "lane", queue.Lanes[i], "Error", err)
+		}
+		backend.Close()
 	}
 }
 
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	yesFlag       = "yes"
+	fromBlockFlag = "from-block"
+	listenerFlag  = "listener"
+)
+
+// purgeDBCmd clears listener state kept in bridge db
+var purgeDBCmd = &cobra.Command{
+	Use:   "purge-db",
+	Short: "Clear listener cursors and dedup records from bridge db, bridge must be stopped",
+	Long: `Clear listener cursors and dedup records from bridge db, so listeners start again from
+configured start blocks and events seen again are published again. Queued tasks are kept,
+use purge-queue to drop them. Bridge must be stopped, as its db can't be opened twice.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openBridgeDB()
+		if err != nil {
+			return err
+		}
+		defer util.CloseBridgeDBInstance()
+
+		fmt.Println("Listener cursors to delete:")
+		for _, name := range listener.CursorNames() {
+			key, _ := listener.CursorKey(name)
+			if value, err := db.Get([]byte(key), nil); err == nil {
+				fmt.Printf("  %s (%s): %s\n", name, key, value)
+			}
+		}
+
+		if ok, err := confirm(cmd, "delete listener cursors, task dedup records and orphaned block marks"); err != nil || !ok {
+			return err
+		}
+
+		return purgeListenerState(db)
+	},
+}
+
+// rebuildDBCmd re-seeds listener state kept in bridge db from block
+var rebuildDBCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Restart listener from block and clear dedup records in bridge db, bridge must be stopped",
+	Long: `Set cursor of listener to block, the way --force-start-block sets it, and clear dedup
+records and orphaned block marks, so events of blocks processed again are published again.
+Bridge must be stopped, as its db can't be opened twice.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString(listenerFlag)
+		if _, ok := listener.CursorKey(name); !ok {
+			return fmt.Errorf("unknown listener %q, expected one of %s", name, strings.Join(listener.CursorNames(), ", "))
+		}
+
+		fromBlock, _ := cmd.Flags().GetUint64(fromBlockFlag)
+		if fromBlock == 0 {
+			return errors.New("--from-block must be greater than 0")
+		}
+
+		db, err := openBridgeDB()
+		if err != nil {
+			return err
+		}
+		defer util.CloseBridgeDBInstance()
+
+		if ok, err := confirm(cmd, fmt.Sprintf("restart %s listener from block %d and delete task dedup records", name, fromBlock)); err != nil || !ok {
+			return err
+		}
+
+		return rebuildListenerState(db, name, fromBlock)
+	},
+}
+
+// openBridgeDB opens bridge db, which fails while running bridge holds it
+func openBridgeDB() (*leveldb.DB, error) {
+	path := viper.GetString(util.BridgeDBFlag)
+	db := util.GetBridgeDBInstance(path)
+	if db == nil {
+		return nil, fmt.Errorf("unable to open bridge db %s, make sure bridge is stopped", path)
+	}
+	return db, nil
+}
+
+// confirm asks operator to confirm action unless --yes is given
+func confirm(cmd *cobra.Command, action string) (bool, error) {
+	if yes, _ := cmd.Flags().GetBool(yesFlag); yes {
+		return true, nil
+	}
+
+	ok, err := input.GetConfirmation(action, bufio.NewReader(os.Stdin))
+	if err == nil && !ok {
+		fmt.Println("Cancelled")
+	}
+	return ok, err
+}
+
+// purgeListenerState deletes listener cursors, task idempotency keys and orphaned block marks
+func purgeListenerState(db *leveldb.DB) error {
+	batch := new(leveldb.Batch)
+	for _, name := range listener.CursorNames() {
+		key, _ := listener.CursorKey(name)
+		batch.Delete([]byte(key))
+	}
+	if err := db.Write(batch, nil); err != nil {
+		return err
+	}
+
+	return clearDedupRecords(db)
+}
+
+// rebuildListenerState sets cursor of listener to block and clears dedup records
+func rebuildListenerState(db *leveldb.DB, name string, fromBlock uint64) error {
+	key, ok := listener.CursorKey(name)
+	if !ok {
+		return fmt.Errorf("unknown listener %q", name)
+	}
+
+	if err := db.Put([]byte(key), []byte(strconv.FormatUint(fromBlock, 10)), nil); err != nil {
+		return err
+	}
+
+	return clearDedupRecords(db)
+}
+
+func clearDedupRecords(db *leveldb.DB) error {
+	taskKeys, err := queue.PurgeTaskKeys(db)
+	if err != nil {
+		return err
+	}
+
+	orphanedBlocks, err := util.PurgeOrphanedBlocks(db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted %d task dedup records and %d orphaned block marks\n", taskKeys, orphanedBlocks)
+	return nil
+}
+
+func init() {
+	purgeDBCmd.Flags().Bool(yesFlag, false, "Skip confirmation prompt")
+
+	rebuildDBCmd.Flags().Bool(yesFlag, false, "Skip confirmation prompt")
+	rebuildDBCmd.Flags().Uint64(fromBlockFlag, 0, "Block listener starts from")
+	rebuildDBCmd.Flags().String(listenerFlag, "", fmt.Sprintf("Listener to restart, one of %s", strings.Join(listener.CursorNames(), ", ")))
+	if err := rebuildDBCmd.MarkFlagRequired(fromBlockFlag); err != nil {
+		panic(err)
+	}
+	if err := rebuildDBCmd.MarkFlagRequired(listenerFlag); err != nil {
+		panic(err)
+	}
+
+	rootCmd.AddCommand(purgeDBCmd)
+	rootCmd.AddCommand(rebuildDBCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+func TestPurgeAndRebuildListenerState(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	seed := func() {
+		for _, name := range listener.CursorNames() {
+			key, _ := listener.CursorKey(name)
+			require.NoError(t, db.Put([]byte(key), []byte("100"), nil))
+		}
+		connector := queue.NewQueueConnector(queue.BackendLevelDB, "", db, 0)
+		_, err := connector.SendTaskOnce(queue.NewTaskKey("StateSynced", "ethereum", "0x01", 3), &tasks.Signature{Name: "sendStateSyncedToHeimdall"})
+		require.NoError(t, err)
+		require.NoError(t, util.MarkBlocksOrphaned(db, []common.Hash{common.HexToHash("0x01")}))
+	}
+
+	seed()
+	require.NoError(t, purgeListenerState(db))
+	for _, name := range listener.CursorNames() {
+		key, _ := listener.CursorKey(name)
+		has, err := db.Has([]byte(key), nil)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	require.False(t, util.IsBlockOrphaned(db, common.HexToHash("0x01")))
+	purged, err := queue.PurgeTaskKeys(db)
+	require.NoError(t, err)
+	require.Equal(t, 0, purged)
+
+	// rebuild moves cursor of listener only
+	seed()
+	require.NoError(t, rebuildListenerState(db, listener.RootChainListenerStr, 42))
+	for _, name := range listener.CursorNames() {
+		key, _ := listener.CursorKey(name)
+		value, err := db.Get([]byte(key), nil)
+		require.NoError(t, err)
+		if name == listener.RootChainListenerStr {
+			require.Equal(t, "42", string(value))
+		} else {
+			require.Equal(t, "100", string(value))
+		}
+	}
+	require.False(t, util.IsBlockOrphaned(db, common.HexToHash("0x01")))
+
+	require.Error(t, rebuildListenerState(db, "unknown", 42))
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// reloadCmd asks running bridge to reload listener config
+var reloadCmd = &cobra.Command{
+	Use:   "reload",
+	Short: "Reload listener config of running bridge",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return reloadBridge(helper.GetConfig().BridgeAdminAddr)
+	},
+}
+
+// reloadBridge calls reload on admin endpoint of bridge running at addr
+func reloadBridge(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("bridge admin endpoint is disabled, send SIGHUP to bridge process instead")
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s/reload", addr), "application/json", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("bridge reload failed with status %s", resp.Status)
+	}
+
+	fmt.Println("Bridge listener config reloaded")
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(reloadCmd)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
@@ -41,19 +42,50 @@ func GetStartCmd() *cobra.Command {
 			// create codec
 			cdc := app.MakeCodec()
 			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+			queueKind := helper.GetConfig().BridgeQueue
+			_queueConnector := queue.NewQueueConnector(queueKind, queueURL(queueKind),
+				util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)), helper.GetConfig().BridgeTaskMaxAttempts)
 			_queueConnector.StartWorker()
 
 			_txBroadcaster := broadcaster.NewTxBroadcaster(cdc)
 			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 			// selected services to start
+			listenerService := listener.NewListenerService(cdc, _queueConnector, _httpClient)
 			services := []common.Service{}
 			services = append(services,
-				listener.NewListenerService(cdc, _queueConnector, _httpClient),
+				listenerService,
 				processor.NewProcessorService(cdc, _queueConnector, _httpClient, _txBroadcaster),
 			)
 
+			// expose metrics for alerting on stalled listeners
+			var metricsServer *http.Server
+			if addr := helper.GetConfig().BridgeMetricsAddr; addr != "" {
+				metricsServer = startMetricsServer(addr, logger)
+			}
+
+			// expose listener, queue and rpc status
+			var adminServer *http.Server
+			if addr := helper.GetConfig().BridgeAdminAddr; addr != "" {
+				adminServer = startAdminServer(addr, &statusHandler{
+					listenerService: listenerService,
+					queueConnector:  _queueConnector,
+					httpClient:      _httpClient,
+				}, logger)
+			}
+
+			// reload listener config on SIGHUP
+			reloadSignal := make(chan os.Signal, 1)
+			signal.Notify(reloadSignal, syscall.SIGHUP)
+			go func() {
+				for range reloadSignal {
+					logger.Info("Received reload signal - Reloading listener config")
+					if err := listenerService.Reload(); err != nil {
+						logger.Error("GetStartCmd | listenerService.Reload", "Error", err)
+					}
+				}
+			}()
+
 			// sync group
 			var wg sync.WaitGroup
 
@@ -71,6 +103,20 @@ func GetStartCmd() *cobra.Command {
 						}
 					}
 
+					// stop metrics server
+					if metricsServer != nil {
+						if err := metricsServer.Close(); err != nil {
+							logger.Error("GetStartCmd | metricsServer.Close", "Error", err)
+						}
+					}
+
+					// stop admin server
+					if adminServer != nil {
+						if err := adminServer.Close(); err != nil {
+							logger.Error("GetStartCmd | adminServer.Close", "Error", err)
+						}
+					}
+
 					// stop http client
 					if err := _httpClient.Stop(); err != nil {
 						logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
@@ -137,6 +183,11 @@ func GetStartCmd() *cobra.Command {
 	if err := viper.BindPFlag("only", startCmd.Flags().Lookup("only")); err != nil {
 		logger.Error("GetStartCmd | BindPFlag | only", "Error", err)
 	}
+
+	startCmd.Flags().StringSlice(listener.ForceStartBlockFlag, []string{}, "comma separated <listener>=<block> pairs overriding persisted listener cursors, e.g. rootchain=1000,tron=2000")
+	if err := viper.BindPFlag(listener.ForceStartBlockFlag, startCmd.Flags().Lookup(listener.ForceStartBlockFlag)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | force-start-block", "Error", err)
+	}
 	return startCmd
 }
 
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/tendermint/tendermint/libs/log"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/listener"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// statusCheckTimeout bounds a single reachability check of status endpoint
+var statusCheckTimeout = 5 * time.Second
+
+// QueueStatus is task queue connectivity reported by status endpoint
+type QueueStatus struct {
+	Connected bool           `json:"connected"`
+	Depth     int            `json:"depth"`
+	Lanes     map[string]int `json:"lanes,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// RPCStatus is reachability of a chain rpc reported by status endpoint
+type RPCStatus struct {
+	Reachable   bool   `json:"reachable"`
+	LatestBlock uint64 `json:"latest_block,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// BridgeStatus is bridge state reported by status endpoint
+type BridgeStatus struct {
+	Listeners []listener.ListenerStatus `json:"listeners"`
+	Queue     QueueStatus               `json:"queue"`
+	RPC       map[string]RPCStatus      `json:"rpc"`
+}
+
+// statusHandler serves bridge state as JSON
+type statusHandler struct {
+	listenerService *listener.ListenerService
+	queueConnector  *queue.QueueConnector
+	httpClient      *httpClient.HTTP
+}
+
+func (sh *statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), statusCheckTimeout)
+	defer cancel()
+
+	status := BridgeStatus{
+		Listeners: sh.listenerService.Status(),
+		RPC: map[string]RPCStatus{
+			"heimdall":   sh.heimdallStatus(),
+			"rootchain":  chainStatus(ctx, helper.GetMainClient()),
+			"bsc":        chainStatus(ctx, helper.GetBscClient()),
+			"maticchain": chainStatus(ctx, helper.GetMaticClient()),
+		},
+	}
+
+	if lanes, err := sh.queueConnector.LaneDepths(); err != nil {
+		status.Queue.Error = err.Error()
+	} else {
+		status.Queue = QueueStatus{Connected: true, Lanes: lanes}
+		for _, depth := range lanes {
+			status.Queue.Depth += depth
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// heimdallStatus returns reachability of heimdall rpc
+func (sh *statusHandler) heimdallStatus() RPCStatus {
+	result, err := sh.httpClient.Status()
+	if err != nil {
+		return RPCStatus{Error: err.Error()}
+	}
+	return RPCStatus{Reachable: true, LatestBlock: uint64(result.SyncInfo.LatestBlockHeight)}
+}
+
+// chainStatus returns reachability of chain rpc by fetching its latest header
+func chainStatus(ctx context.Context, client *ethclient.Client) RPCStatus {
+	if client == nil {
+		return RPCStatus{Error: "rpc client not configured"}
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return RPCStatus{Error: err.Error()}
+	}
+	return RPCStatus{Reachable: true, LatestBlock: header.Number.Uint64()}
+}
+
+// reloadHandler reloads listener config on POST
+type reloadHandler struct {
+	listenerService *listener.ListenerService
+}
+
+func (rh *reloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := rh.listenerService.Reload(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deadLettersHandler lists dead-lettered tasks on GET and replays one on POST with uuid query param
+type deadLettersHandler struct {
+	queueConnector *queue.QueueConnector
+}
+
+func (dh *deadLettersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		deadLetters, err := dh.queueConnector.DeadLetters()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(deadLetters)
+	case http.MethodPost:
+		err := dh.queueConnector.ReplayDeadLetter(r.URL.Query().Get("uuid"))
+		if err == queue.ErrDeadLetterNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// tasksHandler lists tasks on GET, shows one with uuid query param, and requeues one on POST with
+// uuid query param
+type tasksHandler struct {
+	queueConnector *queue.QueueConnector
+}
+
+func (th *tasksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	uuid := r.URL.Query().Get("uuid")
+
+	var (
+		result interface{}
+		err    error
+	)
+	switch {
+	case r.Method == http.MethodGet && uuid == "":
+		result, err = th.queueConnector.Tasks()
+	case r.Method == http.MethodGet:
+		result, err = th.queueConnector.Task(uuid)
+	case r.Method == http.MethodPost:
+		err = th.queueConnector.RequeueTask(uuid)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch err {
+	case nil:
+	case queue.ErrTaskNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case queue.ErrTaskInFlight:
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// startAdminServer serves bridge status, config reload, tasks and dead-lettered tasks on addr
+func startAdminServer(addr string, handler *statusHandler, logger log.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/status", handler)
+	mux.Handle("/reload", &reloadHandler{listenerService: handler.listenerService})
+	mux.Handle("/dead-letters", &deadLettersHandler{queueConnector: handler.queueConnector})
+	mux.Handle("/tasks", &tasksHandler{queueConnector: handler.queueConnector})
+
+	server := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		logger.Info("Serving bridge admin endpoint", "addr", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Error while serving bridge admin endpoint", "error", err)
+		}
+	}()
+
+	return server
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const stateFlag = "state"
+
+// tasksCmd groups commands inspecting tasks of running bridge
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Inspect and requeue pending, in-flight and failed bridge tasks",
+}
+
+// listTasksCmd prints tasks of running bridge
+var listTasksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending, in-flight and failed tasks with age, attempts and retries left",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var list queue.TaskList
+		if err := getTasks(helper.GetConfig().BridgeAdminAddr, "", &list); err != nil {
+			return err
+		}
+
+		state, _ := cmd.Flags().GetString(stateFlag)
+		now := time.Now()
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "UUID\tTASK\tSTATE\tLANE\tATTEMPTS\tRETRIES LEFT\tAGE")
+		for _, task := range list.Tasks {
+			if state != "" && task.State != state {
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%s\n", task.UUID, task.TaskName, task.State, task.Lane, task.Attempts, task.RetryCount, taskAge(task, now))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		for lane, depth := range list.Unlisted {
+			fmt.Printf("%d tasks queued in %s lane are not listed, queue backend cannot list them\n", depth, lane)
+		}
+		return nil
+	},
+}
+
+// showTaskCmd prints task of running bridge with its payload
+var showTaskCmd = &cobra.Command{
+	Use:   "show [uuid]",
+	Short: "Show task with its payload",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var task queue.TaskInfo
+		if err := getTasks(helper.GetConfig().BridgeAdminAddr, args[0], &task); err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(task)
+	},
+}
+
+// requeueTaskCmd replays failed tasks and runs tasks waiting for retry right away
+var requeueTaskCmd = &cobra.Command{
+	Use:   "requeue [uuid...]",
+	Short: "Replay failed tasks and run tasks waiting for retry right away",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr := helper.GetConfig().BridgeAdminAddr
+		for _, uuid := range args {
+			if err := requeueTask(addr, uuid); err != nil {
+				return fmt.Errorf("requeueing task %s: %v", uuid, err)
+			}
+			fmt.Println("Requeued task", uuid)
+		}
+		return nil
+	},
+}
+
+// taskAge returns time since task was first published, or since it failed if publish time is unknown
+func taskAge(task queue.TaskInfo, now time.Time) string {
+	since := task.PublishedAt
+	if since == nil {
+		since = task.FailedAt
+	}
+	if since == nil {
+		return "-"
+	}
+	return now.Sub(*since).Truncate(time.Second).String()
+}
+
+// getTasks reads tasks, or task with uuid if given, from admin endpoint of bridge running at addr
+func getTasks(addr string, uuid string, result interface{}) error {
+	if addr == "" {
+		return fmt.Errorf("bridge admin endpoint is disabled")
+	}
+
+	endpoint := fmt.Sprintf("http://%s/tasks", addr)
+	if uuid != "" {
+		endpoint += "?uuid=" + url.QueryEscape(uuid)
+	}
+
+	resp, err := adminClient.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("status %s: %s", resp.Status, body)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// requeueTask asks bridge running at addr to requeue task
+func requeueTask(addr string, uuid string) error {
+	if addr == "" {
+		return fmt.Errorf("bridge admin endpoint is disabled")
+	}
+
+	resp, err := adminClient.Post(fmt.Sprintf("http://%s/tasks?uuid=%s", addr, url.QueryEscape(uuid)), "application/json", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("status %s: %s", resp.Status, body)
+	}
+	return nil
+}
+
+func init() {
+	listTasksCmd.Flags().String(stateFlag, "", fmt.Sprintf("Only list tasks in state, one of %s, %s, %s", queue.TaskStatePending, queue.TaskStateInFlight, queue.TaskStateFailed))
+
+	tasksCmd.AddCommand(listTasksCmd, showTaskCmd, requeueTaskCmd)
+	rootCmd.AddCommand(tasksCmd)
+}
@@ -0,0 +1,84 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// errBackfillPaused is returned by block batch processors to stop range processing early,
+// e.g. while heimdall is busy. Remaining blocks are picked up from cursor later.
+var errBackfillPaused = errors.New("block range processing paused")
+
+// BlockBatchProcessor is implemented by listeners which process a whole block range at once,
+// e.g. with a single log query, instead of header by header
+type BlockBatchProcessor interface {
+	// ProcessBlockBatch processes blocks from..to inclusive and moves cursor to `to`
+	ProcessBlockBatch(from uint64, to uint64) error
+
+	// BlockBatchSize is max number of blocks in one batch, 0 processes range in one batch
+	BlockBatchSize() uint64
+}
+
+// ProcessBlockRange processes blocks start..end inclusive in batches, so a listener far behind
+// catches up with paged queries. Listeners without batch support process headers one by one.
+func (bl *BaseListener) ProcessBlockRange(start uint64, end uint64) error {
+	if end < start {
+		return fmt.Errorf("invalid block range %d..%d", start, end)
+	}
+
+	processor, ok := bl.impl.(BlockBatchProcessor)
+	if !ok {
+		return bl.processHeaderRange(start, end)
+	}
+
+	batchSize := processor.BlockBatchSize()
+	for from := start; from <= end; {
+		to := end
+		if batchSize != 0 && end-from >= batchSize {
+			to = from + batchSize - 1
+		}
+
+		if err := processor.ProcessBlockBatch(from, to); errors.Is(err, errBackfillPaused) {
+			bl.Logger.Info("Block range processing paused", "processedUpTo", from-1, "end", end)
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if to == end {
+			break
+		}
+		from = to + 1
+	}
+
+	return nil
+}
+
+// processHeaderRange fetches headers start..end and processes them one by one
+func (bl *BaseListener) processHeaderRange(start uint64, end uint64) error {
+	client := bl.client()
+	if client == nil {
+		return fmt.Errorf("listener %s does not support block range processing", bl.name)
+	}
+
+	for number := start; number <= end; number++ {
+		start := time.Now()
+		header, err := client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+		bl.observeRPC("HeaderByNumber", start, err)
+		if err != nil {
+			bl.endpointFailed(client, err)
+			return NewRetriableError(err)
+		}
+
+		bl.processHeader(context.Background(), header)
+
+		if number == end {
+			break
+		}
+	}
+
+	return nil
+}
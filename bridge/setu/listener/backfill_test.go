@@ -0,0 +1,90 @@
+package listener
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// batchTestListener records block batches it is asked to process
+type batchTestListener struct {
+	testListener
+
+	batchSize uint64
+	batches   [][2]uint64
+	pauseAt   uint64
+	failAt    uint64
+}
+
+func (bl *batchTestListener) BlockBatchSize() uint64 {
+	return bl.batchSize
+}
+
+func (bl *batchTestListener) ProcessBlockBatch(from uint64, to uint64) error {
+	if bl.pauseAt != 0 && from >= bl.pauseAt {
+		return errBackfillPaused
+	}
+	if bl.failAt != 0 && from >= bl.failAt {
+		return NewRetriableError(errors.New("logs unavailable"))
+	}
+	bl.batches = append(bl.batches, [2]uint64{from, to})
+	return nil
+}
+
+func newBatchTestListener(batchSize uint64) *batchTestListener {
+	bl := &batchTestListener{batchSize: batchSize}
+	bl.testListener = *newTestListener()
+	bl.impl = bl
+	return bl
+}
+
+func TestProcessBlockRangeBatches(t *testing.T) {
+	bl := newBatchTestListener(100)
+	require.NoError(t, bl.ProcessBlockRange(1, 250))
+	require.Equal(t, [][2]uint64{{1, 100}, {101, 200}, {201, 250}}, bl.batches)
+
+	// range fitting one batch
+	bl = newBatchTestListener(100)
+	require.NoError(t, bl.ProcessBlockRange(7, 7))
+	require.Equal(t, [][2]uint64{{7, 7}}, bl.batches)
+
+	// zero batch size processes whole range at once
+	bl = newBatchTestListener(0)
+	require.NoError(t, bl.ProcessBlockRange(1, 1000))
+	require.Equal(t, [][2]uint64{{1, 1000}}, bl.batches)
+
+	require.Error(t, bl.ProcessBlockRange(10, 9))
+}
+
+func TestProcessBlockRangeStops(t *testing.T) {
+	// paused processing is not an error, remaining blocks are left for later
+	bl := newBatchTestListener(10)
+	bl.pauseAt = 21
+	require.NoError(t, bl.ProcessBlockRange(1, 50))
+	require.Equal(t, [][2]uint64{{1, 10}, {11, 20}}, bl.batches)
+
+	// failures are returned so header is retried from cursor
+	bl = newBatchTestListener(10)
+	bl.failAt = 11
+	err := bl.ProcessBlockRange(1, 50)
+	require.True(t, IsRetriableError(err))
+	require.Equal(t, [][2]uint64{{1, 10}}, bl.batches)
+}
+
+func TestProcessBlockRangeHeaderByHeader(t *testing.T) {
+	client := &fakeChainClient{}
+	tl := newTestListener()
+	tl.endpoints = newTestEndpointPool(client)
+
+	require.NoError(t, tl.ProcessBlockRange(5, 8))
+	require.Len(t, tl.processed, 4)
+	require.Equal(t, 4, client.calls)
+
+	// fetch errors are retriable
+	client.setErr(errors.New("connection refused"))
+	require.True(t, IsRetriableError(tl.ProcessBlockRange(9, 10)))
+
+	// listener without rpc endpoints cannot process ranges
+	require.Error(t, newTestListener().ProcessBlockRange(1, 2))
+}
@@ -2,8 +2,10 @@ package listener
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,11 +37,24 @@ type Listener interface {
 
 	ProcessHeader(*types.Header)
 
+	ProcessBlockRange(start uint64, end uint64) error
+
+	Status() ListenerStatus
+
+	OnReorg(oldHead *types.Header, newHead *types.Header)
+
 	Stop()
 
 	String() string
 }
 
+// ConcurrentHeaderProcessor is implemented by listeners whose ProcessHeader is safe to run
+// concurrently. Other listeners read and commit block cursor per header, so overlapping
+// calls would query same range twice, they always process headers sequentially.
+type ConcurrentHeaderProcessor interface {
+	ConcurrentHeaderProcessing() bool
+}
+
 type BaseListener struct {
 	Logger log.Logger
 	name   string
@@ -51,7 +66,8 @@ type BaseListener struct {
 	// contract caller
 	contractConnector helper.ContractCaller
 
-	chainClient *ethclient.Client
+	// rpc endpoints of chain, primary first then fallbacks
+	endpoints *endpointPool
 
 	// header channel
 	HeaderChannel chan *types.Header
@@ -73,8 +89,68 @@ type BaseListener struct {
 
 	// storage client
 	storageClient *leveldb.DB
+
+	// queue depth reader used for backpressure
+	queueDepth func() (int, error)
+
+	// set to 1 while header forwarding is paused by backpressure
+	backpressurePaused int32
+
+	// set to 1 when listener halted on too deep reorg
+	halted int32
+
+	// collapses repeated errors in polling and subscription
+	errorLimiter *errorLogLimiter
+
+	// serializes last block commits when headers are processed concurrently
+	commitMu *sync.Mutex
+
+	// latest block reader for listener state keys
+	getListenerTip func(key string) (uint64, error)
+
+	// re-subscribes new heads after recoverable subscription error
+	subscribeNewHead func(ctx context.Context) (ethereum.Subscription, error)
+
+	// polls new headers after subscription could not be recovered, nil tears listener down instead
+	pollFallback func(ctx context.Context)
+
+	// classifies subscription errors as recoverable or fatal
+	subscriptionErrorClassifier SubscriptionErrorClassifier
+
+	// broadcasts delivered headers to additional subscribers
+	fanout *headerFanout
+
+	// slows header intake when downstream confirmation latency is high
+	latency *latencyThrottle
+
+	// recently seen header hashes, drops identical headers delivered twice
+	seenHeaders *headerDeduper
+
+	// recently received headers by hash, detects reorgs on parent hash mismatch
+	headerChain *headerChain
+
+	// blocks a header must be below head before it is dispatched, 0 dispatches head
+	requiredConfirmations uint64
+
+	// last block dispatched with required confirmations
+	lastConfirmed uint64
+
+	// number of header loops running, previous loop may overlap new one briefly on restart
+	running int32
+
+	// unix nano time last header was received
+	lastHeaderAt int64
+
+	// last block processed by listener
+	lastProcessed uint64
+
+	// reloadable settings listener was last started with
+	settings listenerSettings
 }
 
+// backpressureCheckInterval is how often a paused listener re-reads queue depth
+var backpressureCheckInterval = 5 * time.Second
+
 // NewBaseListener creates a new BaseListener.
 func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, httpClient *httpClient.HTTP, chainClient *ethclient.Client, name string, impl Listener) *BaseListener {
 
@@ -90,7 +166,7 @@ func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, htt
 	cliCtx.TrustNode = true
 
 	// creating syncer object
-	return &BaseListener{
+	bl := &BaseListener{
 		Logger:        logger,
 		name:          name,
 		quit:          make(chan struct{}),
@@ -101,10 +177,50 @@ func NewBaseListener(cdc *codec.Codec, queueConnector *queue.QueueConnector, htt
 		queueConnector:    queueConnector,
 		httpClient:        httpClient,
 		contractConnector: contractCaller,
-		chainClient:       chainClient,
+		endpoints:         newEndpointPool(logger),
 
 		HeaderChannel: make(chan *types.Header),
+
+		errorLimiter: &errorLogLimiter{},
+		commitMu:     &sync.Mutex{},
+		fanout:       newHeaderFanout(helper.GetConfig().HeaderFanoutBuffer),
+		latency:      newLatencyThrottle(),
+		seenHeaders:  newHeaderDeduper(),
+		headerChain:  newHeaderChain(),
+
+		requiredConfirmations: requiredConfirmations(name),
+
+		settings: currentListenerSettings(name),
+	}
+
+	// fail fast on unusable storage
+	if err := probeStorage(bl.storageClient); err != nil {
+		logger.Error("Bridge storage is not usable", "error", err)
+		panic(err)
+	}
+
+	if queueConnector != nil {
+		bl.queueDepth = queueConnector.QueueDepth
 	}
+	bl.getListenerTip = bl.listenerTip
+	if chainClient != nil {
+		bl.endpoints.add("primary", chainClient)
+		bl.endpoints.addFallbackEndpoints(fallbackRPCUrls(name))
+
+		// re-subscribe on active endpoint, previous one is failed over on error
+		headerChannel := bl.HeaderChannel
+		endpoints := bl.endpoints
+		bl.subscribeNewHead = func(ctx context.Context) (ethereum.Subscription, error) {
+			client := endpoints.client()
+			subscription, err := client.SubscribeNewHead(ctx, headerChannel)
+			if err != nil {
+				endpoints.markFailed(client, err)
+			}
+			return subscription, err
+		}
+	}
+
+	return bl
 }
 
 // // Start starts new block subscription
@@ -144,11 +260,94 @@ func (bl *BaseListener) String() string {
 
 // startHeaderProcess starts header process when they get new header
 func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
-	bl.Logger.Info("Starting header process")
+	workers := helper.GetConfig().HeaderProcessWorkers
+	if processor, ok := bl.impl.(ConcurrentHeaderProcessor); workers > 1 && (!ok || !processor.ConcurrentHeaderProcessing()) {
+		bl.Logger.Info("Listener is not safe for concurrent header processing, using single worker", "workers", workers)
+		workers = 1
+	}
+	bl.Logger.Info("Starting header process", "workers", workers)
+
+	atomic.AddInt32(&bl.running, 1)
+	defer atomic.AddInt32(&bl.running, -1)
+
+	processHeader := func(header *types.Header) {
+		bl.processHeader(ctx, header)
+	}
+	if workers > 1 {
+		var wg sync.WaitGroup
+		queues := make([]chan *types.Header, workers)
+		for i := range queues {
+			queues[i] = make(chan *types.Header)
+			wg.Add(1)
+			go func(queue chan *types.Header) {
+				defer wg.Done()
+				for header := range queue {
+					bl.processHeader(ctx, header)
+				}
+			}(queues[i])
+		}
+
+		// stop workers after they finish headers in progress
+		defer func() {
+			for _, queue := range queues {
+				close(queue)
+			}
+			wg.Wait()
+		}()
+
+		// headers with same block number always go to the same worker
+		processHeader = func(header *types.Header) {
+			var number uint64
+			if header.Number != nil {
+				number = header.Number.Uint64()
+			}
+			queues[number%uint64(workers)] <- header
+		}
+	}
+
 	for {
 		select {
-		case newHeader := <-bl.HeaderChannel:
-			bl.impl.ProcessHeader(newHeader)
+		case newHeader, ok := <-bl.HeaderChannel:
+			if !ok {
+				bl.Logger.Info("Header channel closed, header process stopped")
+				return
+			}
+
+			if newHeader == nil {
+				bl.Logger.Error("Received nil header, skipping")
+				continue
+			}
+			bl.headerReceived()
+
+			// same header may arrive from both subscription and polling
+			if bl.isDuplicateHeader(newHeader) {
+				continue
+			}
+
+			// invalidate work derived from blocks orphaned by reorg
+			bl.detectReorg(newHeader)
+
+			// broadcast to fan-out subscribers, never blocks
+			bl.publishHeader(newHeader)
+
+			if bl.IsHalted() {
+				bl.Logger.Debug("Listener halted, skipping header", "blockNumber", newHeader.Number)
+				continue
+			}
+
+			if !bl.waitForQueueDrain(ctx) || !bl.waitForLatencyThrottle(ctx) {
+				bl.Logger.Info("Header process stopped")
+				return
+			}
+
+			// wait until header is deep enough to be safe from reorgs
+			confirmedHeader, ok := bl.confirmedHeader(ctx, newHeader)
+			if !ok {
+				continue
+			}
+
+			bl.markHeaderProcessed(confirmedHeader)
+			processHeader(confirmedHeader)
 		case <-ctx.Done():
 			bl.Logger.Info("Header process stopped")
 			return
@@ -156,10 +355,94 @@ func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 	}
 }
 
+// IsHalted returns true if listener stopped processing headers pending operator intervention
+func (bl *BaseListener) IsHalted() bool {
+	return atomic.LoadInt32(&bl.halted) == 1
+}
+
+// checkReorgDepth returns false and halts listener if chain tip moved back
+// from last processed block by more than max reorg depth
+func (bl *BaseListener) checkReorgDepth(lastBlock uint64, newBlock uint64) bool {
+	maxReorgDepth := helper.GetConfig().MaxReorgDepth
+	if maxReorgDepth == 0 || lastBlock <= newBlock || lastBlock-newBlock <= maxReorgDepth {
+		return true
+	}
+
+	atomic.StoreInt32(&bl.halted, 1)
+	bl.Logger.Error("CRITICAL: reorg deeper than max reorg depth, listener halted. Operator intervention required",
+		"lastBlock", lastBlock, "newBlock", newBlock, "depth", lastBlock-newBlock, "maxReorgDepth", maxReorgDepth)
+	return false
+}
+
+// IsBackpressurePaused returns true while header forwarding is paused because task queue is too deep
+func (bl *BaseListener) IsBackpressurePaused() bool {
+	return atomic.LoadInt32(&bl.backpressurePaused) == 1
+}
+
+// waitForQueueDrain blocks while task queue depth is above high water mark,
+// until it drains below low water mark. Returns false if ctx is done while waiting.
+func (bl *BaseListener) waitForQueueDrain(ctx context.Context) bool {
+	highWaterMark := helper.GetConfig().QueueHighWaterMark
+	lowWaterMark := helper.GetConfig().QueueLowWaterMark
+	if bl.queueDepth == nil || highWaterMark <= 0 {
+		return true
+	}
+
+	// listener paused with low water mark out of range would never resume
+	if lowWaterMark <= 0 || lowWaterMark >= highWaterMark {
+		bl.logErrorRateLimited("Invalid queue water marks, backpressure disabled",
+			fmt.Errorf("low water mark %d must be above 0 and below high water mark %d", lowWaterMark, highWaterMark))
+		return true
+	}
+
+	depth, err := bl.queueDepth()
+	if err != nil {
+		bl.Logger.Error("Error while fetching queue depth", "error", err)
+		return true
+	}
+
+	if depth < highWaterMark {
+		return true
+	}
+
+	pausedAt := time.Now()
+	atomic.StoreInt32(&bl.backpressurePaused, 1)
+	defer atomic.StoreInt32(&bl.backpressurePaused, 0)
+
+	bl.Logger.Info("Queue depth above high water mark, pausing header forwarding",
+		"queueDepth", depth, "highWaterMark", highWaterMark, "lowWaterMark", lowWaterMark)
+
+	ticker := time.NewTicker(backpressureCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			depth, err = bl.queueDepth()
+			if err != nil {
+				bl.Logger.Error("Error while fetching queue depth", "error", err)
+				continue
+			}
+
+			if depth < lowWaterMark {
+				bl.Logger.Info("Queue depth below low water mark, resuming header forwarding",
+					"queueDepth", depth, "lowWaterMark", lowWaterMark, "pausedFor", time.Since(pausedAt))
+				return true
+			}
+
+			bl.Logger.Debug("Header forwarding still paused", "queueDepth", depth, "lowWaterMark", lowWaterMark)
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
 // startPolling starts polling
 // needAlign is used to decide whether the ticker is align to 1970 UTC.
 // if true, the ticker will always tick as it begins at 1970 UTC.
 func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Duration, needAlign bool) {
+	bl.setHeaderSource(headerSourcePolling)
+
 	// How often to fire the passed in function in second
 	interval := pollInterval
 	firstInterval := interval
@@ -182,8 +465,14 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 				ticker.Reset(interval)
 			})
 
-			header, err := bl.chainClient.HeaderByNumber(ctx, nil)
-			if err == nil && header != nil {
+			client := bl.client()
+			start := time.Now()
+			header, err := client.HeaderByNumber(ctx, nil)
+			bl.observeRPC("HeaderByNumber", start, err)
+			if err != nil {
+				bl.logErrorRateLimited("Error while fetching latest header", err)
+				bl.endpointFailed(client, err)
+			} else if header != nil {
 				// send data to channel
 				bl.HeaderChannel <- header
 			}
@@ -197,11 +486,28 @@ func (bl *BaseListener) StartPolling(ctx context.Context, pollInterval time.Dura
 }
 
 func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethereum.Subscription) {
+	bl.setHeaderSource(headerSourceSubscription)
 	for {
 		select {
 		case err := <-subscription.Err():
+			bl.logErrorRateLimited("Error while subscribing new blocks", err)
+
+			subscription.Unsubscribe()
+
+			// try to keep listening on recoverable errors
+			if bl.subscriptionErrorRecoverable(err) {
+				if newSubscription, ok := bl.resubscribe(ctx); ok {
+					subscription = newSubscription
+					continue
+				}
+			}
+
+			// keep receiving headers by polling
+			if bl.fallBackToPolling(ctx) {
+				return
+			}
+
 			// stop service
-			bl.Logger.Error("Error while subscribing new blocks", "error", err)
 			// bl.Stop()
 
 			// cancel subscription
@@ -218,14 +524,23 @@ func (bl *BaseListener) StartSubscription(ctx context.Context, subscription ethe
 
 // OnStop stops all necessary go routines
 func (bl *BaseListener) Stop() {
+	bl.stopListening()
 
+	// stop header fan-out
+	bl.stopFanout()
+}
+
+// stopListening stops subscription, polling and header process, listener can be started again
+func (bl *BaseListener) stopListening() {
 	// cancel subscription if any
 	if bl.cancelSubscription != nil {
 		bl.cancelSubscription()
 	}
 
 	// cancel header process
-	bl.cancelHeaderProcess()
+	if bl.cancelHeaderProcess != nil {
+		bl.cancelHeaderProcess()
+	}
 }
 
 func (bl *BaseListener) setStartListenBLock(StartBlock uint64, key string) error {
@@ -238,3 +553,25 @@ func (bl *BaseListener) setStartListenBLock(StartBlock uint64, key string) error
 	}
 	return nil
 }
+
+// commitLastBlock stores last processed block for key. With concurrent header
+// processing commits are serialized and never move stored block backwards.
+func (bl *BaseListener) commitLastBlock(key string, block *big.Int) error {
+	bl.commitMu.Lock()
+	defer bl.commitMu.Unlock()
+
+	if helper.GetConfig().HeaderProcessWorkers > 1 {
+		if lastBlockBytes, err := bl.storageClient.Get([]byte(key), nil); err == nil {
+			if lastBlock, ok := big.NewInt(0).SetString(string(lastBlockBytes), 10); ok && lastBlock.Cmp(block) >= 0 {
+				return nil
+			}
+		}
+	}
+
+	if err := bl.storageClient.Put([]byte(key), []byte(block.String()), nil); err != nil {
+		return err
+	}
+
+	bl.setLastProcessedBlock(block.Uint64())
+	return nil
+}
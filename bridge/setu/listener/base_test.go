@@ -0,0 +1,290 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// testListener records headers passed to ProcessHeader
+type testListener struct {
+	BaseListener
+
+	mu         sync.Mutex
+	processed  []*types.Header
+	concurrent bool
+	inFlight   int32
+	maxFlight  int32
+}
+
+func (tl *testListener) ConcurrentHeaderProcessing() bool {
+	return tl.concurrent
+}
+
+func (tl *testListener) Start() error {
+	return nil
+}
+
+func (tl *testListener) ProcessHeader(header *types.Header) {
+	// panics on nil header, same as concrete listeners
+	_ = header.Number.Uint64()
+
+	inFlight := atomic.AddInt32(&tl.inFlight, 1)
+	defer atomic.AddInt32(&tl.inFlight, -1)
+
+	tl.mu.Lock()
+	if inFlight > tl.maxFlight {
+		tl.maxFlight = inFlight
+	}
+	tl.processed = append(tl.processed, header)
+	tl.mu.Unlock()
+
+	// give other workers a chance to overlap
+	time.Sleep(time.Millisecond)
+}
+
+func newTestListener() *testListener {
+	tl := &testListener{}
+	tl.BaseListener = BaseListener{
+		Logger:        log.NewNopLogger(),
+		name:          "test",
+		quit:          make(chan struct{}),
+		impl:          tl,
+		HeaderChannel: make(chan *types.Header),
+		errorLimiter:  &errorLogLimiter{},
+		commitMu:      &sync.Mutex{},
+		fanout:        newHeaderFanout(0),
+	}
+
+	return tl
+}
+
+func TestStartHeaderProcessSkipsNilHeader(t *testing.T) {
+	tl := newTestListener()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NotPanics(t, func() { tl.StartHeaderProcess(context.Background()) })
+	}()
+
+	tl.HeaderChannel <- nil
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(1)}
+
+	// closed channel stops header process
+	close(tl.HeaderChannel)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("header process did not stop on closed channel")
+	}
+
+	require.Len(t, tl.processed, 1)
+	require.Equal(t, uint64(1), tl.processed[0].Number.Uint64())
+}
+
+func TestWaitForQueueDrain(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.QueueHighWaterMark = 10
+	conf.QueueLowWaterMark = 5
+	helper.SetTestConfig(conf)
+
+	backpressureCheckInterval = 10 * time.Millisecond
+
+	tl := newTestListener()
+	depths := []int{12, 8, 4}
+	tl.queueDepth = func() (int, error) {
+		depth := depths[0]
+		if len(depths) > 1 {
+			depths = depths[1:]
+		}
+		return depth, nil
+	}
+
+	require.True(t, tl.waitForQueueDrain(context.Background()))
+	require.False(t, tl.IsBackpressurePaused())
+	require.Equal(t, []int{4}, depths, "listener should wait until depth is below low water mark")
+
+	// cancelled context while paused
+	depths = []int{20}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.False(t, tl.waitForQueueDrain(ctx))
+
+	// below high water mark passes immediately
+	depths = []int{9}
+	require.True(t, tl.waitForQueueDrain(context.Background()))
+
+	// low water mark out of range disables backpressure instead of pausing forever
+	for _, lowWaterMark := range []int{0, 10, 15} {
+		conf.QueueLowWaterMark = lowWaterMark
+		helper.SetTestConfig(conf)
+
+		depths = []int{20, 1}
+		require.True(t, tl.waitForQueueDrain(context.Background()), "low water mark %d", lowWaterMark)
+		require.Equal(t, []int{20, 1}, depths, "queue depth should not be read")
+	}
+}
+
+func TestCheckReorgDepth(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.MaxReorgDepth = 10
+	helper.SetTestConfig(conf)
+
+	tl := newTestListener()
+
+	// chain moving forward or shallow reorg
+	require.True(t, tl.checkReorgDepth(100, 120))
+	require.True(t, tl.checkReorgDepth(100, 90))
+	require.False(t, tl.IsHalted())
+
+	// deep reorg halts listener
+	require.False(t, tl.checkReorgDepth(100, 89))
+	require.True(t, tl.IsHalted())
+
+	// halted listener skips headers
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tl.StartHeaderProcess(context.Background())
+	}()
+
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(1)}
+	close(tl.HeaderChannel)
+	<-done
+
+	require.Empty(t, tl.processed)
+}
+
+// countingLogger counts error logs
+type countingLogger struct {
+	log.Logger
+
+	errors []string
+}
+
+func (cl *countingLogger) Error(msg string, keyvals ...interface{}) {
+	cl.errors = append(cl.errors, msg)
+}
+
+func TestLogErrorRateLimited(t *testing.T) {
+	errorLogWindow = 50 * time.Millisecond
+
+	tl := newTestListener()
+	logger := &countingLogger{Logger: log.NewNopLogger()}
+	tl.Logger = logger
+
+	err := errors.New("connection refused")
+
+	// first occurrence logs immediately, identical ones are suppressed
+	tl.logErrorRateLimited("Error while fetching latest header", err)
+	tl.logErrorRateLimited("Error while fetching latest header", err)
+	tl.logErrorRateLimited("Error while fetching latest header", err)
+	require.Len(t, logger.errors, 1)
+
+	// different error is not suppressed
+	tl.logErrorRateLimited("Error while subscribing new blocks", err)
+	require.Len(t, logger.errors, 2)
+
+	// after window summary and new occurrence are logged
+	time.Sleep(60 * time.Millisecond)
+	tl.logErrorRateLimited("Error while fetching latest header", err)
+	require.Len(t, logger.errors, 4)
+	require.Contains(t, logger.errors[2], "2 occurrences of")
+
+	// expired entries are evicted, summary of error that doesn't recur is flushed
+	tl.logErrorRateLimited("Error while subscribing new blocks", err)
+	tl.logErrorRateLimited("Error while subscribing new blocks", err)
+	require.Len(t, logger.errors, 5)
+	require.Len(t, tl.errorLimiter.entries, 2)
+
+	time.Sleep(60 * time.Millisecond)
+	tl.logErrorRateLimited("Error while fetching header 5", err)
+	require.Len(t, logger.errors, 7)
+	require.Len(t, tl.errorLimiter.entries, 1)
+	require.Equal(t, fmt.Sprintf("1 occurrences of %q in the last %s", "Error while subscribing new blocks: connection refused", errorLogWindow), logger.errors[5])
+}
+
+func TestStartHeaderProcessWorkers(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.HeaderProcessWorkers = 3
+	helper.SetTestConfig(conf)
+	defer helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	run := func(concurrent bool) *testListener {
+		tl := newTestListener()
+		tl.concurrent = concurrent
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			tl.StartHeaderProcess(context.Background())
+		}()
+
+		for i := int64(1); i <= 10; i++ {
+			tl.HeaderChannel <- &types.Header{Number: big.NewInt(i)}
+		}
+
+		// closed channel drains and stops all workers
+		close(tl.HeaderChannel)
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("header process did not stop")
+		}
+
+		require.Len(t, tl.processed, 10)
+		return tl
+	}
+
+	tl := run(true)
+	require.Greater(t, tl.maxFlight, int32(1), "concurrency safe listener should use workers")
+
+	// listener not opted in keeps strictly sequential order
+	tl = run(false)
+	require.Equal(t, int32(1), tl.maxFlight)
+	for i, header := range tl.processed {
+		require.Equal(t, int64(i+1), header.Number.Int64())
+	}
+}
+
+func TestStartHeaderProcessWaitsForConfirmations(t *testing.T) {
+	tl := newTestListener()
+	tl.endpoints = newTestEndpointPool(&numberedChainClient{})
+	tl.requiredConfirmations = 3
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tl.StartHeaderProcess(ctx)
+
+	// heads below confirmation depth, repeated and out of order heads dispatch nothing new
+	for _, number := range []int64{2, 5, 6, 6, 4, 10} {
+		tl.HeaderChannel <- &types.Header{Number: big.NewInt(number)}
+	}
+
+	require.Eventually(t, func() bool {
+		tl.mu.Lock()
+		defer tl.mu.Unlock()
+		return len(tl.processed) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	var numbers []uint64
+	for _, header := range tl.processed {
+		numbers = append(numbers, header.Number.Uint64())
+	}
+	require.Equal(t, []uint64{2, 3, 7}, numbers)
+}
@@ -0,0 +1,63 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// requiredConfirmations returns configured confirmation depth for listener
+func requiredConfirmations(name string) uint64 {
+	switch name {
+	case RootChainListenerStr:
+		return helper.GetConfig().EthRequiredConfirmations
+	case BscChainListenerStr:
+		return helper.GetConfig().BscRequiredConfirmations
+	case MaticChainListenerStr:
+		return helper.GetConfig().BttcRequiredConfirmations
+	}
+	return 0
+}
+
+// confirmedHeader returns canonical header required confirmations below head, false if there is
+// nothing new deep enough to dispatch. Head is returned as is when confirmations are disabled.
+func (bl *BaseListener) confirmedHeader(ctx context.Context, head *types.Header) (*types.Header, bool) {
+	if bl.requiredConfirmations == 0 || head.Number == nil {
+		return head, true
+	}
+
+	client := bl.client()
+	if client == nil {
+		return head, true
+	}
+
+	number := head.Number.Uint64()
+	if number < bl.requiredConfirmations {
+		return nil, false
+	}
+
+	// heads arriving out of order or twice must not dispatch same depth again
+	target := number - bl.requiredConfirmations
+	if target <= atomic.LoadUint64(&bl.lastConfirmed) {
+		return nil, false
+	}
+
+	// fetch by number at dispatch time, header seen earlier at this height may be orphaned
+	start := time.Now()
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(target))
+	bl.observeRPC("HeaderByNumber", start, err)
+	if err != nil {
+		bl.logErrorRateLimited("Error while fetching confirmed header", err)
+		bl.endpointFailed(client, err)
+		return nil, false
+	}
+
+	atomic.StoreUint64(&bl.lastConfirmed, target)
+	bl.Logger.Debug("Dispatching confirmed header", "head", number, "blockNumber", target, "confirmations", bl.requiredConfirmations)
+	return header, true
+}
@@ -0,0 +1,124 @@
+package listener
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// ForceStartBlockFlag overrides persisted listener cursors on startup, as comma separated
+// <listener>=<block> pairs, e.g. rootchain=1000,tron=2000
+const ForceStartBlockFlag = "force-start-block"
+
+// listenerCursorKeys maps listener name to storage key holding its last processed block
+var listenerCursorKeys = map[string]string{
+	RootChainListenerStr: lastEthBlockKey,
+	BscChainListenerStr:  lastBscBlockKey,
+	TronChainListenerStr: tronLastBlockKey,
+	HeimdallListenerStr:  heimdallLastBlockKey,
+}
+
+// CursorKey returns storage key holding last processed block of listener
+func CursorKey(name string) (string, bool) {
+	key, ok := listenerCursorKeys[name]
+	return key, ok
+}
+
+// CursorNames returns sorted names of listeners keeping cursor in storage
+func CursorNames() []string {
+	names := make([]string, 0, len(listenerCursorKeys))
+	for name := range listenerCursorKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseForceStartBlocks parses <listener>=<block> pairs of force start block flag
+func parseForceStartBlocks(values []string) (map[string]uint64, error) {
+	res := make(map[string]uint64)
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid force start block %q, expected <listener>=<block>", value)
+		}
+
+		name := strings.TrimSpace(parts[0])
+		if _, ok := listenerCursorKeys[name]; !ok {
+			return nil, fmt.Errorf("unknown listener %s in force start block", name)
+		}
+
+		block, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block in force start block %q: %v", value, err)
+		}
+		res[name] = block
+	}
+	return res, nil
+}
+
+// LastProcessedBlock returns last processed block persisted for listener, false if none is stored yet
+func (bl *BaseListener) LastProcessedBlock() (uint64, bool, error) {
+	key, ok := listenerCursorKeys[bl.name]
+	if !ok {
+		return 0, false, fmt.Errorf("listener %s has no cursor", bl.name)
+	}
+
+	value, err := bl.storageClient.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	block, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid block number %q for key %s: %v", string(value), key, err)
+	}
+	return block, true, nil
+}
+
+// resumeCursor prepares listener cursor on startup. Block forced by flag always wins, otherwise
+// persisted cursor is resumed with replay rewind and configured start block only seeds first start.
+func (bl *BaseListener) resumeCursor(startListenBlock uint64) error {
+	key, ok := listenerCursorKeys[bl.name]
+	if !ok {
+		return fmt.Errorf("listener %s has no cursor", bl.name)
+	}
+
+	forced, err := parseForceStartBlocks(viper.GetStringSlice(ForceStartBlockFlag))
+	if err != nil {
+		return err
+	}
+
+	if block, ok := forced[bl.name]; ok {
+		bl.Logger.Info("Forcing listener start block", "key", key, "block", block)
+		return bl.setStartListenBLock(block, key)
+	}
+
+	lastProcessed, found, err := bl.LastProcessedBlock()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if startListenBlock == 0 {
+			return nil
+		}
+		bl.Logger.Info("No persisted cursor, starting from configured block", "key", key, "block", startListenBlock)
+		return bl.setStartListenBLock(startListenBlock, key)
+	}
+
+	bl.Logger.Info("Resuming listener from persisted cursor", "key", key, "lastProcessed", lastProcessed)
+
+	// replay recent blocks processed before restart
+	return bl.rewindForReplay(key, startListenBlock)
+}
@@ -0,0 +1,80 @@
+package listener
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestParseForceStartBlocks(t *testing.T) {
+	blocks, err := parseForceStartBlocks([]string{"rootchain=100", " tron = 200 ", ""})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{RootChainListenerStr: 100, TronChainListenerStr: 200}, blocks)
+
+	for _, value := range []string{"rootchain", "maticchain=1", "unknown=1", "rootchain=abc"} {
+		_, err := parseForceStartBlocks([]string{value})
+		require.Error(t, err, value)
+	}
+}
+
+func TestResumeCursor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bridge-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.ReplayDepth = 0
+	helper.SetTestConfig(conf)
+	defer helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	tl := newTestListener()
+	tl.name = RootChainListenerStr
+	tl.storageClient = db
+
+	lastProcessed := func() uint64 {
+		block, found, err := tl.LastProcessedBlock()
+		require.NoError(t, err)
+		require.True(t, found)
+		return block
+	}
+
+	// nothing stored and no start block
+	require.NoError(t, tl.resumeCursor(0))
+	_, found, err := tl.LastProcessedBlock()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// configured start block seeds first start
+	require.NoError(t, tl.resumeCursor(100))
+	require.Equal(t, uint64(100), lastProcessed())
+
+	// restart resumes persisted cursor instead of resetting to start block
+	require.NoError(t, db.Put([]byte(lastEthBlockKey), []byte("500"), nil))
+	require.NoError(t, tl.resumeCursor(100))
+	require.Equal(t, uint64(500), lastProcessed())
+
+	// forced start block overrides persisted cursor
+	viper.Set(ForceStartBlockFlag, []string{"rootchain=50"})
+	defer viper.Set(ForceStartBlockFlag, nil)
+	require.NoError(t, tl.resumeCursor(100))
+	require.Equal(t, uint64(50), lastProcessed())
+
+	// invalid flag fails and leaves cursor untouched
+	viper.Set(ForceStartBlockFlag, []string{"rootchain=abc"})
+	require.Error(t, tl.resumeCursor(100))
+	require.Equal(t, uint64(50), lastProcessed())
+
+	// listeners without cursor are rejected
+	tl.name = MaticChainListenerStr
+	require.Error(t, tl.resumeCursor(0))
+}
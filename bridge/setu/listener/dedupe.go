@@ -0,0 +1,75 @@
+package listener
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// headerDedupeWindow is how long a header hash is remembered as recently seen
+var headerDedupeWindow = 30 * time.Second
+
+// maxSeenHeaders bounds number of remembered header hashes, oldest are forgotten first
+var maxSeenHeaders = 1024
+
+// seenHeader is a header hash along with the time it was first seen
+type seenHeader struct {
+	hash   common.Hash
+	seenAt time.Time
+}
+
+// headerDeduper remembers hashes of recently seen headers so identical header delivered
+// by both subscription and polling is processed once
+type headerDeduper struct {
+	mu     sync.Mutex
+	hashes map[common.Hash]struct{}
+	order  []seenHeader
+}
+
+// newHeaderDeduper creates header deduper
+func newHeaderDeduper() *headerDeduper {
+	return &headerDeduper{hashes: make(map[common.Hash]struct{})}
+}
+
+// seen records header hash and returns true if identical header was already seen within dedupe window
+func (hd *headerDeduper) seen(hash common.Hash, now time.Time) bool {
+	hd.mu.Lock()
+	defer hd.mu.Unlock()
+
+	// forget expired hashes, order is sorted by time seen
+	expired := 0
+	for expired < len(hd.order) && now.Sub(hd.order[expired].seenAt) >= headerDedupeWindow {
+		delete(hd.hashes, hd.order[expired].hash)
+		expired++
+	}
+	hd.order = hd.order[expired:]
+
+	if _, ok := hd.hashes[hash]; ok {
+		return true
+	}
+
+	hd.hashes[hash] = struct{}{}
+	hd.order = append(hd.order, seenHeader{hash: hash, seenAt: now})
+	if len(hd.order) > maxSeenHeaders {
+		delete(hd.hashes, hd.order[0].hash)
+		hd.order = hd.order[1:]
+	}
+	return false
+}
+
+// isDuplicateHeader returns true if identical header was received shortly before
+func (bl *BaseListener) isDuplicateHeader(header *types.Header) bool {
+	if bl.seenHeaders == nil {
+		return false
+	}
+
+	hash := header.Hash()
+	if !bl.seenHeaders.seen(hash, time.Now()) {
+		return false
+	}
+
+	bl.Logger.Debug("Duplicate header received, skipping", "blockNumber", header.Number, "hash", hash.Hex())
+	return true
+}
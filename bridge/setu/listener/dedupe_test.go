@@ -0,0 +1,61 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderDeduper(t *testing.T) {
+	hd := newHeaderDeduper()
+	now := time.Now()
+
+	hash := common.HexToHash("0x1")
+	require.False(t, hd.seen(hash, now))
+	require.True(t, hd.seen(hash, now.Add(time.Second)))
+
+	// expired hash is seen as new
+	require.False(t, hd.seen(hash, now.Add(headerDedupeWindow)))
+
+	// size bound forgets oldest hash
+	defer func(max int) { maxSeenHeaders = max }(maxSeenHeaders)
+	maxSeenHeaders = 2
+
+	hd = newHeaderDeduper()
+	require.False(t, hd.seen(common.HexToHash("0x1"), now))
+	require.False(t, hd.seen(common.HexToHash("0x2"), now))
+	require.False(t, hd.seen(common.HexToHash("0x3"), now))
+	require.Len(t, hd.hashes, 2)
+	require.False(t, hd.seen(common.HexToHash("0x1"), now))
+}
+
+func TestStartHeaderProcessSkipsDuplicateHeader(t *testing.T) {
+	tl := newTestListener()
+	tl.seenHeaders = newHeaderDeduper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tl.StartHeaderProcess(context.Background())
+	}()
+
+	header := &types.Header{Number: big.NewInt(1)}
+	tl.HeaderChannel <- header
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(1)}
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(1), Extra: []byte("reorg")}
+	close(tl.HeaderChannel)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("header process did not stop on closed channel")
+	}
+
+	require.Len(t, tl.processed, 2, "identical header is processed once")
+	require.Equal(t, header.Hash(), tl.processed[0].Hash())
+}
@@ -0,0 +1,224 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// endpointHealthCheckTimeout bounds a single endpoint health check
+var endpointHealthCheckTimeout = 10 * time.Second
+
+// ChainClient is the part of eth client used by listeners
+type ChainClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+type rpcEndpoint struct {
+	url     string
+	client  ChainClient
+	healthy bool
+}
+
+// endpointPool holds RPC endpoints of a chain, listeners use the active one
+// and fail over round-robin to the next healthy endpoint on errors
+type endpointPool struct {
+	logger log.Logger
+
+	mu        sync.RWMutex
+	endpoints []*rpcEndpoint
+	active    int
+}
+
+func newEndpointPool(logger log.Logger) *endpointPool {
+	return &endpointPool{logger: logger}
+}
+
+// add appends endpoint to pool, endpoints are assumed healthy until a check fails
+func (p *endpointPool) add(url string, client ChainClient) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.endpoints = append(p.endpoints, &rpcEndpoint{url: url, client: client, healthy: true})
+}
+
+// size returns number of endpoints in pool
+func (p *endpointPool) size() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.endpoints)
+}
+
+// client returns client of active endpoint, nil if pool is empty
+func (p *endpointPool) client() ChainClient {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if len(p.endpoints) == 0 {
+		return nil
+	}
+	return p.endpoints[p.active].client
+}
+
+// markFailed marks endpoint of client unhealthy. If it is the active one,
+// pool fails over to next healthy endpoint, or simply the next one if none is healthy.
+func (p *endpointPool) markFailed(client ChainClient, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, endpoint := range p.endpoints {
+		if endpoint.client != client {
+			continue
+		}
+
+		endpoint.healthy = false
+		if i == p.active {
+			p.failover(err)
+		}
+		return
+	}
+}
+
+// failover moves active endpoint to next healthy one, caller holds lock
+func (p *endpointPool) failover(err error) {
+	if len(p.endpoints) < 2 {
+		return
+	}
+
+	from := p.active
+	next := (from + 1) % len(p.endpoints)
+	for i := 1; i < len(p.endpoints); i++ {
+		candidate := (from + i) % len(p.endpoints)
+		if p.endpoints[candidate].healthy {
+			next = candidate
+			break
+		}
+	}
+
+	p.active = next
+	p.logger.Info("Failing over to next RPC endpoint",
+		"from", p.endpoints[from].url, "to", p.endpoints[next].url, "error", err)
+}
+
+// checkHealth fetches latest header from every endpoint and updates their health,
+// failing over if active endpoint turned unhealthy
+func (p *endpointPool) checkHealth(ctx context.Context) {
+	p.mu.RLock()
+	endpoints := make([]*rpcEndpoint, len(p.endpoints))
+	copy(endpoints, p.endpoints)
+	p.mu.RUnlock()
+
+	// query endpoints without holding lock, rpc calls may be slow
+	errs := make([]error, len(endpoints))
+	for i, endpoint := range endpoints {
+		checkCtx, cancel := context.WithTimeout(ctx, endpointHealthCheckTimeout)
+		_, errs[i] = endpoint.client.HeaderByNumber(checkCtx, nil)
+		cancel()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, endpoint := range endpoints {
+		if errs[i] != nil && endpoint.healthy {
+			p.logger.Error("RPC endpoint health check failed", "url", endpoint.url, "error", errs[i])
+		} else if errs[i] == nil && !endpoint.healthy {
+			p.logger.Info("RPC endpoint recovered", "url", endpoint.url)
+		}
+		endpoint.healthy = errs[i] == nil
+	}
+
+	if len(p.endpoints) > 0 && !p.endpoints[p.active].healthy {
+		p.failover(errs[p.active])
+	}
+}
+
+// fallbackRPCUrls returns configured fallback RPC urls for listener
+func fallbackRPCUrls(name string) []string {
+	var urls string
+	switch name {
+	case RootChainListenerStr:
+		urls = helper.GetConfig().EthRPCFallbackUrls
+	case BscChainListenerStr:
+		urls = helper.GetConfig().BscRPCFallbackUrls
+	case MaticChainListenerStr:
+		urls = helper.GetConfig().BttcRPCFallbackUrls
+	}
+
+	var res []string
+	for _, url := range strings.Split(urls, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			res = append(res, url)
+		}
+	}
+	return res
+}
+
+// resetFallbackEndpoints removes all endpoints but primary one and makes it active
+func (p *endpointPool) resetFallbackEndpoints() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.endpoints) > 1 {
+		p.endpoints = p.endpoints[:1]
+	}
+	p.active = 0
+}
+
+// addFallbackEndpoints dials fallback urls and adds them to pool, unreachable ones are skipped
+func (p *endpointPool) addFallbackEndpoints(urls []string) {
+	for _, url := range urls {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			p.logger.Error("Unable to dial fallback RPC endpoint", "url", url, "error", err)
+			continue
+		}
+		p.add(url, client)
+	}
+}
+
+// client returns client of listener's active RPC endpoint, nil if listener has no RPC endpoints
+func (bl *BaseListener) client() ChainClient {
+	if bl.endpoints == nil {
+		return nil
+	}
+	return bl.endpoints.client()
+}
+
+// endpointFailed fails over from client's endpoint after rpc error
+func (bl *BaseListener) endpointFailed(client ChainClient, err error) {
+	bl.endpoints.markFailed(client, err)
+}
+
+// StartEndpointHealthCheck periodically checks listener RPC endpoints until ctx is done,
+// no-op with single endpoint as there is nothing to fail over to
+func (bl *BaseListener) StartEndpointHealthCheck(ctx context.Context) {
+	interval := helper.GetConfig().RPCHealthCheckInterval
+	if bl.endpoints.size() < 2 || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			bl.endpoints.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// fakeChainClient returns latest header or err if set
+type fakeChainClient struct {
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func (c *fakeChainClient) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (c *fakeChainClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &types.Header{Number: big.NewInt(1)}, nil
+}
+
+func (c *fakeChainClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	return newFakeSubscription(), nil
+}
+
+func (c *fakeChainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return nil, c.err
+}
+
+func newTestEndpointPool(clients ...ChainClient) *endpointPool {
+	pool := newEndpointPool(log.NewNopLogger())
+	for i, client := range clients {
+		pool.add(string(rune('a'+i)), client)
+	}
+	return pool
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	a, b, c := &fakeChainClient{}, &fakeChainClient{}, &fakeChainClient{}
+	pool := newTestEndpointPool(a, b, c)
+	require.Same(t, a, pool.client())
+
+	// failure of inactive endpoint only marks it unhealthy
+	pool.markFailed(c, errors.New("down"))
+	require.Same(t, a, pool.client())
+
+	// active endpoint fails over to next healthy one
+	pool.markFailed(a, errors.New("down"))
+	require.Same(t, b, pool.client())
+
+	// with no healthy endpoint left, pool keeps rotating round-robin
+	pool.markFailed(b, errors.New("down"))
+	require.Same(t, c, pool.client())
+	pool.markFailed(c, errors.New("down"))
+	require.Same(t, a, pool.client())
+
+	// healthy endpoints are preferred over next unhealthy one
+	pool.endpoints[2].healthy = true
+	pool.markFailed(a, errors.New("down"))
+	require.Same(t, c, pool.client())
+
+	// single endpoint has nothing to fail over to
+	single := newTestEndpointPool(a)
+	single.markFailed(a, errors.New("down"))
+	require.Same(t, a, single.client())
+}
+
+func TestEndpointPoolCheckHealth(t *testing.T) {
+	a, b := &fakeChainClient{}, &fakeChainClient{}
+	pool := newTestEndpointPool(a, b)
+
+	a.setErr(errors.New("down"))
+	pool.checkHealth(context.Background())
+	require.Same(t, b, pool.client())
+	require.False(t, pool.endpoints[0].healthy)
+
+	// recovered endpoint becomes healthy again, active one stays
+	a.setErr(nil)
+	pool.checkHealth(context.Background())
+	require.Same(t, b, pool.client())
+	require.True(t, pool.endpoints[0].healthy)
+
+	b.setErr(errors.New("down"))
+	pool.checkHealth(context.Background())
+	require.Same(t, a, pool.client())
+}
+
+func TestStartPollingFailsOver(t *testing.T) {
+	failing, healthy := &fakeChainClient{}, &fakeChainClient{}
+	failing.setErr(errors.New("connection refused"))
+
+	tl := newTestListener()
+	tl.endpoints = newTestEndpointPool(failing, healthy)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tl.StartPolling(ctx, time.Millisecond, false)
+
+	select {
+	case header := <-tl.HeaderChannel:
+		require.Equal(t, uint64(1), header.Number.Uint64())
+	case <-time.After(5 * time.Second):
+		t.Fatal("polling did not fail over to healthy endpoint")
+	}
+	require.Same(t, healthy, tl.client())
+}
+
+// numberedChainClient returns header with requested number
+type numberedChainClient struct {
+	fakeChainClient
+}
+
+func (c *numberedChainClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if _, err := c.fakeChainClient.HeaderByNumber(ctx, number); err != nil || number == nil {
+		return nil, err
+	}
+	return &types.Header{Number: new(big.Int).Set(number)}, nil
+}
@@ -0,0 +1,170 @@
+package listener
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// HeaderFanoutPolicy defines what fan-out does when subscriber channel is full
+type HeaderFanoutPolicy string
+
+const (
+	// HeaderFanoutDrop drops header for subscriber whose channel is full
+	HeaderFanoutDrop HeaderFanoutPolicy = "drop"
+
+	// HeaderFanoutBlock waits for subscriber whose channel is full. It delays
+	// other subscribers, never primary header processing.
+	HeaderFanoutBlock HeaderFanoutPolicy = "block"
+)
+
+// headerSubscriber is a registered fan-out subscriber
+type headerSubscriber struct {
+	ch      chan *types.Header
+	policy  HeaderFanoutPolicy
+	done    chan struct{}
+	dropped uint64
+}
+
+// headerFanout broadcasts headers delivered to listener to additional subscribers.
+// Headers are handed to a dispatcher through a buffered queue, so primary header
+// processing never waits for subscribers.
+type headerFanout struct {
+	mu          sync.RWMutex
+	subscribers []*headerSubscriber
+
+	queue   chan *types.Header
+	dropped uint64 // headers dropped because dispatcher queue was full
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	quit      chan struct{}
+}
+
+// newHeaderFanout creates fan-out with dispatcher queue of given size
+func newHeaderFanout(queueSize int) *headerFanout {
+	if queueSize <= 0 {
+		queueSize = helper.DefaultHeaderFanoutBuffer
+	}
+
+	return &headerFanout{
+		queue: make(chan *types.Header, queueSize),
+		quit:  make(chan struct{}),
+	}
+}
+
+// SubscribeHeaders registers a subscriber receiving every header delivered to listener,
+// in addition to primary HeaderChannel. Zero buffer and empty policy use configured defaults.
+// Returned channel is not closed on unsubscribe.
+func (bl *BaseListener) SubscribeHeaders(buffer int, policy HeaderFanoutPolicy) <-chan *types.Header {
+	if buffer <= 0 {
+		buffer = helper.GetConfig().HeaderFanoutBuffer
+	}
+	if buffer <= 0 {
+		buffer = helper.DefaultHeaderFanoutBuffer
+	}
+	if policy == "" {
+		policy = HeaderFanoutPolicy(helper.GetConfig().HeaderFanoutPolicy)
+	}
+	if policy != HeaderFanoutBlock {
+		policy = HeaderFanoutDrop
+	}
+
+	subscriber := &headerSubscriber{
+		ch:     make(chan *types.Header, buffer),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+
+	f := bl.fanout
+	f.mu.Lock()
+	f.subscribers = append(f.subscribers, subscriber)
+	f.mu.Unlock()
+
+	f.startOnce.Do(func() { go bl.dispatchHeaders() })
+
+	bl.Logger.Info("Registered header subscriber", "buffer", buffer, "policy", policy)
+	return subscriber.ch
+}
+
+// UnsubscribeHeaders removes subscriber registered with SubscribeHeaders
+func (bl *BaseListener) UnsubscribeHeaders(ch <-chan *types.Header) {
+	f := bl.fanout
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, subscriber := range f.subscribers {
+		if subscriber.ch == ch {
+			close(subscriber.done)
+			f.subscribers = append(f.subscribers[:i], f.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+// publishHeader hands header to fan-out dispatcher without blocking
+func (bl *BaseListener) publishHeader(header *types.Header) {
+	f := bl.fanout
+	if f == nil {
+		return
+	}
+
+	f.mu.RLock()
+	subscribed := len(f.subscribers) > 0
+	f.mu.RUnlock()
+	if !subscribed {
+		return
+	}
+
+	select {
+	case f.queue <- header:
+	default:
+		dropped := atomic.AddUint64(&f.dropped, 1)
+		bl.Logger.Debug("Header fan-out queue full, dropping header", "blockNumber", header.Number, "dropped", dropped)
+	}
+}
+
+// dispatchHeaders delivers queued headers to subscribers according to their policy
+func (bl *BaseListener) dispatchHeaders() {
+	f := bl.fanout
+	for {
+		select {
+		case header := <-f.queue:
+			f.mu.RLock()
+			subscribers := make([]*headerSubscriber, len(f.subscribers))
+			copy(subscribers, f.subscribers)
+			f.mu.RUnlock()
+
+			for _, subscriber := range subscribers {
+				if subscriber.policy == HeaderFanoutBlock {
+					select {
+					case subscriber.ch <- header:
+					case <-subscriber.done:
+					case <-f.quit:
+						return
+					}
+					continue
+				}
+
+				select {
+				case subscriber.ch <- header:
+				default:
+					dropped := atomic.AddUint64(&subscriber.dropped, 1)
+					bl.Logger.Debug("Header subscriber full, dropping header", "blockNumber", header.Number, "dropped", dropped)
+				}
+			}
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+// stopFanout stops fan-out dispatcher
+func (bl *BaseListener) stopFanout() {
+	if bl.fanout != nil {
+		bl.fanout.stopOnce.Do(func() { close(bl.fanout.quit) })
+	}
+}
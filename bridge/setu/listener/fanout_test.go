@@ -0,0 +1,54 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderFanout(t *testing.T) {
+	tl := newTestListener()
+	defer tl.stopFanout()
+
+	// nobody reads dropping subscriber, blocking one is read below
+	dropping := tl.SubscribeHeaders(1, HeaderFanoutDrop)
+	blocking := tl.SubscribeHeaders(1, HeaderFanoutBlock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tl.StartHeaderProcess(ctx)
+
+	// slow subscribers don't block primary path
+	for i := int64(1); i <= 3; i++ {
+		tl.HeaderChannel <- &types.Header{Number: big.NewInt(i)}
+	}
+	require.Eventually(t, func() bool {
+		tl.mu.Lock()
+		defer tl.mu.Unlock()
+		return len(tl.processed) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// blocking subscriber gets every header in order
+	for i := uint64(1); i <= 3; i++ {
+		select {
+		case header := <-blocking:
+			require.Equal(t, i, header.Number.Uint64())
+		case <-time.After(5 * time.Second):
+			t.Fatal("blocking subscriber did not receive header")
+		}
+	}
+
+	// dropping subscriber keeps first header only
+	require.Len(t, dropping, 1)
+	require.Equal(t, uint64(1), (<-dropping).Number.Uint64())
+
+	// unsubscribed channel gets no more headers
+	tl.UnsubscribeHeaders(dropping)
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(4)}
+	require.Equal(t, uint64(4), (<-blocking).Number.Uint64())
+	require.Len(t, dropping, 0)
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/helper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +43,11 @@ func (hl *HeimdallListener) Start() error {
 	headerCtx, cancelHeaderProcess := context.WithCancel(context.Background())
 	hl.cancelHeaderProcess = cancelHeaderProcess
 
+	// resume from persisted cursor
+	if err := hl.resumeCursor(0); err != nil {
+		hl.Logger.Error("Error while resuming listener cursor", "error", err)
+	}
+
 	// Heimdall pollIntervall = (minimal pollInterval of rootchain and matichain)
 	pollInterval := helper.GetConfig().EthSyncerPollInterval
 	if helper.GetConfig().CheckpointerPollInterval < helper.GetConfig().EthSyncerPollInterval {
@@ -74,6 +81,9 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 	// the ending of the interval
 	ticker := time.NewTicker(firstInterval)
 
+	atomic.AddInt32(&hl.running, 1)
+	defer atomic.AddInt32(&hl.running, -1)
+
 	var tickerOnce sync.Once
 	// var eventTypes []string
 	// eventTypes = append(eventTypes, "message.action='checkpoint'")
@@ -91,7 +101,11 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 			fromBlock, toBlock, err := hl.fetchFromAndToBlock()
 			if err != nil {
 				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
-			} else if fromBlock < toBlock {
+			} else {
+				hl.headerReceived()
+			}
+
+			if err == nil && fromBlock < toBlock {
 
 				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
 
@@ -101,8 +115,8 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 					if err != nil {
 						hl.Logger.Error("Error fetching begin block events", "error", err)
 					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
+					for index, event := range events {
+						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i), index)
 					}
 				}
 
@@ -144,6 +158,8 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 				// set last block to storage
 				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
 					hl.Logger.Error("hl.storageClient.Put", "Error", err)
+				} else {
+					hl.setLastProcessedBlock(toBlock)
 				}
 			}
 
@@ -189,7 +205,8 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
-func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) {
+// eventIndex is position of event in block events.
+func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64, eventIndex int) {
 	hl.Logger.Info("Received block event from Heimdall", "eventType", event.Type, "height", blockHeight)
 	eventBytes, err := json.Marshal(event)
 	if err != nil {
@@ -197,29 +214,31 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 		return
 	}
 
+	taskKey := queue.NewTaskKey(event.Type, hl.String(), strconv.FormatInt(blockHeight, 10), uint64(eventIndex))
+
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
-		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
+		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight, taskKey)
 	case checkpointTypes.EventTypeCheckpointSync:
-		hl.sendBlockTask("sendCheckpointSyncToStakeChain", eventBytes, blockHeight)
+		hl.sendBlockTask("sendCheckpointSyncToStakeChain", eventBytes, blockHeight, taskKey)
 	case slashingTypes.EventTypeSlashLimit:
-		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
+		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight, taskKey)
 	case slashingTypes.EventTypeTickConfirm:
-		hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
+		hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight, taskKey)
 
 	case stakingTypes.EventTypeValidatorJoin,
 		stakingTypes.EventTypeSignerUpdate,
 		stakingTypes.EventTypeValidatorExit,
 		stakingTypes.EventTypeStakingSyncAck:
-		hl.sendBlockTask("sendStakingSyncToHeimdall", eventBytes, blockHeight)
+		hl.sendBlockTask("sendStakingSyncToHeimdall", eventBytes, blockHeight, taskKey)
 	case stakingTypes.EventTypeStakingSync:
-		hl.sendBlockTask("sendStakingSyncToRootChain", eventBytes, blockHeight)
+		hl.sendBlockTask("sendStakingSyncToRootChain", eventBytes, blockHeight, taskKey)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
 }
 
-func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) {
+func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64, taskKey string) {
 	// create machinery task
 	signature := &tasks.Signature{
 		Name: taskName,
@@ -239,8 +258,11 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 	hl.Logger.Info("Sending block level task",
 		"taskName", taskName, "eventBytes", eventBytes, "currentTime", time.Now(), "blockHeight", blockHeight)
 	// send task
-	_, err := hl.queueConnector.Server.SendTask(signature)
+	sent, err := hl.queueConnector.SendTaskOnce(taskKey, signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
+		hl.publishFailed(taskName)
+	} else if !sent {
+		hl.Logger.Debug("Dropped duplicate block level task", "taskName", taskName, "blockHeight", blockHeight)
 	}
 }
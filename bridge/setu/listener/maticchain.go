@@ -6,7 +6,10 @@ import (
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
+	hmtypes "github.com/maticnetwork/heimdall/types"
 )
 
 // MaticChainListener - Listens to and process headerblocks from maticchain
@@ -34,8 +37,16 @@ func (ml *MaticChainListener) Start() error {
 	// start header process
 	go ml.StartHeaderProcess(headerCtx)
 
+	// fail over between rpc endpoints
+	go ml.StartEndpointHealthCheck(ctx)
+
+	// poll for new headers if subscription breaks for good
+	ml.pollFallback = func(ctx context.Context) {
+		ml.StartPolling(ctx, helper.GetConfig().CheckpointerPollInterval, true)
+	}
+
 	// subscribe to new head
-	subscription, err := ml.contractConnector.MaticChainClient.SubscribeNewHead(ctx, ml.HeaderChannel)
+	subscription, err := ml.subscribeNewHead(ctx)
 	if err != nil {
 		// start go routine to poll for new header using client object
 		ml.Logger.Info("Start polling for header blocks", "pollInterval", helper.GetConfig().CheckpointerPollInterval)
@@ -61,10 +72,38 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *types.Header) {
 		return
 	}
 
-	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, 0)
+	taskKey := queue.NewTaskKey("NewHeader", ml.String(), newHeader.Hash().Hex(), 0)
+	ml.sendTaskWithDelay("sendCheckpointToHeimdall", headerBytes, taskKey, 0)
+
+	ml.confirmCheckpointedHeaders()
+}
+
+// OnReorg implements Listener, checkpoint tasks queued from orphaned headers are dropped
+func (ml *MaticChainListener) OnReorg(oldHead *types.Header, newHead *types.Header) {
+	ml.markOrphaned(oldHead, newHead)
+}
+
+// confirmCheckpointedHeaders confirms headers covered by latest acked checkpoint for latency throttle
+func (ml *MaticChainListener) confirmCheckpointedHeaders() {
+	if !ml.latencyThrottleEnabled() {
+		return
+	}
+
+	checkpoint, err := util.GetlastestCheckpoint(ml.cliCtx, hmtypes.RootChainTypeStake)
+	if err != nil {
+		ml.Logger.Debug("Error while fetching latest checkpoint for confirmation latency", "error", err)
+		return
+	}
+
+	ml.ConfirmHeaders(checkpoint.EndBlock)
+}
+
+// ConcurrentHeaderProcessing implements ConcurrentHeaderProcessor, headers are only forwarded to queue
+func (ml *MaticChainListener) ConcurrentHeaderProcessing() bool {
+	return true
 }
 
-func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
+func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, taskKey string, delay time.Duration) {
 	// create machinery task
 	signature := &tasks.Signature{
 		Name: taskName,
@@ -81,8 +120,11 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	ml.Logger.Debug("Sending task", "taskname", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := ml.queueConnector.Server.SendTask(signature)
+	sent, err := ml.queueConnector.SendTaskOnce(taskKey, signature)
 	if err != nil {
 		ml.Logger.Error("Error sending task", "taskName", taskName, "error", err)
+		ml.publishFailed(taskName)
+	} else if !sent {
+		ml.Logger.Debug("Dropped duplicate task", "taskName", taskName)
 	}
 }
@@ -0,0 +1,117 @@
+package listener
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "bridge"
+	metricsSubsystem = "listener"
+
+	headerSourceSubscription = "subscription"
+	headerSourcePolling      = "polling"
+)
+
+var (
+	// headerSourceGauge is 1 for the way listener currently receives new headers
+	headerSourceGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "header_source",
+		Help:      "Set to 1 for the source listener currently receives new headers from (subscription or polling).",
+	}, []string{"listener", "source"})
+
+	// resubscribeCounter counts re-subscribe attempts after subscription errors by result
+	resubscribeCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "resubscribe_attempts_total",
+		Help:      "Number of new head re-subscribe attempts after subscription errors, by result.",
+	}, []string{"listener", "result"})
+
+	// headersProcessedCounter counts headers processed by listener by result
+	headersProcessedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "headers_processed_total",
+		Help:      "Number of headers processed by listener, by result.",
+	}, []string{"listener", "result"})
+
+	// lastProcessedBlockGauge is the last block processed by listener
+	lastProcessedBlockGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "last_processed_block",
+		Help:      "Last block processed by listener.",
+	}, []string{"listener"})
+
+	// queuePublishFailuresCounter counts tasks listener failed to publish to queue
+	queuePublishFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "queue_publish_failures_total",
+		Help:      "Number of tasks listener failed to publish to task queue, by task.",
+	}, []string{"listener", "task"})
+
+	// rpcLatencyHistogram observes duration of chain rpc calls made by listener
+	rpcLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rpc_latency_seconds",
+		Help:      "Duration of chain RPC calls made by listener, by method and result.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"listener", "method", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(headerSourceGauge, resubscribeCounter, headersProcessedCounter,
+		lastProcessedBlockGauge, queuePublishFailuresCounter, rpcLatencyHistogram)
+}
+
+// metricResult returns result label for err
+func metricResult(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// setHeaderSource records the source listener receives new headers from
+func (bl *BaseListener) setHeaderSource(source string) {
+	for _, s := range []string{headerSourceSubscription, headerSourcePolling} {
+		value := 0.0
+		if s == source {
+			value = 1
+		}
+		headerSourceGauge.WithLabelValues(bl.name, s).Set(value)
+	}
+}
+
+// headerProcessed records header processing result. Last processed block of listeners
+// with a block cursor is recorded when cursor is committed instead.
+func (bl *BaseListener) headerProcessed(header *types.Header, err error) {
+	headersProcessedCounter.WithLabelValues(bl.name, metricResult(err)).Inc()
+	if _, ok := listenerCursorKeys[bl.name]; !ok && err == nil && header.Number != nil {
+		bl.setLastProcessedBlock(header.Number.Uint64())
+	}
+}
+
+// observeRPC records latency of chain rpc call started at start
+func (bl *BaseListener) observeRPC(method string, start time.Time, err error) {
+	rpcLatencyHistogram.WithLabelValues(bl.name, method, metricResult(err)).Observe(time.Since(start).Seconds())
+}
+
+// setLastProcessedBlock records last block processed by listener
+func (bl *BaseListener) setLastProcessedBlock(block uint64) {
+	atomic.StoreUint64(&bl.lastProcessed, block)
+	lastProcessedBlockGauge.WithLabelValues(bl.name).Set(float64(block))
+}
+
+// publishFailed records task listener failed to publish to queue
+func (bl *BaseListener) publishFailed(taskName string) {
+	queuePublishFailuresCounter.WithLabelValues(bl.name, taskName).Inc()
+}
@@ -0,0 +1,30 @@
+package listener
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderProcessedMetrics(t *testing.T) {
+	tl := newTestListener()
+	tl.name = "metrics-test"
+
+	tl.headerProcessed(&types.Header{Number: big.NewInt(10)}, nil)
+	tl.headerProcessed(&types.Header{Number: big.NewInt(11)}, errors.New("failed"))
+
+	require.Equal(t, 1.0, testutil.ToFloat64(headersProcessedCounter.WithLabelValues("metrics-test", "success")))
+	require.Equal(t, 1.0, testutil.ToFloat64(headersProcessedCounter.WithLabelValues("metrics-test", "error")))
+
+	// failed header does not move last processed block
+	require.Equal(t, 10.0, testutil.ToFloat64(lastProcessedBlockGauge.WithLabelValues("metrics-test")))
+
+	// listeners with a block cursor record last processed block on commit
+	tl.name = RootChainListenerStr
+	tl.headerProcessed(&types.Header{Number: big.NewInt(12)}, nil)
+	require.Equal(t, 0.0, testutil.ToFloat64(lastProcessedBlockGauge.WithLabelValues(RootChainListenerStr)))
+}
@@ -0,0 +1,83 @@
+package listener
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// errorLogWindow is the window in which repeated identical errors are collapsed
+var errorLogWindow = time.Minute
+
+// errorLogEntry tracks occurrences of one error in current window
+type errorLogEntry struct {
+	windowStart time.Time
+	suppressed  int
+}
+
+// errorLogLimiter collapses repeated identical error logs into periodic summaries
+type errorLogLimiter struct {
+	mu        sync.Mutex
+	entries   map[string]*errorLogEntry
+	lastSweep time.Time
+}
+
+// logErrorRateLimited logs first occurrence of an error immediately and counts identical
+// ones within the window, logging a summary once the window is over
+func (bl *BaseListener) logErrorRateLimited(msg string, err error, keyvals ...interface{}) {
+	key := fmt.Sprintf("%s: %v", msg, err)
+	now := time.Now()
+
+	bl.errorLimiter.mu.Lock()
+	if bl.errorLimiter.entries == nil {
+		bl.errorLimiter.entries = make(map[string]*errorLogEntry)
+	}
+
+	// evict entries of expired windows, errors are keyed by text so keys don't repeat forever
+	summaries := bl.errorLimiter.evictExpired(now)
+
+	entry, ok := bl.errorLimiter.entries[key]
+	if ok && now.Sub(entry.windowStart) < errorLogWindow {
+		entry.suppressed++
+		bl.errorLimiter.mu.Unlock()
+		bl.logSuppressedSummaries(summaries)
+		return
+	}
+
+	if ok && entry.suppressed > 0 {
+		summaries[key] = entry.suppressed
+	}
+	bl.errorLimiter.entries[key] = &errorLogEntry{windowStart: now}
+	bl.errorLimiter.mu.Unlock()
+
+	bl.logSuppressedSummaries(summaries)
+	bl.Logger.Error(msg, append([]interface{}{"error", err}, keyvals...)...)
+}
+
+// evictExpired removes entries whose window is over, at most once per window,
+// and returns suppressed counts of evicted entries. Caller must hold mu.
+func (el *errorLogLimiter) evictExpired(now time.Time) map[string]int {
+	summaries := make(map[string]int)
+	if now.Sub(el.lastSweep) < errorLogWindow {
+		return summaries
+	}
+	el.lastSweep = now
+
+	for key, entry := range el.entries {
+		if now.Sub(entry.windowStart) < errorLogWindow {
+			continue
+		}
+		if entry.suppressed > 0 {
+			summaries[key] = entry.suppressed
+		}
+		delete(el.entries, key)
+	}
+	return summaries
+}
+
+// logSuppressedSummaries logs count of suppressed occurrences per error
+func (bl *BaseListener) logSuppressedSummaries(summaries map[string]int) {
+	for key, suppressed := range summaries {
+		bl.Logger.Error(fmt.Sprintf("%d occurrences of %q in the last %s", suppressed, key, errorLogWindow))
+	}
+}
@@ -0,0 +1,128 @@
+package listener
+
+import (
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// listenerSettings are listener settings which can change on config reload
+type listenerSettings struct {
+	pollInterval time.Duration
+	fallbackURLs string
+}
+
+// reloadableListener is implemented by listeners embedding BaseListener
+type reloadableListener interface {
+	Listener
+
+	reloadConfig() bool
+	stopListening()
+}
+
+// listenerPollInterval returns configured poll interval of listener
+func listenerPollInterval(name string) time.Duration {
+	conf := helper.GetConfig()
+	switch name {
+	case RootChainListenerStr:
+		return conf.EthSyncerPollInterval
+	case BscChainListenerStr:
+		return conf.BscSyncerPollInterval
+	case TronChainListenerStr:
+		return conf.TronSyncerPollInterval
+	case MaticChainListenerStr:
+		return conf.CheckpointerPollInterval
+	case HeimdallListenerStr:
+		// minimal poll interval of rootchain and maticchain
+		if conf.CheckpointerPollInterval < conf.EthSyncerPollInterval {
+			return conf.CheckpointerPollInterval
+		}
+		return conf.EthSyncerPollInterval
+	}
+	return 0
+}
+
+// currentListenerSettings returns reloadable settings of listener from current config
+func currentListenerSettings(name string) listenerSettings {
+	return listenerSettings{
+		pollInterval: listenerPollInterval(name),
+		fallbackURLs: strings.Join(fallbackRPCUrls(name), ","),
+	}
+}
+
+// listenerEnabled returns true if listener is enabled in config, all listeners are enabled by default
+func listenerEnabled(name string) bool {
+	names := strings.TrimSpace(helper.GetConfig().BridgeListeners)
+	if names == "" {
+		return true
+	}
+
+	for _, n := range strings.Split(names, ",") {
+		if strings.TrimSpace(n) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// reloadConfig applies reloaded config to listener and returns true if running
+// listener must restart for new settings to take effect
+func (bl *BaseListener) reloadConfig() bool {
+	settings := currentListenerSettings(bl.name)
+	if settings == bl.settings {
+		return false
+	}
+
+	bl.Logger.Info("Listener settings changed",
+		"pollInterval", settings.pollInterval, "fallbackRPCUrls", settings.fallbackURLs)
+
+	// primary endpoint is kept, fallbacks are dialed again
+	if bl.endpoints != nil && bl.endpoints.size() > 0 && settings.fallbackURLs != bl.settings.fallbackURLs {
+		bl.endpoints.resetFallbackEndpoints()
+		bl.endpoints.addFallbackEndpoints(fallbackRPCUrls(bl.name))
+	}
+
+	bl.settings = settings
+	return true
+}
+
+// Reload reads config again and applies it to listeners. Listeners disabled in config are
+// stopped, newly enabled ones started and running ones with changed settings restarted.
+func (listenerService *ListenerService) Reload() error {
+	if _, err := helper.ReloadDeliveryConfig(); err != nil {
+		listenerService.Logger.Error("Error while reloading config", "error", err)
+		return err
+	}
+
+	listenerService.mu.Lock()
+	defer listenerService.mu.Unlock()
+
+	for _, listener := range listenerService.listeners {
+		reloadable, ok := listener.(reloadableListener)
+		if !ok {
+			continue
+		}
+
+		name := listener.String()
+		changed := reloadable.reloadConfig()
+		enabled := listenerEnabled(name)
+
+		switch {
+		case !enabled && listenerService.started[name]:
+			listenerService.Logger.Info("Stopping listener disabled in config", "listener", name)
+			reloadable.stopListening()
+			listenerService.started[name] = false
+		case enabled && !listenerService.started[name]:
+			listenerService.Logger.Info("Starting listener enabled in config", "listener", name)
+			listenerService.startListener(listener)
+		case enabled && changed:
+			listenerService.Logger.Info("Restarting listener with reloaded settings", "listener", name)
+			reloadable.stopListening()
+			listenerService.startListener(listener)
+		}
+	}
+
+	listenerService.Logger.Info("Listener config reloaded")
+	return nil
+}
@@ -0,0 +1,42 @@
+package listener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenerEnabled(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	helper.SetTestConfig(conf)
+	require.True(t, listenerEnabled(RootChainListenerStr))
+
+	conf.BridgeListeners = "maticchain, heimdall"
+	helper.SetTestConfig(conf)
+	require.False(t, listenerEnabled(RootChainListenerStr))
+	require.True(t, listenerEnabled(HeimdallListenerStr))
+	require.True(t, listenerEnabled(MaticChainListenerStr))
+}
+
+func TestReloadConfig(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	helper.SetTestConfig(conf)
+
+	tl := newTestListener()
+	tl.name = RootChainListenerStr
+	tl.settings = currentListenerSettings(RootChainListenerStr)
+	require.False(t, tl.reloadConfig(), "unchanged settings need no restart")
+
+	conf.EthSyncerPollInterval = conf.EthSyncerPollInterval + time.Second
+	helper.SetTestConfig(conf)
+	require.True(t, tl.reloadConfig())
+	require.Equal(t, conf.EthSyncerPollInterval, tl.settings.pollInterval)
+	require.False(t, tl.reloadConfig())
+
+	// settings of other chains do not restart listener
+	conf.BscSyncerPollInterval = conf.BscSyncerPollInterval + time.Second
+	helper.SetTestConfig(conf)
+	require.False(t, tl.reloadConfig())
+}
@@ -0,0 +1,173 @@
+package listener
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// maxTrackedHeaders bounds number of recent headers kept to find orphaned blocks on reorg
+var maxTrackedHeaders = 256
+
+// headerChain tracks recently received headers by hash along with the last seen head
+type headerChain struct {
+	mu      sync.Mutex
+	head    *types.Header
+	headers map[common.Hash]*types.Header
+	order   []common.Hash
+}
+
+// newHeaderChain creates header chain tracker
+func newHeaderChain() *headerChain {
+	return &headerChain{headers: make(map[common.Hash]*types.Header)}
+}
+
+// add records header as new head. Returns previous head and true if header does not extend
+// it: its parent is not previous head, or it replaces a different block seen at its height.
+// Header skipping blocks (e.g. polling) or below tracked blocks can not be checked and is not a reorg.
+func (hc *headerChain) add(header *types.Header) (*types.Header, bool) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	// already seen header, e.g. from a lagging endpoint, does not move head
+	hash := header.Hash()
+	if _, ok := hc.headers[hash]; ok {
+		return hc.head, false
+	}
+
+	oldHead := hc.head
+	reorg := false
+	if oldHead != nil {
+		number, oldNumber := header.Number.Uint64(), oldHead.Number.Uint64()
+		switch {
+		case number == oldNumber+1:
+			reorg = header.ParentHash != oldHead.Hash()
+		case number <= oldNumber:
+			reorg = hc.ancestorAt(oldHead, number) != nil
+		}
+	}
+
+	hc.headers[hash] = header
+	hc.order = append(hc.order, hash)
+	if len(hc.order) > maxTrackedHeaders {
+		delete(hc.headers, hc.order[0])
+		hc.order = hc.order[1:]
+	}
+	hc.head = header
+
+	return oldHead, reorg
+}
+
+// ancestorAt returns tracked ancestor of header at number, nil if it is not tracked
+func (hc *headerChain) ancestorAt(header *types.Header, number uint64) *types.Header {
+	for ; header != nil && header.Number.Uint64() >= number; header = hc.headers[header.ParentHash] {
+		if header.Number.Uint64() == number {
+			return header
+		}
+	}
+	return nil
+}
+
+// orphaned returns hashes of tracked blocks from old head back to common ancestor with new head.
+// If new head's ancestry is not tracked, only old blocks down to its known ancestry are returned.
+func (hc *headerChain) orphaned(oldHead *types.Header, newHead *types.Header) []common.Hash {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	// canonical hashes known from new head, down to parent of its lowest tracked ancestor
+	canonical := make(map[common.Hash]struct{})
+	lowest := newHead
+	for header := newHead; header != nil; header = hc.headers[header.ParentHash] {
+		canonical[header.Hash()] = struct{}{}
+		lowest = header
+	}
+	canonical[lowest.ParentHash] = struct{}{}
+
+	var hashes []common.Hash
+	for header := oldHead; header != nil && header.Number.Uint64()+1 >= lowest.Number.Uint64(); header = hc.headers[header.ParentHash] {
+		hash := header.Hash()
+		if _, ok := canonical[hash]; ok {
+			break
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// detectReorg tracks received header and calls OnReorg of listener if it does not extend last seen head
+func (bl *BaseListener) detectReorg(header *types.Header) {
+	if bl.headerChain == nil || header.Number == nil {
+		return
+	}
+
+	oldHead, reorg := bl.headerChain.add(header)
+	if !reorg {
+		return
+	}
+
+	bl.Logger.Info("Chain reorg detected", "oldHead", oldHead.Number, "oldHash", oldHead.Hash().Hex(),
+		"newHead", header.Number, "newHash", header.Hash().Hex(), "parentHash", header.ParentHash.Hex())
+	bl.impl.OnReorg(oldHead, header)
+}
+
+// OnReorg is called when received header does not extend last seen head. Listeners
+// queuing tasks from block data override it to invalidate tasks from orphaned blocks.
+func (bl *BaseListener) OnReorg(oldHead *types.Header, newHead *types.Header) {}
+
+// orphanedBlocks returns hashes of blocks orphaned by reorg from old head to new head
+func (bl *BaseListener) orphanedBlocks(oldHead *types.Header, newHead *types.Header) []common.Hash {
+	if bl.headerChain == nil {
+		return []common.Hash{oldHead.Hash()}
+	}
+	return bl.headerChain.orphaned(oldHead, newHead)
+}
+
+// markOrphaned records blocks orphaned by reorg in bridge storage so processors drop queued tasks from them
+func (bl *BaseListener) markOrphaned(oldHead *types.Header, newHead *types.Header) []common.Hash {
+	hashes := bl.orphanedBlocks(oldHead, newHead)
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	if err := util.MarkBlocksOrphaned(bl.storageClient, hashes); err != nil {
+		bl.Logger.Error("Error while marking orphaned blocks", "count", len(hashes), "error", err)
+		return hashes
+	}
+
+	bl.Logger.Info("Marked orphaned blocks", "count", len(hashes), "oldHead", oldHead.Number, "newHead", newHead.Number)
+	return hashes
+}
+
+// rewindCursor moves persisted cursor back below first orphaned block, so blocks replacing
+// orphaned ones are processed again. Cursor below fork point is kept as is.
+func (bl *BaseListener) rewindCursor(oldHead *types.Header, orphaned []common.Hash) error {
+	if len(orphaned) == 0 {
+		return nil
+	}
+
+	key, ok := listenerCursorKeys[bl.name]
+	if !ok {
+		return nil
+	}
+
+	bl.commitMu.Lock()
+	defer bl.commitMu.Unlock()
+
+	lastBlock, found, err := bl.LastProcessedBlock()
+	if err != nil || !found {
+		return err
+	}
+
+	// orphaned blocks are contiguous down from old head
+	forkBlock := oldHead.Number.Uint64() + 1 - uint64(len(orphaned))
+	if forkBlock == 0 || lastBlock < forkBlock {
+		return nil
+	}
+
+	bl.Logger.Info("Rewinding listener cursor below orphaned blocks", "lastBlock", lastBlock, "rewindTo", forkBlock-1)
+	return bl.storageClient.Put([]byte(key), []byte(strconv.FormatUint(forkBlock-1, 10)), nil)
+}
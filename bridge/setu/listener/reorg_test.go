@@ -0,0 +1,108 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// reorgListener records OnReorg calls
+type reorgListener struct {
+	testListener
+
+	reorgs [][2]*types.Header
+}
+
+func (rl *reorgListener) OnReorg(oldHead *types.Header, newHead *types.Header) {
+	rl.reorgs = append(rl.reorgs, [2]*types.Header{oldHead, newHead})
+}
+
+func childHeader(parent *types.Header, extra string) *types.Header {
+	return &types.Header{
+		Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+		ParentHash: parent.Hash(),
+		Extra:      []byte(extra),
+	}
+}
+
+func TestHeaderChainDetectsReorg(t *testing.T) {
+	hc := newHeaderChain()
+
+	genesis := &types.Header{Number: big.NewInt(10)}
+	a1 := childHeader(genesis, "a")
+	a2 := childHeader(a1, "a")
+	for _, header := range []*types.Header{genesis, a1, a2} {
+		_, reorg := hc.add(header)
+		require.False(t, reorg)
+	}
+
+	// already seen header does not move head
+	head, reorg := hc.add(a1)
+	require.False(t, reorg)
+	require.Equal(t, a2.Hash(), head.Hash())
+
+	// header skipping blocks can not be checked
+	_, reorg = hc.add(&types.Header{Number: big.NewInt(20)})
+	require.False(t, reorg)
+
+	hc = newHeaderChain()
+	for _, header := range []*types.Header{genesis, a1, a2} {
+		hc.add(header)
+	}
+
+	// fork from genesis replaces a1 and a2
+	b1 := childHeader(genesis, "b")
+	b2 := childHeader(b1, "b")
+	b3 := childHeader(b2, "b")
+
+	oldHead, reorg := hc.add(b1)
+	require.True(t, reorg)
+	require.Equal(t, a2.Hash(), oldHead.Hash())
+	require.Equal(t, []common.Hash{a2.Hash(), a1.Hash()}, hc.orphaned(oldHead, b1))
+
+	_, reorg = hc.add(b2)
+	require.False(t, reorg)
+
+	// next block with unknown parent at head height + 1
+	c3 := &types.Header{Number: b3.Number, ParentHash: common.HexToHash("0xc")}
+	oldHead, reorg = hc.add(c3)
+	require.True(t, reorg)
+	require.Equal(t, b2.Hash(), oldHead.Hash())
+	require.Equal(t, []common.Hash{b2.Hash()}, hc.orphaned(oldHead, c3))
+}
+
+func TestStartHeaderProcessCallsOnReorg(t *testing.T) {
+	rl := &reorgListener{}
+	rl.testListener.BaseListener = newTestListener().BaseListener
+	rl.impl = rl
+	rl.headerChain = newHeaderChain()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		rl.StartHeaderProcess(context.Background())
+	}()
+
+	genesis := &types.Header{Number: big.NewInt(1)}
+	a1 := childHeader(genesis, "a")
+	b1 := childHeader(genesis, "b")
+	for _, header := range []*types.Header{genesis, a1, b1} {
+		rl.HeaderChannel <- header
+	}
+	close(rl.HeaderChannel)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("header process did not stop on closed channel")
+	}
+
+	require.Len(t, rl.reorgs, 1)
+	require.Equal(t, a1.Hash(), rl.reorgs[0][0].Hash())
+	require.Equal(t, b1.Hash(), rl.reorgs[0][1].Hash())
+}
@@ -0,0 +1,89 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// headerProcessMaxAttempts is the max number of attempts to process a header
+	headerProcessMaxAttempts = 3
+
+	// headerProcessRetryBackoff is the wait before first retry, doubled on every retry
+	headerProcessRetryBackoff = 2 * time.Second
+)
+
+// HeaderErrorProcessor is implemented by listeners which report header processing errors.
+// Headers failing with a RetriableError are processed again with backoff.
+type HeaderErrorProcessor interface {
+	ProcessHeaderWithError(*types.Header) error
+}
+
+// RetriableError marks header processing error as transient
+type RetriableError struct {
+	Err error
+}
+
+// NewRetriableError wraps error as retriable
+func NewRetriableError(err error) error {
+	return &RetriableError{Err: err}
+}
+
+func (e *RetriableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetriableError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetriableError returns true if header processing error is transient
+func IsRetriableError(err error) bool {
+	var retriableErr *RetriableError
+	return errors.As(err, &retriableErr)
+}
+
+// processHeader processes header with listener, retrying transient failures
+// if listener implements HeaderErrorProcessor
+func (bl *BaseListener) processHeader(ctx context.Context, header *types.Header) {
+	processor, ok := bl.impl.(HeaderErrorProcessor)
+	if !ok {
+		bl.impl.ProcessHeader(header)
+		bl.headerProcessed(header, nil)
+		return
+	}
+
+	backoff := headerProcessRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := processor.ProcessHeaderWithError(header)
+		if err == nil {
+			bl.headerProcessed(header, nil)
+			return
+		}
+
+		if !IsRetriableError(err) {
+			bl.Logger.Error("Error while processing header, skipping", "blockNumber", header.Number, "error", err)
+			bl.headerProcessed(header, err)
+			return
+		}
+
+		if attempt >= headerProcessMaxAttempts {
+			bl.Logger.Error("Header processing failed permanently, dropping header",
+				"blockNumber", header.Number, "attempts", attempt, "error", err)
+			bl.headerProcessed(header, err)
+			return
+		}
+
+		bl.Logger.Info("Retrying header processing", "blockNumber", header.Number, "attempt", attempt, "backoff", backoff, "error", err)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// errorListener fails header processing with queued errors
+type errorListener struct {
+	testListener
+
+	errs     []error
+	attempts int
+}
+
+func (el *errorListener) ProcessHeaderWithError(header *types.Header) error {
+	el.attempts++
+	if len(el.errs) == 0 {
+		return nil
+	}
+
+	err := el.errs[0]
+	el.errs = el.errs[1:]
+	return err
+}
+
+func newErrorListener(errs ...error) *errorListener {
+	el := &errorListener{errs: errs}
+	el.BaseListener = BaseListener{
+		Logger:        log.NewNopLogger(),
+		name:          "test",
+		quit:          make(chan struct{}),
+		impl:          el,
+		HeaderChannel: make(chan *types.Header),
+		errorLimiter:  &errorLogLimiter{},
+		commitMu:      &sync.Mutex{},
+	}
+	return el
+}
+
+func TestProcessHeaderRetry(t *testing.T) {
+	headerProcessRetryBackoff = time.Millisecond
+	header := &types.Header{Number: big.NewInt(1)}
+	transient := NewRetriableError(errors.New("rpc timeout"))
+
+	// transient failures are retried until success
+	el := newErrorListener(transient, transient)
+	el.processHeader(context.Background(), header)
+	require.Equal(t, 3, el.attempts)
+
+	// retries are bounded
+	el = newErrorListener(transient, transient, transient, transient)
+	el.processHeader(context.Background(), header)
+	require.Equal(t, headerProcessMaxAttempts, el.attempts)
+
+	// non retriable errors are skipped immediately
+	el = newErrorListener(errors.New("bad header"))
+	el.processHeader(context.Background(), header)
+	require.Equal(t, 1, el.attempts)
+}
+
+func TestProcessHeaderRetryListeners(t *testing.T) {
+	headerProcessRetryBackoff = time.Millisecond
+
+	// heimdall rest server is unavailable, params can't be fetched
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":"unavailable"}`))
+	}))
+	defer server.Close()
+
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.DeliveryServerURL = server.URL
+	conf.EthUnconfirmedTxsBusyLimit = 0
+	conf.TronUnconfirmedTxsBusyLimit = 0
+	helper.SetTestConfig(conf)
+	defer helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	newBase := func(impl Listener) BaseListener {
+		return BaseListener{
+			Logger:       util.Logger(),
+			name:         "test",
+			impl:         impl,
+			cliCtx:       cliContext.CLIContext{Codec: codec.New()},
+			errorLimiter: &errorLogLimiter{},
+			commitMu:     &sync.Mutex{},
+		}
+	}
+
+	rl := &RootChainListener{rootChainType: hmTypes.RootChainTypeEth, blockKey: lastEthBlockKey}
+	rl.BaseListener = newBase(rl)
+
+	tl := &TronListener{rootChainType: hmTypes.RootChainTypeTron}
+	tl.BaseListener = newBase(tl)
+
+	for _, listener := range []interface {
+		HeaderErrorProcessor
+		processHeader(context.Context, *types.Header)
+	}{rl, tl} {
+		atomic.StoreInt32(&requests, 0)
+
+		err := listener.ProcessHeaderWithError(&types.Header{Number: big.NewInt(100)})
+		require.True(t, IsRetriableError(err), "expected retriable error, got %v", err)
+
+		// every attempt fetches params again
+		atomic.StoreInt32(&requests, 0)
+		listener.processHeader(context.Background(), &types.Header{Number: big.NewInt(100)})
+		require.Equal(t, int32(headerProcessMaxAttempts), atomic.LoadInt32(&requests))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -93,17 +94,31 @@ func (rl *RootChainListener) Start() error {
 	headerCtx, cancelHeaderProcess := context.WithCancel(context.Background())
 	rl.cancelHeaderProcess = cancelHeaderProcess
 
-	// set start listen block
+	// poll interval may have been reloaded since listener was created
+	rl.pollInterval = listenerPollInterval(rl.name)
+
+	// resume from persisted cursor, start listen block only seeds first start
 	startListenBlock := rl.contractConnector.GetStartListenBlock(rl.rootChainType)
-	if startListenBlock != 0 {
-		_ = rl.setStartListenBLock(startListenBlock, rl.blockKey)
+	if err := rl.resumeCursor(startListenBlock); err != nil {
+		rl.Logger.Error("Error while resuming listener cursor", "root", rl.rootChainType, "error", err)
 	}
 
 	// start header process
 	go rl.StartHeaderProcess(headerCtx)
 
+	// classify subscription errors for root chain
+	rl.subscriptionErrorClassifier = GetSubscriptionErrorClassifier(rl.rootChainType)
+
+	// fail over between rpc endpoints
+	go rl.StartEndpointHealthCheck(ctx)
+
+	// poll for new headers if subscription breaks for good
+	rl.pollFallback = func(ctx context.Context) {
+		rl.StartPolling(ctx, rl.pollInterval, false)
+	}
+
 	// subscribe to new head
-	subscription, err := rl.chainClient.SubscribeNewHead(ctx, rl.HeaderChannel)
+	subscription, err := rl.subscribeNewHead(ctx)
 	if err != nil {
 		// start go routine to poll for new header using client object
 		rl.Logger.Info("Start polling for root chain header blocks",
@@ -122,6 +137,14 @@ func (rl *RootChainListener) Start() error {
 
 // ProcessHeader - process headerblock from rootchain
 func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
+	if err := rl.ProcessHeaderWithError(newHeader); err != nil {
+		rl.Logger.Error("Error while processing header", "root", rl.rootChainType, "blockNumber", newHeader.Number, "error", err)
+	}
+}
+
+// ProcessHeaderWithError implements HeaderErrorProcessor. Failures fetching params from heimdall
+// or logs from root chain are retriable, block cursor is only moved after logs are fetched.
+func (rl *RootChainListener) ProcessHeaderWithError(newHeader *ethTypes.Header) error {
 	rl.Logger.Debug("New block detected", "root", rl.rootChainType, "blockNumber", newHeader.Number)
 
 	// check if heimdall is busy
@@ -133,25 +156,14 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 		} else {
 			rl.stateSyncedCountWithDecay = 0
 		}
-		if rl.stateSyncedCountWithDecay > uint64(rl.busyLimit) {
-			rl.Logger.Debug("heimdall is busy now", "busyLimit", rl.busyLimit, "stateSyncedCountWithDecay", rl.stateSyncedCountWithDecay)
-			return
-		}
-
-		numUnconfirmedTxs, err := helper.GetNumUnconfirmedTxs(rl.cliCtx)
-		if err != nil {
-			rl.Logger.Debug("heimdall is busy now", "error", err)
-			return
-		}
-		if numUnconfirmedTxs.Total > rl.busyLimit {
-			rl.Logger.Debug("heimdall is busy now", "busyLimit", rl.busyLimit, "UnconfirmedTxs", numUnconfirmedTxs.Total)
-			return
+		if rl.heimdallBusy() {
+			return nil
 		}
 	}
 	// fetch context
 	rootchainContext, err := rl.getRootChainContext()
 	if err != nil {
-		return
+		return NewRetriableError(err)
 	}
 	requiredConfirmations := rootchainContext.ChainmanagerParams.MainchainTxConfirmations
 	latestNumber := newHeader.Number
@@ -162,7 +174,7 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 	if latestNumber.Cmp(confirmationBlocks) <= 0 {
 		rl.Logger.Error("Block number less than Confirmations required",
 			"root", rl.rootChainType, "blockNumber", latestNumber.Uint64, "confirmationsRequired", confirmationBlocks.Uint64)
-		return
+		return nil
 	}
 	latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
 
@@ -175,12 +187,13 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 		lastBlockBytes, err := rl.storageClient.Get([]byte(rl.blockKey), nil)
 		if err != nil {
 			rl.Logger.Info("Error while fetching last block bytes from storage", "root", rl.rootChainType, "error", err)
-			return
+			return err
 		}
 		rl.Logger.Debug("Got last block from bridge storage", "root", rl.rootChainType, "lastBlock", string(lastBlockBytes))
 		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
 			if result >= newHeader.Number.Uint64() {
-				return
+				rl.checkReorgDepth(result, newHeader.Number.Uint64())
+				return nil
 			}
 			if result+1 < fromBlock.Uint64() { // only start from solidity block
 				fromBlock = big.NewInt(0).SetUint64(result + 1)
@@ -195,14 +208,67 @@ func (rl *RootChainListener) ProcessHeader(newHeader *ethTypes.Header) {
 	if toBlock.Cmp(fromBlock) == -1 {
 		fromBlock = toBlock
 	}
-	if rl.maxQueryBlocks != 0 && big.NewInt(0).Sub(toBlock, fromBlock).Cmp(big.NewInt(rl.maxQueryBlocks)) > 0 {
-		toBlock = toBlock.Add(fromBlock, big.NewInt(rl.maxQueryBlocks))
+
+	// query events, listener far behind catches up in batches of max query blocks
+	return rl.ProcessBlockRange(fromBlock.Uint64(), toBlock.Uint64())
+}
+
+// OnReorg implements Listener. Tasks from orphaned blocks are dropped and block cursor
+// rewound, so logs of replacing blocks are queried again.
+func (rl *RootChainListener) OnReorg(oldHead *ethTypes.Header, newHead *ethTypes.Header) {
+	orphaned := rl.markOrphaned(oldHead, newHead)
+	if err := rl.rewindCursor(oldHead, orphaned); err != nil {
+		rl.Logger.Error("Error while rewinding block cursor on reorg", "root", rl.rootChainType, "error", err)
+	}
+}
+
+// heimdallBusy returns true if heimdall has too many pending txs to take more events
+func (rl *RootChainListener) heimdallBusy() bool {
+	if rl.busyLimit == 0 {
+		return false
+	}
+
+	if rl.stateSyncedCountWithDecay > uint64(rl.busyLimit) {
+		rl.Logger.Debug("heimdall is busy now", "busyLimit", rl.busyLimit, "stateSyncedCountWithDecay", rl.stateSyncedCountWithDecay)
+		return true
+	}
+
+	numUnconfirmedTxs, err := helper.GetNumUnconfirmedTxs(rl.cliCtx)
+	if err != nil {
+		rl.Logger.Debug("heimdall is busy now", "error", err)
+		return true
+	}
+	if numUnconfirmedTxs.Total > rl.busyLimit {
+		rl.Logger.Debug("heimdall is busy now", "busyLimit", rl.busyLimit, "UnconfirmedTxs", numUnconfirmedTxs.Total)
+		return true
+	}
+	return false
+}
+
+// BlockBatchSize implements BlockBatchProcessor
+func (rl *RootChainListener) BlockBatchSize() uint64 {
+	if rl.maxQueryBlocks <= 0 {
+		return 0
+	}
+	return uint64(rl.maxQueryBlocks)
+}
+
+// ProcessBlockBatch implements BlockBatchProcessor, querying logs of blocks from..to at once.
+// Pauses range processing while heimdall is busy.
+func (rl *RootChainListener) ProcessBlockBatch(from uint64, to uint64) error {
+	if rl.heimdallBusy() {
+		return errBackfillPaused
 	}
-	// query events
-	rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock)
+
+	rootchainContext, err := rl.getRootChainContext()
+	if err != nil {
+		return NewRetriableError(err)
+	}
+
+	return rl.queryAndBroadcastEvents(rootchainContext, new(big.Int).SetUint64(from), new(big.Int).SetUint64(to))
 }
 
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) error {
 	rl.Logger.Info("Query rootchain event logs", "root", rl.rootChainType, "fromBlock", fromBlock, "toBlock", toBlock)
 
 	// get chain params
@@ -218,16 +284,20 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 
 	query := ethereum.FilterQuery{FromBlock: fromBlock, ToBlock: toBlock, Addresses: queryAddresses}
 	// get logs from root chain by filter
-	logs, err := rl.chainClient.FilterLogs(context.Background(), query)
+	client := rl.client()
+	start := time.Now()
+	logs, err := client.FilterLogs(context.Background(), query)
+	rl.observeRPC("FilterLogs", start, err)
 	if err != nil {
 		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		rl.endpointFailed(client, err)
+		return NewRetriableError(err)
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
 	// set last block to storage
-	if err := rl.storageClient.Put([]byte(rl.blockKey), []byte(toBlock.String()), nil); err != nil {
+	if err := rl.commitLastBlock(rl.blockKey, toBlock); err != nil {
 		rl.Logger.Error("rl.storageClient.Put", "Error", err)
 	}
 
@@ -238,29 +308,31 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 			selectedEvent := helper.EventByID(abiObject, topic)
 			logBytes, _ := json.Marshal(vLog)
 			if selectedEvent != nil {
+				taskKey := queue.NewTaskKey(selectedEvent.Name, rl.rootChainType, vLog.BlockHash.Hex(), uint64(vLog.Index))
 				rl.Logger.Debug("ReceivedEvent", "eventname", selectedEvent.Name, "root", rl.rootChainType)
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "StateSynced":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
+						rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 						rl.stateSyncedCountWithDecay++
 					}
 				case "StakeAck":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStakingAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						rl.sendTaskWithDelay("sendStakingAckToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 				}
 			}
 		}
 	}
+	return nil
 }
 
-func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) {
+func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, taskKey string, delay time.Duration) {
 	signature := &tasks.Signature{
 		Name: taskName,
 		Args: []tasks.Arg{
@@ -284,9 +356,12 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	rl.Logger.Info("Sending task", "root", rl.rootChainType, "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := rl.queueConnector.Server.SendTask(signature)
+	sent, err := rl.queueConnector.SendTaskOnce(taskKey, signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
+		rl.publishFailed(taskName)
+	} else if !sent {
+		rl.Logger.Debug("Dropped duplicate task", "taskName", taskName, "eventName", eventName)
 	}
 }
 
@@ -1,6 +1,8 @@
 package listener
 
 import (
+	"sync"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -27,6 +29,12 @@ type ListenerService struct {
 	// Base service
 	common.BaseService
 	listeners []Listener
+
+	// guards started against concurrent config reloads
+	mu sync.Mutex
+
+	// listeners started by service by name
+	started map[string]bool
 }
 
 // NewListenerService returns new service object for listneing to events
@@ -35,7 +43,7 @@ func NewListenerService(cdc *codec.Codec, queueConnector *queue.QueueConnector,
 	var logger = util.Logger().With("service", ListenerServiceStr)
 
 	// creating listener object
-	listenerService := &ListenerService{}
+	listenerService := &ListenerService{started: make(map[string]bool)}
 
 	listenerService.BaseService = *common.NewBaseService(logger, ListenerServiceStr, listenerService)
 
@@ -68,17 +76,32 @@ func (listenerService *ListenerService) OnStart() error {
 		listenerService.Logger.Error("OnStart | OnStart", "Error", err)
 	} // Always call the overridden method.
 
-	// start chain listeners
+	// start chain listeners enabled in config
+	listenerService.mu.Lock()
 	for _, listener := range listenerService.listeners {
-		if err := listener.Start(); err != nil {
-			listenerService.Logger.Error("OnStart | Start", "Error", err)
+		if !listenerEnabled(listener.String()) {
+			listenerService.Logger.Info("Listener disabled in config, not starting", "listener", listener.String())
+			continue
 		}
+		listenerService.startListener(listener)
 	}
+	listenerService.mu.Unlock()
 
 	listenerService.Logger.Info("all listeners Started")
 	return nil
 }
 
+// startListener starts listener in background, heimdall listener blocks in Start while polling.
+// Caller holds mu.
+func (listenerService *ListenerService) startListener(listener Listener) {
+	listenerService.started[listener.String()] = true
+	go func() {
+		if err := listener.Start(); err != nil {
+			listenerService.Logger.Error("OnStart | Start", "Error", err)
+		}
+	}()
+}
+
 // OnStop stops all necessary go routines
 func (listenerService *ListenerService) OnStop() {
 	listenerService.BaseService.OnStop() // Always call the overridden method.
@@ -0,0 +1,182 @@
+package listener
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// storageProbeKey is reserved storage key used to check bridge storage on startup
+const storageProbeKey = "listener-storage-probe"
+
+// probeStorage writes reserved key and reads it back to make sure bridge storage is usable
+func probeStorage(db *leveldb.DB) error {
+	if db == nil {
+		return errors.New("bridge storage is not opened, check bridge db path and permissions")
+	}
+
+	value := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err := db.Put([]byte(storageProbeKey), value, nil); err != nil {
+		return fmt.Errorf("bridge storage is not writable: %v", err)
+	}
+
+	stored, err := db.Get([]byte(storageProbeKey), nil)
+	if err != nil {
+		return fmt.Errorf("bridge storage is not readable: %v", err)
+	}
+
+	if !bytes.Equal(stored, value) {
+		return fmt.Errorf("bridge storage returned %q for probe key, expected %q", stored, value)
+	}
+
+	return db.Delete([]byte(storageProbeKey), nil)
+}
+
+// listenerStateKeys are storage keys holding listener cursors
+var listenerStateKeys = []string{
+	lastEthBlockKey,
+	lastBscBlockKey,
+	tronLastBlockKey,
+	heimdallLastBlockKey,
+}
+
+// rewindForReplay moves listener cursor stored under key back by configured replay depth, so
+// processing resumes from lastProcessed - ReplayDepth and blocks processed right before restart
+// are processed again to catch reorgs during downtime. Cursor never goes below floor.
+func (bl *BaseListener) rewindForReplay(key string, floor uint64) error {
+	replayDepth := helper.GetConfig().ReplayDepth
+	if replayDepth == 0 {
+		return nil
+	}
+
+	value, err := bl.storageClient.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	lastProcessed, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid block number %q for key %s: %v", string(value), key, err)
+	}
+
+	// processing resumes at cursor + 1
+	var cursor uint64
+	if lastProcessed > replayDepth {
+		cursor = lastProcessed - replayDepth - 1
+	}
+	if cursor < floor {
+		cursor = floor
+	}
+	if cursor >= lastProcessed {
+		return nil
+	}
+
+	bl.Logger.Info("Rewinding listener to replay recent blocks",
+		"key", key, "lastProcessed", lastProcessed, "resumeFrom", cursor+1, "replayDepth", replayDepth)
+	return bl.storageClient.Put([]byte(key), []byte(strconv.FormatUint(cursor, 10)), nil)
+}
+
+// ExportListenerState returns all listener cursors found in bridge storage
+func (bl *BaseListener) ExportListenerState() (map[string]uint64, error) {
+	state := make(map[string]uint64)
+
+	for _, key := range listenerStateKeys {
+		has, err := bl.storageClient.Has([]byte(key), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if !has {
+			continue
+		}
+
+		value, err := bl.storageClient.Get([]byte(key), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		block, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block number %q for key %s: %v", string(value), key, err)
+		}
+
+		state[key] = block
+	}
+
+	return state, nil
+}
+
+// ImportListenerState restores listener cursors into bridge storage.
+// Nothing is written if any cursor is unknown or above current tip of its chain.
+func (bl *BaseListener) ImportListenerState(state map[string]uint64) error {
+	batch := new(leveldb.Batch)
+
+	for key, block := range state {
+		if !isListenerStateKey(key) {
+			return fmt.Errorf("unknown listener state key %s", key)
+		}
+
+		tip, err := bl.getListenerTip(key)
+		if err != nil {
+			return fmt.Errorf("unable to fetch tip for key %s: %v", key, err)
+		}
+
+		if block > tip {
+			return fmt.Errorf("block %d for key %s is above current tip %d", block, key, tip)
+		}
+
+		batch.Put([]byte(key), []byte(strconv.FormatUint(block, 10)))
+	}
+
+	return bl.storageClient.Write(batch, nil)
+}
+
+func isListenerStateKey(key string) bool {
+	for _, k := range listenerStateKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// listenerTip returns latest block number of the chain listener state key belongs to
+func (bl *BaseListener) listenerTip(key string) (uint64, error) {
+	switch key {
+	case lastEthBlockKey:
+		header, err := helper.GetMainClient().HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return 0, err
+		}
+		return header.Number.Uint64(), nil
+	case lastBscBlockKey:
+		header, err := helper.GetBscClient().HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return 0, err
+		}
+		return header.Number.Uint64(), nil
+	case tronLastBlockKey:
+		number, err := bl.contractConnector.GetTronLatestBlockNumber(context.Background())
+		if err != nil {
+			return 0, err
+		}
+		return uint64(number), nil
+	case heimdallLastBlockKey:
+		status, err := bl.httpClient.Status()
+		if err != nil {
+			return 0, err
+		}
+		return uint64(status.SyncInfo.LatestBlockHeight), nil
+	}
+
+	return 0, fmt.Errorf("unknown listener state key %s", key)
+}
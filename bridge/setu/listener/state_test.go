@@ -0,0 +1,131 @@
+package listener
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestExportImportListenerState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bridge-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	tl := newTestListener()
+	tl.storageClient = db
+	tl.getListenerTip = func(key string) (uint64, error) {
+		return 1000, nil
+	}
+
+	// empty storage
+	state, err := tl.ExportListenerState()
+	require.NoError(t, err)
+	require.Empty(t, state)
+
+	// import and export round trip
+	err = tl.ImportListenerState(map[string]uint64{lastEthBlockKey: 100, tronLastBlockKey: 200})
+	require.NoError(t, err)
+
+	state, err = tl.ExportListenerState()
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{lastEthBlockKey: 100, tronLastBlockKey: 200}, state)
+
+	// value above tip is rejected and nothing is written
+	err = tl.ImportListenerState(map[string]uint64{lastBscBlockKey: 2000})
+	require.Error(t, err)
+
+	// unknown key is rejected
+	err = tl.ImportListenerState(map[string]uint64{"unknown-key": 1})
+	require.Error(t, err)
+
+	state, err = tl.ExportListenerState()
+	require.NoError(t, err)
+	require.Len(t, state, 2)
+
+	// unparseable value fails export
+	require.NoError(t, db.Put([]byte(heimdallLastBlockKey), []byte("abc"), nil))
+	_, err = tl.ExportListenerState()
+	require.Error(t, err)
+}
+
+func TestProbeStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bridge-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+
+	// usable storage, probe key is removed
+	require.NoError(t, probeStorage(db))
+	has, err := db.Has([]byte(storageProbeKey), nil)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// closed storage
+	require.NoError(t, db.Close())
+	require.Error(t, probeStorage(db))
+
+	// storage not opened
+	require.Error(t, probeStorage(nil))
+}
+
+func TestRewindForReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bridge-db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := leveldb.OpenFile(dir, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	tl := newTestListener()
+	tl.storageClient = db
+
+	cursor := func() string {
+		value, err := db.Get([]byte(lastEthBlockKey), nil)
+		require.NoError(t, err)
+		return string(value)
+	}
+
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.ReplayDepth = 10
+	helper.SetTestConfig(conf)
+	defer helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	// nothing stored yet
+	require.NoError(t, tl.rewindForReplay(lastEthBlockKey, 0))
+	has, err := db.Has([]byte(lastEthBlockKey), nil)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// processing resumes from last processed - replay depth
+	require.NoError(t, db.Put([]byte(lastEthBlockKey), []byte("100"), nil))
+	require.NoError(t, tl.rewindForReplay(lastEthBlockKey, 0))
+	require.Equal(t, "89", cursor())
+
+	// clamped to start block floor
+	require.NoError(t, db.Put([]byte(lastEthBlockKey), []byte("100"), nil))
+	require.NoError(t, tl.rewindForReplay(lastEthBlockKey, 95))
+	require.Equal(t, "95", cursor())
+
+	// clamped to genesis
+	require.NoError(t, db.Put([]byte(lastEthBlockKey), []byte("5"), nil))
+	require.NoError(t, tl.rewindForReplay(lastEthBlockKey, 0))
+	require.Equal(t, "0", cursor())
+
+	// disabled replay leaves cursor untouched
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	require.NoError(t, db.Put([]byte(lastEthBlockKey), []byte("100"), nil))
+	require.NoError(t, tl.rewindForReplay(lastEthBlockKey, 0))
+	require.Equal(t, "100", cursor())
+}
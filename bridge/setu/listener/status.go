@@ -0,0 +1,55 @@
+package listener
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ListenerStatus is listener state reported by bridge status endpoint
+type ListenerStatus struct {
+	Name               string     `json:"name"`
+	Running            bool       `json:"running"`
+	Halted             bool       `json:"halted"`
+	BackpressurePaused bool       `json:"backpressure_paused"`
+	LastHeaderTime     *time.Time `json:"last_header_time,omitempty"`
+	LastProcessedBlock uint64     `json:"last_processed_block"`
+}
+
+// headerReceived records time listener last received a header
+func (bl *BaseListener) headerReceived() {
+	atomic.StoreInt64(&bl.lastHeaderAt, time.Now().UnixNano())
+}
+
+// Status returns current state of listener. Last processed block falls back to
+// persisted cursor until listener processes a block.
+func (bl *BaseListener) Status() ListenerStatus {
+	status := ListenerStatus{
+		Name:               bl.name,
+		Running:            atomic.LoadInt32(&bl.running) > 0,
+		Halted:             bl.IsHalted(),
+		BackpressurePaused: bl.IsBackpressurePaused(),
+		LastProcessedBlock: atomic.LoadUint64(&bl.lastProcessed),
+	}
+
+	if lastHeaderAt := atomic.LoadInt64(&bl.lastHeaderAt); lastHeaderAt != 0 {
+		lastHeaderTime := time.Unix(0, lastHeaderAt).UTC()
+		status.LastHeaderTime = &lastHeaderTime
+	}
+
+	if status.LastProcessedBlock == 0 && bl.storageClient != nil {
+		if block, found, err := bl.LastProcessedBlock(); err == nil && found {
+			status.LastProcessedBlock = block
+		}
+	}
+
+	return status
+}
+
+// Status returns state of all listeners
+func (listenerService *ListenerService) Status() []ListenerStatus {
+	statuses := make([]ListenerStatus, 0, len(listenerService.listeners))
+	for _, listener := range listenerService.listeners {
+		statuses = append(statuses, listener.Status())
+	}
+	return statuses
+}
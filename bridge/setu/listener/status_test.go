@@ -0,0 +1,41 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenerStatus(t *testing.T) {
+	tl := newTestListener()
+
+	status := tl.Status()
+	require.Equal(t, "test", status.Name)
+	require.False(t, status.Running)
+	require.Nil(t, status.LastHeaderTime)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tl.StartHeaderProcess(context.Background())
+	}()
+
+	tl.HeaderChannel <- &types.Header{Number: big.NewInt(5)}
+	require.True(t, tl.Status().Running)
+
+	close(tl.HeaderChannel)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("header process did not stop on closed channel")
+	}
+
+	status = tl.Status()
+	require.False(t, status.Running)
+	require.NotNil(t, status.LastHeaderTime)
+	require.Equal(t, uint64(5), status.LastProcessedBlock)
+}
@@ -0,0 +1,125 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// subscriptionMaxResubscribes is the max number of re-subscribe attempts after a recoverable error
+	subscriptionMaxResubscribes = 5
+
+	// subscriptionResubscribeBackoff is the wait before first re-subscribe, doubled on every attempt
+	subscriptionResubscribeBackoff = 2 * time.Second
+
+	// subscriptionMaxResubscribeBackoff caps the wait between re-subscribe attempts
+	subscriptionMaxResubscribeBackoff = time.Minute
+)
+
+// SubscriptionErrorClassifier returns true if subscription error is recoverable
+type SubscriptionErrorClassifier func(err error) bool
+
+var (
+	subscriptionClassifiersMu sync.RWMutex
+	subscriptionClassifiers   = map[string]SubscriptionErrorClassifier{}
+)
+
+// RegisterSubscriptionErrorClassifier registers subscription error classifier for root chain
+func RegisterSubscriptionErrorClassifier(rootChain string, classifier SubscriptionErrorClassifier) {
+	subscriptionClassifiersMu.Lock()
+	defer subscriptionClassifiersMu.Unlock()
+
+	subscriptionClassifiers[rootChain] = classifier
+}
+
+// GetSubscriptionErrorClassifier returns subscription error classifier for root chain, default otherwise
+func GetSubscriptionErrorClassifier(rootChain string) SubscriptionErrorClassifier {
+	subscriptionClassifiersMu.RLock()
+	defer subscriptionClassifiersMu.RUnlock()
+
+	if classifier, ok := subscriptionClassifiers[rootChain]; ok {
+		return classifier
+	}
+	return DefaultSubscriptionErrorClassifier
+}
+
+// DefaultSubscriptionErrorClassifier treats network timeouts and dropped connections as recoverable
+func DefaultSubscriptionErrorClassifier(err error) bool {
+	if err == nil || errors.Is(err, rpc.ErrClientQuit) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"connection reset", "broken pipe", "use of closed network connection", "timeout"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// resubscribe attempts to re-subscribe new heads with backoff after recoverable error
+func (bl *BaseListener) resubscribe(ctx context.Context) (ethereum.Subscription, bool) {
+	if bl.subscribeNewHead == nil {
+		return nil, false
+	}
+
+	backoff := subscriptionResubscribeBackoff
+	for attempt := 1; attempt <= subscriptionMaxResubscribes; attempt++ {
+		select {
+		case <-time.After(backoff):
+			if backoff *= 2; backoff > subscriptionMaxResubscribeBackoff {
+				backoff = subscriptionMaxResubscribeBackoff
+			}
+		case <-ctx.Done():
+			return nil, false
+		}
+
+		subscription, err := bl.subscribeNewHead(ctx)
+		if err == nil {
+			resubscribeCounter.WithLabelValues(bl.name, "success").Inc()
+			bl.Logger.Info("Re-subscribed to new head", "attempt", attempt)
+			return subscription, true
+		}
+		resubscribeCounter.WithLabelValues(bl.name, "failure").Inc()
+		bl.Logger.Error("Error while re-subscribing new blocks", "attempt", attempt, "backoff", backoff, "error", err)
+	}
+	return nil, false
+}
+
+// fallBackToPolling polls new headers until ctx is done once subscription could not be
+// recovered. Returns false if listener has no polling fallback.
+func (bl *BaseListener) fallBackToPolling(ctx context.Context) bool {
+	if bl.pollFallback == nil {
+		return false
+	}
+
+	bl.Logger.Info("Subscription could not be recovered, falling back to polling for new headers")
+	bl.pollFallback(ctx)
+	return true
+}
+
+// subscriptionErrorRecoverable classifies subscription error with listener classifier
+func (bl *BaseListener) subscriptionErrorRecoverable(err error) bool {
+	if bl.subscriptionErrorClassifier == nil {
+		return DefaultSubscriptionErrorClassifier(err)
+	}
+	return bl.subscriptionErrorClassifier(err)
+}
@@ -0,0 +1,141 @@
+package listener
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSubscription emits errors pushed to its error channel
+type fakeSubscription struct {
+	errCh        chan error
+	unsubscribed bool
+}
+
+func newFakeSubscription() *fakeSubscription {
+	return &fakeSubscription{errCh: make(chan error, 1)}
+}
+
+func (s *fakeSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+func (s *fakeSubscription) Unsubscribe() {
+	s.unsubscribed = true
+}
+
+func TestDefaultSubscriptionErrorClassifier(t *testing.T) {
+	require.True(t, DefaultSubscriptionErrorClassifier(io.EOF))
+	require.True(t, DefaultSubscriptionErrorClassifier(errors.New("read tcp: connection reset by peer")))
+	require.True(t, DefaultSubscriptionErrorClassifier(context.DeadlineExceeded))
+
+	require.False(t, DefaultSubscriptionErrorClassifier(nil))
+	require.False(t, DefaultSubscriptionErrorClassifier(rpc.ErrClientQuit))
+	require.False(t, DefaultSubscriptionErrorClassifier(errors.New("notifications not supported")))
+}
+
+func TestStartSubscriptionResubscribe(t *testing.T) {
+	backoff := subscriptionResubscribeBackoff
+	subscriptionResubscribeBackoff = time.Millisecond
+	defer func() { subscriptionResubscribeBackoff = backoff }()
+
+	tl := newTestListener()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resubscribed := newFakeSubscription()
+	resubscribes := 0
+	tl.subscribeNewHead = func(ctx context.Context) (ethereum.Subscription, error) {
+		resubscribes++
+		return resubscribed, nil
+	}
+
+	cancelled := false
+	tl.cancelSubscription = func() { cancelled = true }
+
+	// recoverable error re-subscribes
+	first := newFakeSubscription()
+	first.errCh <- io.EOF
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tl.StartSubscription(ctx, first)
+	}()
+
+	// fatal error on new subscription tears down
+	resubscribed.errCh <- rpc.ErrClientQuit
+	<-done
+
+	require.True(t, first.unsubscribed)
+	require.Equal(t, 1, resubscribes)
+	require.True(t, cancelled)
+}
+
+func TestStartSubscriptionCustomClassifier(t *testing.T) {
+	tl := newTestListener()
+
+	resubscribes := 0
+	tl.subscribeNewHead = func(ctx context.Context) (ethereum.Subscription, error) {
+		resubscribes++
+		return newFakeSubscription(), nil
+	}
+
+	cancelled := false
+	tl.cancelSubscription = func() { cancelled = true }
+
+	// classifier treating every error as fatal
+	tl.subscriptionErrorClassifier = func(err error) bool { return false }
+
+	subscription := newFakeSubscription()
+	subscription.errCh <- io.EOF
+	tl.StartSubscription(context.Background(), subscription)
+
+	require.Equal(t, 0, resubscribes)
+	require.True(t, cancelled)
+}
+
+func TestStartSubscriptionFallsBackToPolling(t *testing.T) {
+	backoff := subscriptionResubscribeBackoff
+	subscriptionResubscribeBackoff = time.Millisecond
+	defer func() { subscriptionResubscribeBackoff = backoff }()
+
+	tl := newTestListener()
+	tl.name = "fallback-test"
+
+	resubscribes := 0
+	tl.subscribeNewHead = func(ctx context.Context) (ethereum.Subscription, error) {
+		resubscribes++
+		return nil, errors.New("dial tcp: connection refused")
+	}
+
+	cancelled := false
+	tl.cancelSubscription = func() { cancelled = true }
+
+	polled := false
+	tl.pollFallback = func(ctx context.Context) {
+		polled = true
+		tl.setHeaderSource(headerSourcePolling)
+	}
+
+	subscription := newFakeSubscription()
+	subscription.errCh <- io.EOF
+	tl.StartSubscription(context.Background(), subscription)
+
+	// listener keeps running by polling after re-subscribes are exhausted
+	require.True(t, subscription.unsubscribed)
+	require.Equal(t, subscriptionMaxResubscribes, resubscribes)
+	require.True(t, polled)
+	require.False(t, cancelled)
+
+	require.Equal(t, float64(subscriptionMaxResubscribes), testutil.ToFloat64(resubscribeCounter.WithLabelValues(tl.name, "failure")))
+	require.Equal(t, float64(1), testutil.ToFloat64(headerSourceGauge.WithLabelValues(tl.name, headerSourcePolling)))
+	require.Equal(t, float64(0), testutil.ToFloat64(headerSourceGauge.WithLabelValues(tl.name, headerSourceSubscription)))
+}
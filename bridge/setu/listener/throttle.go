@@ -0,0 +1,164 @@
+package listener
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// maxPendingHeaders bounds headers awaiting confirmation, oldest are forgotten first
+const maxPendingHeaders = 10000
+
+// pendingHeader is a processed header awaiting downstream confirmation
+type pendingHeader struct {
+	number      uint64
+	processedAt time.Time
+}
+
+// latencyThrottle tracks moving average latency between header processing and
+// downstream confirmation, and slows header intake when it exceeds threshold
+type latencyThrottle struct {
+	mu      sync.Mutex
+	pending []pendingHeader
+	average time.Duration
+
+	// set to 1 while header intake is slowed down
+	throttled int32
+}
+
+// newLatencyThrottle creates latency throttle
+func newLatencyThrottle() *latencyThrottle {
+	return &latencyThrottle{}
+}
+
+// latencyThrottleEnabled returns true if listener tracks confirmation latency
+func (bl *BaseListener) latencyThrottleEnabled() bool {
+	return bl.latency != nil && helper.GetConfig().LatencyThrottleThreshold > 0
+}
+
+// markHeaderProcessed starts measuring confirmation latency of header
+func (bl *BaseListener) markHeaderProcessed(header *types.Header) {
+	if !bl.latencyThrottleEnabled() || header.Number == nil {
+		return
+	}
+
+	lt := bl.latency
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	lt.pending = append(lt.pending, pendingHeader{number: header.Number.Uint64(), processedAt: time.Now()})
+	if len(lt.pending) > maxPendingHeaders {
+		lt.pending = lt.pending[len(lt.pending)-maxPendingHeaders:]
+	}
+}
+
+// ConfirmHeaders is called by concrete listener once work produced from headers up to
+// block is confirmed downstream (eg. checkpoint covering block is acked on root chain).
+// Latency of highest confirmed header is fed into moving average.
+func (bl *BaseListener) ConfirmHeaders(block uint64) {
+	if !bl.latencyThrottleEnabled() {
+		return
+	}
+
+	lt := bl.latency
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	var confirmed *pendingHeader
+	remaining := lt.pending[:0]
+	for i := range lt.pending {
+		header := lt.pending[i]
+		if header.number > block {
+			remaining = append(remaining, header)
+			continue
+		}
+		if confirmed == nil || header.number > confirmed.number {
+			confirmed = &header
+		}
+	}
+	lt.pending = remaining
+
+	if confirmed == nil {
+		return
+	}
+
+	sample := time.Since(confirmed.processedAt)
+	if lt.average == 0 {
+		lt.average = sample
+	} else {
+		smoothing := helper.GetConfig().LatencyThrottleSmoothing
+		if smoothing <= 0 || smoothing > 1 {
+			smoothing = helper.DefaultLatencyThrottleSmoothing
+		}
+		lt.average += time.Duration(smoothing * float64(sample-lt.average))
+	}
+
+	threshold := helper.GetConfig().LatencyThrottleThreshold
+	if lt.average > threshold {
+		if atomic.CompareAndSwapInt32(&lt.throttled, 0, 1) {
+			bl.Logger.Info("Confirmation latency above threshold, throttling header intake",
+				"latency", lt.average, "threshold", threshold, "confirmedBlock", confirmed.number)
+		}
+	} else if atomic.CompareAndSwapInt32(&lt.throttled, 1, 0) {
+		bl.Logger.Info("Confirmation latency below threshold, header intake back to normal",
+			"latency", lt.average, "threshold", threshold, "confirmedBlock", confirmed.number)
+	}
+
+	bl.Logger.Debug("Header confirmed", "blockNumber", confirmed.number, "latency", sample, "averageLatency", lt.average)
+}
+
+// ConfirmationLatency returns moving average latency between header processing and confirmation
+func (bl *BaseListener) ConfirmationLatency() time.Duration {
+	if bl.latency == nil {
+		return 0
+	}
+
+	bl.latency.mu.Lock()
+	defer bl.latency.mu.Unlock()
+	return bl.latency.average
+}
+
+// IsLatencyThrottled returns true while header intake is slowed down by confirmation latency
+func (bl *BaseListener) IsLatencyThrottled() bool {
+	return bl.latency != nil && atomic.LoadInt32(&bl.latency.throttled) == 1
+}
+
+// latencyThrottleDelay returns how long to hold next header, growing with
+// latency excess over threshold up to max delay
+func (bl *BaseListener) latencyThrottleDelay() time.Duration {
+	if !bl.latencyThrottleEnabled() || !bl.IsLatencyThrottled() {
+		return 0
+	}
+
+	delay := bl.ConfirmationLatency() - helper.GetConfig().LatencyThrottleThreshold
+	if maxDelay := helper.GetConfig().LatencyThrottleMaxDelay; maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// waitForLatencyThrottle holds header intake while confirmation latency is above threshold.
+// Returns false if ctx is done while waiting.
+func (bl *BaseListener) waitForLatencyThrottle(ctx context.Context) bool {
+	delay := bl.latencyThrottleDelay()
+	if delay <= 0 {
+		return true
+	}
+
+	bl.Logger.Debug("Throttling header intake", "delay", delay, "latency", bl.ConfirmationLatency())
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
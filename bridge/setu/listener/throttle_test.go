@@ -0,0 +1,70 @@
+package listener
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestLatencyThrottle(t *testing.T) {
+	conf := helper.GetDefaultHeimdallConfig()
+	conf.LatencyThrottleThreshold = 50 * time.Millisecond
+	conf.LatencyThrottleMaxDelay = 20 * time.Millisecond
+	conf.LatencyThrottleSmoothing = 1
+	helper.SetTestConfig(conf)
+	defer helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	tl := newTestListener()
+	tl.latency = newLatencyThrottle()
+
+	// fast confirmation doesn't throttle
+	tl.markHeaderProcessed(&types.Header{Number: big.NewInt(1)})
+	tl.ConfirmHeaders(1)
+	require.False(t, tl.IsLatencyThrottled())
+	require.Equal(t, time.Duration(0), tl.latencyThrottleDelay())
+
+	// slow confirmation throttles, delay is capped
+	tl.markHeaderProcessed(&types.Header{Number: big.NewInt(2)})
+	tl.markHeaderProcessed(&types.Header{Number: big.NewInt(3)})
+	time.Sleep(100 * time.Millisecond)
+	tl.ConfirmHeaders(2)
+	require.True(t, tl.IsLatencyThrottled())
+	require.True(t, tl.ConfirmationLatency() >= 100*time.Millisecond)
+	require.Equal(t, conf.LatencyThrottleMaxDelay, tl.latencyThrottleDelay())
+	require.Len(t, tl.latency.pending, 1, "only unconfirmed header stays pending")
+
+	// throttled listener waits, cancelled context stops waiting
+	start := time.Now()
+	require.True(t, tl.waitForLatencyThrottle(context.Background()))
+	require.True(t, time.Since(start) >= conf.LatencyThrottleMaxDelay)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.False(t, tl.waitForLatencyThrottle(ctx))
+
+	// fast confirmation lifts throttle
+	tl.markHeaderProcessed(&types.Header{Number: big.NewInt(4)})
+	tl.ConfirmHeaders(4)
+	require.False(t, tl.IsLatencyThrottled())
+	require.Empty(t, tl.latency.pending)
+}
+
+func TestLatencyThrottleDisabled(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	tl := newTestListener()
+	tl.latency = newLatencyThrottle()
+
+	tl.markHeaderProcessed(&types.Header{Number: big.NewInt(1)})
+	require.Empty(t, tl.latency.pending)
+
+	tl.ConfirmHeaders(1)
+	require.False(t, tl.IsLatencyThrottled())
+	require.True(t, tl.waitForLatencyThrottle(context.Background()))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
@@ -59,10 +60,10 @@ func (tl *TronListener) Start() error {
 	headerCtx, cancelHeaderProcess := context.WithCancel(context.Background())
 	tl.cancelHeaderProcess = cancelHeaderProcess
 
-	// set start listen block
+	// resume from persisted cursor, start listen block only seeds first start
 	startListenBlock := tl.contractConnector.GetStartListenBlock(tl.rootChainType)
-	if startListenBlock != 0 {
-		_ = tl.setStartListenBLock(startListenBlock, tronLastBlockKey)
+	if err := tl.resumeCursor(startListenBlock); err != nil {
+		tl.Logger.Error("Error while resuming listener cursor", "error", err)
 	}
 	// start header process
 	go tl.StartHeaderProcess(headerCtx)
@@ -102,8 +103,10 @@ func (tl *TronListener) StartPolling(ctx context.Context, pollInterval time.Dura
 			tickerOnce.Do(func() {
 				ticker.Reset(interval)
 			})
-			headerNum, err := tl.contractConnector.GetTronLatestBlockNumber()
-			if err == nil {
+			headerNum, err := tl.contractConnector.GetTronLatestBlockNumber(ctx)
+			if err != nil {
+				tl.logErrorRateLimited("Error while fetching latest tron block number", err)
+			} else {
 				// send data to channel
 				tl.HeaderChannel <- &(ethTypes.Header{
 					Number: big.NewInt(headerNum),
@@ -120,25 +123,24 @@ func (tl *TronListener) StartPolling(ctx context.Context, pollInterval time.Dura
 
 // ProcessHeader - process headerblock from rootchain
 func (tl *TronListener) ProcessHeader(newHeader *ethTypes.Header) {
+	if err := tl.ProcessHeaderWithError(newHeader); err != nil {
+		tl.Logger.Error("Error while processing header", "blockNumber", newHeader.Number, "error", err)
+	}
+}
+
+// ProcessHeaderWithError implements HeaderErrorProcessor. Failures fetching params from heimdall
+// or logs from tron are retriable, block cursor is only moved after logs are fetched.
+func (tl *TronListener) ProcessHeaderWithError(newHeader *ethTypes.Header) error {
 	tl.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
 
-	busyLimit := helper.GetConfig().TronUnconfirmedTxsBusyLimit
 	// check if heimdall is busy
-	if busyLimit != 0 {
-		numUnconfirmedTxs, err := helper.GetNumUnconfirmedTxs(tl.cliCtx)
-		if err != nil {
-			tl.Logger.Debug("delivery is busy now", "error", err)
-			return
-		}
-		if numUnconfirmedTxs.Total > busyLimit {
-			tl.Logger.Debug("delivery is busy now", "UnconfirmedTxs", numUnconfirmedTxs.Total)
-			return
-		}
+	if tl.heimdallBusy() {
+		return nil
 	}
 	// fetch context
 	chainManagerParams, err := tl.getChainManagerParams()
 	if err != nil {
-		return
+		return NewRetriableError(err)
 	}
 	latestNumber := newHeader.Number
 	// confirmation
@@ -146,7 +148,7 @@ func (tl *TronListener) ProcessHeader(newHeader *ethTypes.Header) {
 
 	if latestNumber.Cmp(confirmationBlocks) <= 0 {
 		tl.Logger.Error("Block number less than Confirmations required", "blockNumber", latestNumber.Uint64, "confirmationsRequired", confirmationBlocks.Uint64)
-		return
+		return nil
 	}
 	latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
 
@@ -159,12 +161,13 @@ func (tl *TronListener) ProcessHeader(newHeader *ethTypes.Header) {
 		lastBlockBytes, err := tl.storageClient.Get([]byte(tronLastBlockKey), nil)
 		if err != nil {
 			tl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return
+			return err
 		}
 		tl.Logger.Debug("Got last block from bridge storage", "lastBlock", string(lastBlockBytes))
 		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
 			if result >= newHeader.Number.Uint64() {
-				return
+				tl.checkReorgDepth(result, newHeader.Number.Uint64())
+				return nil
 			}
 			if result+1 < fromBlock.Uint64() { // only start from solidity block
 				fromBlock = big.NewInt(0).SetUint64(result + 1)
@@ -178,15 +181,54 @@ func (tl *TronListener) ProcessHeader(newHeader *ethTypes.Header) {
 	if toBlock.Cmp(fromBlock) == -1 {
 		fromBlock = toBlock
 	}
-	maxQueryBlocks := helper.GetConfig().TronMaxQueryBlocks
-	if maxQueryBlocks != 0 && big.NewInt(0).Sub(toBlock, fromBlock).Cmp(big.NewInt(maxQueryBlocks)) > 0 {
-		toBlock = toBlock.Add(fromBlock, big.NewInt(maxQueryBlocks))
+
+	// query events, listener far behind catches up in batches of max query blocks
+	return tl.ProcessBlockRange(fromBlock.Uint64(), toBlock.Uint64())
+}
+
+// heimdallBusy returns true if heimdall has too many pending txs to take more events
+func (tl *TronListener) heimdallBusy() bool {
+	busyLimit := helper.GetConfig().TronUnconfirmedTxsBusyLimit
+	if busyLimit == 0 {
+		return false
+	}
+
+	numUnconfirmedTxs, err := helper.GetNumUnconfirmedTxs(tl.cliCtx)
+	if err != nil {
+		tl.Logger.Debug("delivery is busy now", "error", err)
+		return true
+	}
+	if numUnconfirmedTxs.Total > busyLimit {
+		tl.Logger.Debug("delivery is busy now", "UnconfirmedTxs", numUnconfirmedTxs.Total)
+		return true
+	}
+	return false
+}
+
+// BlockBatchSize implements BlockBatchProcessor
+func (tl *TronListener) BlockBatchSize() uint64 {
+	if maxQueryBlocks := helper.GetConfig().TronMaxQueryBlocks; maxQueryBlocks > 0 {
+		return uint64(maxQueryBlocks)
 	}
-	// query events
-	tl.queryAndBroadcastEvents(chainManagerParams, fromBlock, toBlock)
+	return 0
 }
 
-func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanagerTypes.Params, fromBlock *big.Int, toBlock *big.Int) {
+// ProcessBlockBatch implements BlockBatchProcessor, querying tron events of blocks from..to at once.
+// Pauses range processing while heimdall is busy.
+func (tl *TronListener) ProcessBlockBatch(from uint64, to uint64) error {
+	if tl.heimdallBusy() {
+		return errBackfillPaused
+	}
+
+	chainManagerParams, err := tl.getChainManagerParams()
+	if err != nil {
+		return NewRetriableError(err)
+	}
+
+	return tl.queryAndBroadcastEvents(chainManagerParams, new(big.Int).SetUint64(from), new(big.Int).SetUint64(to))
+}
+
+func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanagerTypes.Params, fromBlock *big.Int, toBlock *big.Int) error {
 	tl.Logger.Info("Query tron event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	var tronContractAddresses []string
@@ -195,16 +237,16 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 	tronContractAddresses = append(tronContractAddresses, chainManagerParams.ChainParams.TronStakingInfoAddress)
 	// current public key
 	pubkeyBytes := helper.GetPubKey().Bytes()
-	logs, err := tl.contractConnector.GetTronEventsByContractAddress(tronContractAddresses, fromBlock.Int64(), toBlock.Int64())
+	logs, err := tl.contractConnector.GetTronEventsByContractAddress(context.Background(), tronContractAddresses, fromBlock.Int64(), toBlock.Int64())
 	if err != nil {
 		tl.Logger.Error("Error while query tron logs", "error", err)
-		return
+		return NewRetriableError(err)
 	} else if len(logs) > 0 {
 		tl.Logger.Debug("New tron logs found", "numberOfLogs", len(logs))
 	}
 
 	// set last block to storage
-	if err := tl.storageClient.Put([]byte(tronLastBlockKey), []byte(toBlock.String()), nil); err != nil {
+	if err := tl.commitLastBlock(tronLastBlockKey, toBlock); err != nil {
 		tl.Logger.Error("tl.storageClient.Put", "Error", err)
 	}
 	// process filtered log
@@ -214,11 +256,12 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 			selectedEvent := helper.EventByID(abiObject, topic)
 			logBytes, _ := json.Marshal(vLog)
 			if selectedEvent != nil {
+				taskKey := queue.NewTaskKey(selectedEvent.Name, tl.rootChainType, vLog.BlockHash.Hex(), uint64(vLog.Index))
 				tl.Logger.Debug("ReceivedTronEvent", "eventname", selectedEvent.Name)
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-						tl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 				case "Staked":
 					event := new(stakinginfo.StakinginfoStaked)
@@ -228,11 +271,11 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
 						// topup has to be processed first before validator join. so adding delay.
 						delay := util.TaskDelayBetweenEachVal
-						tl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelayWithOffset(tl.cliCtx, 1); isCurrentValidator {
 						// topup has to be processed first before validator join. so adding delay.
 						delay = delay + util.TaskDelayBetweenEachVal
-						tl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				//case "StakeUpdate":
@@ -251,9 +294,9 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
-						tl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
+						tl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, taskKey, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelayWithOffset(tl.cliCtx, 1); isCurrentValidator {
-						tl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "UnstakeInit":
@@ -262,14 +305,14 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
-						tl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
+						tl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, taskKey, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelayWithOffset(tl.cliCtx, 1); isCurrentValidator {
-						tl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "StateSynced":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-						tl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "TopUpFee":
@@ -278,14 +321,14 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
 					}
 					if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
-						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
+						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, taskKey, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelayWithOffset(tl.cliCtx, 1); isCurrentValidator {
-						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "Slashed":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-						tl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "UnJailed":
@@ -294,27 +337,28 @@ func (tl *TronListener) queryAndBroadcastEvents(chainManagerParams *chainmanager
 						tl.Logger.Error("Error while parsing tron event", "name", selectedEvent.Name, "error", err)
 					}
 					if util.IsEventSender(tl.cliCtx, event.ValidatorId.Uint64()) {
-						tl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
+						tl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, taskKey, 0)
 					} else if isCurrentValidator, delay := util.CalculateTaskDelayWithOffset(tl.cliCtx, 1); isCurrentValidator {
-						tl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "CheckpointSyncAck":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-						tl.sendTaskWithDelay("sendCheckpointSyncAckToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendCheckpointSyncAckToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 
 				case "NewChain":
 					if isCurrentValidator, delay := util.CalculateTaskDelay(tl.cliCtx); isCurrentValidator {
-						tl.sendTaskWithDelay("sendAddNewChainToHeimdall", selectedEvent.Name, logBytes, delay)
+						tl.sendTaskWithDelay("sendAddNewChainToHeimdall", selectedEvent.Name, logBytes, taskKey, delay)
 					}
 				}
 			}
 		}
 	}
+	return nil
 }
 
-func (tl *TronListener) sendTaskWithDelay(taskName string, eventName string, eventBytes []byte, delay time.Duration) {
+func (tl *TronListener) sendTaskWithDelay(taskName string, eventName string, eventBytes []byte, taskKey string, delay time.Duration) {
 	signature := &tasks.Signature{
 		Name: taskName,
 		Args: []tasks.Arg{
@@ -338,9 +382,12 @@ func (tl *TronListener) sendTaskWithDelay(taskName string, eventName string, eve
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	tl.Logger.Info("Sending tron task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := tl.queueConnector.Server.SendTask(signature)
+	sent, err := tl.queueConnector.SendTaskOnce(taskKey, signature)
 	if err != nil {
 		tl.Logger.Error("Error sending tron task", "taskName", taskName, "error", err)
+		tl.publishFailed(taskName)
+	} else if !sent {
+		tl.Logger.Debug("Dropped duplicate task", "taskName", taskName, "eventName", eventName)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 
@@ -92,6 +93,16 @@ func (bp *BaseProcessor) String() string {
 	return bp.name
 }
 
+// isOrphanedBlock returns true if task was derived from block orphaned by a reorg
+func (bp *BaseProcessor) isOrphanedBlock(hash common.Hash) bool {
+	if !util.IsBlockOrphaned(bp.storageClient, hash) {
+		return false
+	}
+
+	bp.Logger.Info("Ignoring task from block orphaned by reorg", "blockHash", hash.Hex())
+	return true
+}
+
 // OnStop stops all necessary go routines
 func (bp *BaseProcessor) Stop() {
 	// override to stop any go-routines in individual processors
@@ -112,6 +112,14 @@ func (cp *CheckpointProcessor) startPolling(ctx context.Context) {
 	defer tickerForNoAck.Stop()
 	defer tickerForSync.Stop()
 
+	// root chain txs are checked twice per stuck timeout, no check if replacement is disabled
+	var stuckTxsC <-chan time.Time
+	if stuckTimeout := helper.GetConfig().RootChainTxStuckTimeout; stuckTimeout > 0 {
+		tickerForStuckTxs := time.NewTicker(stuckTimeout / 2)
+		defer tickerForStuckTxs.Stop()
+		stuckTxsC = tickerForStuckTxs.C
+	}
+
 	cp.Logger.Info("Start polling", "no-ack-interval", noAckInterval, "checkpoint-sync-interval", syncInterval)
 
 	adjustOnce := sync.Once{}
@@ -122,6 +130,8 @@ func (cp *CheckpointProcessor) startPolling(ctx context.Context) {
 			go cp.handleCheckpointNoAck()
 		case <-tickerForSync.C:
 			go cp.handleCheckpointSync()
+		case <-stuckTxsC:
+			go cp.replaceStuckTransactions()
 		case <-ctx.Done():
 			cp.Logger.Info("Polling stopped")
 			return
@@ -129,6 +139,39 @@ func (cp *CheckpointProcessor) startPolling(ctx context.Context) {
 	}
 }
 
+// replaceStuckTransactions resends root chain txs pending longer than stuck timeout with bumped fees,
+// so a stuck tx doesn't block later txs of the same account
+func (cp *CheckpointProcessor) replaceStuckTransactions() {
+	config := helper.GetConfig()
+	nonceManager := helper.GetRootChainNonceManager()
+
+	for _, account := range nonceManager.Accounts() {
+		client := helper.GetRootChainClient(account.RootChain)
+		if client == nil {
+			continue
+		}
+
+		chainNonce, err := client.NonceAt(context.Background(), account.From, nil)
+		if err != nil {
+			cp.Logger.Error("Error fetching root chain nonce", "root", account.RootChain, "from", account.From.Hex(), "error", err)
+			continue
+		}
+
+		for _, pendingTx := range nonceManager.Stuck(account, chainNonce, config.RootChainTxStuckTimeout) {
+			replacement, err := helper.ReplaceTransaction(context.Background(), client, pendingTx.Tx, config.RootChainTxGasBumpPercent)
+			if err != nil {
+				cp.Logger.Error("Error replacing stuck root chain tx", "root", account.RootChain, "nonce", pendingTx.Tx.Nonce(),
+					"txHash", pendingTx.Tx.Hash().Hex(), "error", err)
+				continue
+			}
+
+			nonceManager.Track(account, replacement)
+			cp.Logger.Info("Replaced stuck root chain tx", "root", account.RootChain, "nonce", replacement.Nonce(),
+				"stuckTxHash", pendingTx.Tx.Hash().Hex(), "txHash", replacement.Hash().Hex())
+		}
+	}
+}
+
 // sendCheckpointToHeimdall - handles headerblock from maticchain
 // 1. check if i am the proposer for next checkpoint
 // 2. check if checkpoint has to be proposed for given headerblock
@@ -140,6 +183,10 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		return err
 	}
 
+	if cp.isOrphanedBlock(header.Hash()) {
+		return nil
+	}
+
 	cp.Logger.Info("Processing new header", "headerNumber", header.Number)
 	var isProposer bool
 	if isProposer, err = util.IsProposerByIndex(cp.cliCtx, 0); err != nil {
@@ -147,6 +194,14 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		return err
 	}
 
+	// after repeated no-acks next proposers in rotation may step in for offline ones
+	if !isProposer {
+		if isProposer, err = util.IsBackupProposer(cp.cliCtx); err != nil {
+			cp.Logger.Error("Error checking isBackupProposer in HeaderBlock handler", "error", err)
+			return err
+		}
+	}
+
 	if isProposer {
 		// fetch checkpoint context
 		checkpointContext, err := cp.getCheckpointContext(hmTypes.RootChainTypeEth)
@@ -166,7 +221,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		// tron send tron checkpoint
 		go cp.sendTronCheckpointToHeimdall(checkpointContext, latestConfirmedChildBlock)
 
-		for _, root := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		for _, root := range cp.getEthForkRootChains() {
 			activationHeight := cp.getCheckpointActivationHeight(cp.cliCtx, root)
 			if root != hmTypes.RootChainTypeEth {
 				if activationHeight == 0 || latestConfirmedChildBlock < activationHeight {
@@ -197,7 +252,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			// Check checkpoint buffer
 			//
 			timeStamp := uint64(time.Now().Unix())
-			checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
+			checkpointBufferTime := uint64(checkpointContext.CheckpointParams.GetCheckpointBufferTime(root).Seconds())
 
 			bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, root)
 			if err != nil {
@@ -296,7 +351,8 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 			return nil
 		}
 
-	case hmTypes.RootChainTypeBsc, hmTypes.RootChainTypeEth:
+	default:
+		// eth and registered eth fork root chains
 		shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, startBlock, endBlock, rootChain)
 		if err != nil {
 			return err
@@ -312,11 +368,6 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 			cp.Logger.Info("Checkpoint has already sent. Ignoring", "root", rootChain, "eventType", event.Type)
 			return nil
 		}
-
-	default:
-		err := fmt.Errorf("Unknown rootchain: %s", rootChain)
-		cp.Logger.Error("Error sending checkpoint to rootchain", "root", rootChain, "error", err)
-		return err
 	}
 
 	return nil
@@ -337,6 +388,10 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 		return err
 	}
 
+	if cp.isOrphanedBlock(log.BlockHash) {
+		return nil
+	}
+
 	event := new(rootchain.RootchainNewHeaderBlock)
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
@@ -435,14 +490,14 @@ func (cp *CheckpointProcessor) getCurrentCheckpoint(checkpointContext *Checkpoin
 	}
 
 	// fetch current header block from mainchain contract
-	currentHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	currentHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(context.Background(), rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number from rootchain", "root", rootChain, "error", err)
 		return nil, err
 	}
 
 	// get header info
-	rootHash, start, end, createAt, proposer, err := cp.contractConnector.GetHeaderInfo(currentHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+	rootHash, start, end, createAt, proposer, err := cp.contractConnector.GetHeaderInfo(context.Background(), currentHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block object from rootchain", "root", rootChain, "error", err)
 		return nil, err
@@ -553,7 +608,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointConte
 	checkpointParams := checkpointContext.CheckpointParams
 
 	// Get root hash
-	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
+	root, err := cp.contractConnector.GetRootHash(context.Background(), start, end, checkpointParams.MaxCheckpointLength)
 	if err != nil {
 		return err
 	}
@@ -651,7 +706,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(
 			cp.Logger.Info("Error while creating rootchain instance", "error", err)
 			return err
 		}
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, chainParams.RootChainAddress.EthAddress(), rootChainInstance, rootChain); err != nil {
+		if err := cp.contractConnector.SendCheckpoint(context.Background(), sideTxData, sigs, chainParams.RootChainAddress.EthAddress(), rootChainInstance, rootChain); err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
@@ -688,14 +743,14 @@ func (cp *CheckpointProcessor) resubmitCheckpointAck(checkpointContext *Checkpoi
 	}
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(rootChainInstance, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.CurrentHeaderBlock(context.Background(), rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return err
 	}
 
 	// header block
-	root, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(lastHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+	root, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(context.Background(), lastHeaderNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return err
@@ -800,6 +855,39 @@ func (cp *CheckpointProcessor) getCheckpointActivationHeight(cliCtx cliContext.C
 	return result.Result
 }
 
+// getEthForkRootChains returns registered root chains checkpoints are submitted to through eth contracts,
+// falls back to built in ones if registry cannot be fetched
+func (cp *CheckpointProcessor) getEthForkRootChains() []string {
+	rootChains, err := util.GetRootChains(cp.cliCtx)
+	if err != nil {
+		return []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc}
+	}
+
+	roots := make([]string, 0, len(rootChains))
+	for _, rootChain := range rootChains {
+		if rootChain.RootChainType != hmTypes.RootChainTypeTron {
+			roots = append(roots, rootChain.RootChainType)
+		}
+	}
+	return roots
+}
+
+// getRegisteredRootChain returns name of root chain registered with rootChainID
+func (cp *CheckpointProcessor) getRegisteredRootChain(rootChainID uint64) (string, bool) {
+	rootChains, err := util.GetRootChains(cp.cliCtx)
+	if err != nil {
+		rootChain := hmTypes.GetRootChainName(rootChainID)
+		return rootChain, rootChain != "no-chain"
+	}
+
+	for _, rootChain := range rootChains {
+		if rootChain.RootChainID == rootChainID {
+			return rootChain.RootChainType, true
+		}
+	}
+	return "", false
+}
+
 // checkIfNoAckIsRequired - check if NoAck has to be sent or not
 func (cp *CheckpointProcessor) checkIfNoAckIsRequired(checkpointContext *CheckpointContext, lastCreatedAt int64) (bool, uint64) {
 	var index float64
@@ -827,8 +915,9 @@ func (cp *CheckpointProcessor) checkIfNoAckIsRequired(checkpointContext *Checkpo
 	lastNoAck := cp.getLastNoAckTime()
 	lastNoAckTime := time.Unix(int64(lastNoAck), 0)
 	// if last no ack == 0 , first no-ack to be sent
-	if currentTime.Sub(lastNoAckTime).Seconds() < checkpointParams.CheckpointBufferTime.Seconds() && lastNoAck != 0 {
-		cp.Logger.Debug("Cannot send multiple no-ack in short time", "timeDiff", currentTime.Sub(lastNoAckTime).Seconds(), "ExpectedDiff", checkpointParams.CheckpointBufferTime.Seconds())
+	noAckBufferTime := checkpointParams.GetCheckpointBufferTime(hmTypes.RootChainTypeStake)
+	if currentTime.Sub(lastNoAckTime).Seconds() < noAckBufferTime.Seconds() && lastNoAck != 0 {
+		cp.Logger.Debug("Cannot send multiple no-ack in short time", "timeDiff", currentTime.Sub(lastNoAckTime).Seconds(), "ExpectedDiff", noAckBufferTime.Seconds())
 		return false, uint64(index)
 	}
 	return true, uint64(index)
@@ -839,6 +928,7 @@ func (cp *CheckpointProcessor) proposeCheckpointNoAck() (err error) {
 	// send NO ACK
 	msg := checkpointTypes.NewMsgCheckpointNoAck(
 		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		hmTypes.RootChainTypeStake,
 	)
 
 	// return broadcast to heimdall
@@ -862,7 +952,7 @@ func (cp *CheckpointProcessor) shouldSendCheckpoint(checkpointContext *Checkpoin
 	}
 
 	// current child block from contract
-	currentChildBlock, err := cp.contractConnector.GetLastChildBlock(rootChainInstance)
+	currentChildBlock, err := cp.contractConnector.GetLastChildBlock(context.Background(), rootChainInstance)
 	if err != nil {
 		cp.Logger.Error("Error fetching current child block", "currentChildBlock", currentChildBlock, "error", err)
 		return false, err
@@ -906,16 +996,13 @@ func (cp *CheckpointProcessor) sendAddNewChainToHeimdall(eventName string, newCh
 	if err := helper.UnpackLog(cp.rootchainAbi, event, eventName, &log); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
 	} else {
-		var rootChain string
-		switch event.RootChainId.Uint64() {
-		case uint64(hmTypes.GetRootChainID(hmTypes.RootChainTypeBsc)):
-			if cp.getCheckpointActivationHeight(cp.cliCtx, hmTypes.RootChainTypeBsc) > 0 {
-				cp.Logger.Error("bsc has been connected to the network", "root", event.RootChainId.Uint64())
-				return nil
-			}
-			rootChain = hmTypes.RootChainTypeBsc
-		default:
-			cp.Logger.Error("Error root chain ID from tron", "root", rootChain, "error", err)
+		rootChain, ok := cp.getRegisteredRootChain(event.RootChainId.Uint64())
+		if !ok || rootChain == hmTypes.RootChainTypeEth || rootChain == hmTypes.RootChainTypeTron {
+			cp.Logger.Error("Error root chain ID from tron", "root", event.RootChainId.Uint64())
+			return nil
+		}
+		if cp.getCheckpointActivationHeight(cp.cliCtx, rootChain) > 0 {
+			cp.Logger.Error("Root chain has been connected to the network", "root", rootChain)
 			return nil
 		}
 		cp.Logger.Info(
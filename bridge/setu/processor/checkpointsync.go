@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"math/big"
 	"strconv"
@@ -36,7 +38,7 @@ func (cp *CheckpointProcessor) handleCheckpointSync() {
 		bufferedCheckpoint, err := util.GetBufferedCheckpointSync(cp.cliCtx, rootChain)
 		if err == nil {
 			bufferedTime := time.Unix(int64(bufferedCheckpoint.TimeStamp), 0)
-			if currentTime.Sub(bufferedTime).Seconds() < checkpointParams.CheckpointBufferTime.Seconds()/5 {
+			if currentTime.Sub(bufferedTime).Seconds() < checkpointParams.GetCheckpointBufferTime(rootChain).Seconds()/5 {
 				cp.Logger.Debug("Cannot send multiple checkpoint sync in short time", "error", err)
 				continue
 			}
@@ -68,6 +70,19 @@ func (cp *CheckpointProcessor) handleCheckpointSync() {
 			// send checkpoint sync
 			msg := checkpointTypes.NewMsgCheckpointSync(hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
 				proposer, nextSyncCheckpointNumber, start, end, rootChain)
+
+			// only proposer itself can sign sync when proposer signature is required
+			if checkpointParams.VerifySyncProposerSignature {
+				if !bytes.Equal(proposer.Bytes(), helper.GetAddress()) {
+					cp.Logger.Debug("Not proposer of checkpoint to sync, skipping", "root", rootChain, "proposer", proposer.String())
+					continue
+				}
+
+				if msg.ProposerSignature, err = helper.SignData(msg.GetProposerSignBytes()); err != nil {
+					cp.Logger.Error("Error while signing checkpoint sync", "root", rootChain, "error", err)
+					continue
+				}
+			}
 			// return broadcast to heimdall
 			if isCurrentValidator, delay := util.CalculateTaskDelay(cp.cliCtx); isCurrentValidator {
 				if err := cp.txBroadcaster.BroadcastToHeimdallWithDelay(msg, delay); err != nil {
@@ -201,7 +216,7 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 
 		bufferedTime := time.Unix(int64(bufferedCheckpoint.TimeStamp), 0)
 		currentTime := time.Now().UTC()
-		if currentTime.Sub(bufferedTime).Seconds() > checkpointParams.CheckpointBufferTime.Seconds()/5 {
+		if currentTime.Sub(bufferedTime).Seconds() > checkpointParams.GetCheckpointBufferTime(checkpointChain).Seconds()/5 {
 			cp.Logger.Debug("checkpoint sync buffer has expired, ignore this ack")
 			return nil
 		}
@@ -215,6 +230,19 @@ func (cp *CheckpointProcessor) sendCheckpointSyncAckToHeimdall(eventName string,
 			checkpointChain,
 		)
 
+		// ack is bound to proposer of buffered sync when proposer signature is required
+		if checkpointParams.VerifySyncProposerSignature {
+			if !bytes.Equal(bufferedCheckpoint.Proposer.Bytes(), helper.GetAddress()) {
+				cp.Logger.Debug("Not proposer of checkpoint sync, skipping ack", "root", checkpointChain, "proposer", bufferedCheckpoint.Proposer.String())
+				return nil
+			}
+
+			if msg.ProposerSignature, err = helper.SignData(msg.GetProposerSignBytes()); err != nil {
+				cp.Logger.Error("Error while signing checkpoint sync ack", "root", checkpointChain, "error", err)
+				return err
+			}
+		}
+
 		// return broadcast to heimdall
 		if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
 			cp.Logger.Error("Error while broadcasting checkpoint-ack to heimdall", "error", err)
@@ -236,14 +264,14 @@ func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointConte
 		if err != nil {
 			return 0, 0, hmTypes.ZeroHeimdallAddress, err
 		}
-		_, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(headerNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
+		_, start, end, _, proposer, err := cp.contractConnector.GetHeaderInfo(context.Background(), headerNumber, rootChainInstance, checkpointParams.ChildBlockInterval)
 		if err != nil {
 			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
 			return 0, 0, hmTypes.ZeroHeimdallAddress, err
 		}
 		return start, end, proposer, nil
 	case hmTypes.RootChainTypeTron:
-		_, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(headerNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+		_, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(context.Background(), headerNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 
 		if err != nil {
 			cp.Logger.Error("Error while fetching header block", "root", rootChain, "error", err)
@@ -257,7 +285,7 @@ func (cp *CheckpointProcessor) getHeaderBlock(checkpointContext *CheckpointConte
 // getLastSyncedCheckpointNumber - get last checkpoint header number from stake chain
 func (cp *CheckpointProcessor) getLastSyncedCheckpointNumber(checkpointContext *CheckpointContext, rootChain string) (uint64, error) {
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	syncedHeaderNumber, err := cp.contractConnector.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, rootChain)
+	syncedHeaderNumber, err := cp.contractConnector.GetSyncedCheckpointId(context.Background(), chainParams.TronStakingManagerAddress, rootChain)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current synced header block number from stake chain", "error", err)
 		return 0, err
@@ -305,7 +333,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointSyncToTron(
 
 	// chain manager params
 	chainParams := checkpointContext.ChainmanagerParams.ChainParams
-	err = cp.contractConnector.SendCheckpointSyncToTron(sideTxData, sigs, chainParams.TronStakingManagerAddress)
+	err = cp.contractConnector.SendCheckpointSyncToTron(context.Background(), sideTxData, sigs, chainParams.TronStakingManagerAddress)
 	if err != nil {
 		cp.Logger.Error("Error submitting checkpoint sync to tron", "error", err)
 		return err
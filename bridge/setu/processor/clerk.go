@@ -58,6 +58,10 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 		return err
 	}
 
+	if cp.isOrphanedBlock(vLog.BlockHash) {
+		return nil
+	}
+
 	clerkContext, err := cp.getClerkContext()
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package processor
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -103,11 +104,11 @@ func (sp *SlashingProcessor) sendTickToHeimdall(eventBytes string, blockHeight i
 }
 
 /*
-	sendTickToRootchain - create and submit tick tx to rootchain to slashing faulty validators
-	1. Fetch sigs from heimdall using txHash
-	2. Fetch slashing info from heimdall via Rest call
-	3. Verify if this tick tx is already submitted to rootchain using nonce data
-	4. create tick tx and submit to rootchain
+sendTickToRootchain - create and submit tick tx to rootchain to slashing faulty validators
+1. Fetch sigs from heimdall using txHash
+2. Fetch slashing info from heimdall via Rest call
+3. Verify if this tick tx is already submitted to rootchain using nonce data
+4. create tick tx and submit to rootchain
 */
 func (sp *SlashingProcessor) sendTickToRootchain(eventBytes string, blockHeight int64) (err error) {
 	sp.Logger.Info("Recevied sendTickToRootchain request", "eventBytes", eventBytes, "blockHeight", blockHeight)
@@ -330,7 +331,7 @@ func (sp *SlashingProcessor) createAndSendTickToRootchain(height int64, txHash [
 	}
 
 	// TODO pass sigs in proper form in `SendTick` for slashing
-	if err := sp.contractConnector.SendTick(sideTxData, nil, slashManagerAddress, slashManagerInstance); err != nil {
+	if err := sp.contractConnector.SendTick(context.Background(), sideTxData, nil, slashManagerAddress, slashManagerInstance); err != nil {
 		sp.Logger.Info("Error submitting tick to slashManager contract", "error", err)
 		return err
 	}
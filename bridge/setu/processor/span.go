@@ -137,7 +137,7 @@ func (sp *SpanProcessor) getLastSpan() (*types.Span, error) {
 
 // getCurrentChildBlock gets the current child block
 func (sp *SpanProcessor) getCurrentChildBlock() (uint64, error) {
-	childBlock, err := sp.contractConnector.GetMaticChainBlock(nil)
+	childBlock, err := sp.contractConnector.GetMaticChainBlock(context.Background(), nil)
 	if err != nil {
 		return 0, err
 	}
@@ -520,10 +520,10 @@ func (sp *StakingProcessor) getNonceFromRootChain(stakingContext *StakingContext
 	case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
 		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.StakingManagerAddress.EthAddress()
 		stakingManagerInstance, _ := sp.contractConnector.GetStakeManagerInstance(stakingManagerAddress, rootChain)
-		return sp.contractConnector.GetMainStakingSyncNonce(validatorID, stakingManagerInstance)
+		return sp.contractConnector.GetMainStakingSyncNonce(context.Background(), validatorID, stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
 		stakingManagerAddress := stakingContext.ChainmanagerParams.ChainParams.TronStakingManagerAddress
-		return sp.contractConnector.GetTronStakingSyncNonce(validatorID, stakingManagerAddress)
+		return sp.contractConnector.GetTronStakingSyncNonce(context.Background(), validatorID, stakingManagerAddress)
 	}
 	return 0
 }
@@ -586,7 +586,7 @@ func (sp *StakingProcessor) createAndSendStakingSyncToRootChain(stakingContext *
 		sp.Logger.Error("Error while creating staking instance", "error", err)
 		return
 	}
-	if err := sp.contractConnector.SendMainStakingSync(stakingInfo.Type, sideTxData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain); err != nil {
+	if err := sp.contractConnector.SendMainStakingSync(context.Background(), stakingInfo.Type, sideTxData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain); err != nil {
 		sp.Logger.Error("Error submitting staking sync to rootchain", "error", err)
 		return
 	}
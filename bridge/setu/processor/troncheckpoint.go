@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"context"
 	"math/big"
 	"time"
 
@@ -27,7 +28,7 @@ func (cp *CheckpointProcessor) sendTronCheckpointToHeimdall(checkpointContext *C
 	// Check checkpoint buffer
 	//
 	timeStamp := uint64(time.Now().Unix())
-	checkpointBufferTime := uint64(checkpointContext.CheckpointParams.CheckpointBufferTime.Seconds())
+	checkpointBufferTime := uint64(checkpointContext.CheckpointParams.GetCheckpointBufferTime(hmTypes.RootChainTypeTron).Seconds())
 
 	bufferedCheckpoint, err := util.GetBufferedCheckpoint(cp.cliCtx, hmTypes.RootChainTypeTron)
 	if err != nil {
@@ -51,7 +52,7 @@ func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *Che
 	checkpointParams := checkpointContext.CheckpointParams
 
 	// fetch current header block from tron contract
-	_currentHeaderBlock, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	_currentHeaderBlock, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(context.Background(), chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number from tron", "error", err)
 		return nil, err
@@ -61,7 +62,7 @@ func (cp *CheckpointProcessor) nextExpectedTronCheckpoint(checkpointContext *Che
 	currentHeaderBlockNumber := big.NewInt(0).SetUint64(_currentHeaderBlock)
 
 	// get header info
-	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetTronHeaderInfo(
+	_, currentStart, currentEnd, lastCheckpointTime, _, err := cp.contractConnector.GetTronHeaderInfo(context.Background(),
 		currentHeaderBlockNumber.Uint64(), chainManagerParams.ChainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block object from tron", "error", err)
@@ -149,7 +150,7 @@ func (cp *CheckpointProcessor) createAndSendTronCheckpointToHeimdall(checkpointC
 	checkpointParams := checkpointContext.CheckpointParams
 
 	// Get root hash
-	root, err := cp.contractConnector.GetRootHash(start, end, checkpointParams.MaxCheckpointLength)
+	root, err := cp.contractConnector.GetRootHash(context.Background(), start, end, checkpointParams.MaxCheckpointLength)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (cp *CheckpointProcessor) shouldSendTronCheckpoint(checkpointContext *Check
 	chainManagerParams := checkpointContext.ChainmanagerParams
 
 	// current child block from contract
-	currentChildBlock, err := cp.contractConnector.TronChainRPC.GetLastChildBlock(chainManagerParams.ChainParams.TronChainAddress)
+	currentChildBlock, err := cp.contractConnector.TronChainRPC.GetLastChildBlock(context.Background(), chainManagerParams.ChainParams.TronChainAddress)
 	if err != nil {
 		cp.Logger.Error("Error fetching tron current child block", "currentChildBlock", currentChildBlock, "error", err)
 		return false, err
@@ -270,7 +271,7 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToTron(checkpointContext *
 	if shouldSend {
 		// chain manager params
 		chainParams := checkpointContext.ChainmanagerParams.ChainParams
-		err := cp.contractConnector.SendTronCheckpoint(sideTxData, sigs, chainParams.TronChainAddress)
+		err := cp.contractConnector.SendTronCheckpoint(context.Background(), sideTxData, sigs, chainParams.TronChainAddress)
 		if err != nil {
 			cp.Logger.Error("Error submitting checkpoint[tron] to rootchain", "error", err)
 			return err
@@ -288,14 +289,14 @@ func (cp *CheckpointProcessor) getLatestTronCheckpointTime(checkpointContext *Ch
 	checkpointParams := checkpointContext.CheckpointParams
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(context.Background(), chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return 0, err
 	}
 
 	// header block
-	_, _, _, createdAt, _, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	_, _, _, createdAt, _, err := cp.contractConnector.GetTronHeaderInfo(context.Background(), lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return 0, err
@@ -310,14 +311,14 @@ func (cp *CheckpointProcessor) resubmitTronCheckpointAck(checkpointContext *Chec
 	checkpointParams := checkpointContext.CheckpointParams
 
 	// fetch last header number
-	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	lastHeaderNumber, err := cp.contractConnector.TronChainRPC.CurrentHeaderBlock(context.Background(), chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching current header block number", "error", err)
 		return err
 	}
 
 	// header block
-	root, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
+	root, start, end, _, proposer, err := cp.contractConnector.GetTronHeaderInfo(context.Background(), lastHeaderNumber, chainParams.TronChainAddress, checkpointParams.ChildBlockInterval)
 	if err != nil {
 		cp.Logger.Error("Error while fetching header block object", "error", err)
 		return err
@@ -0,0 +1,229 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	amqpExchange     = "machinery_exchange"
+	amqpExchangeType = "direct"
+	amqpBindingKey   = "machinery_task"
+
+	// amqpPrefetchCount bounds unacked deliveries held by consumer
+	amqpPrefetchCount = 100
+)
+
+// amqpBackend queues tasks of a lane in RabbitMQ. Normal lane uses same exchange and queue as
+// machinery amqp broker so tasks queued before switching to queue backends are still consumed.
+type amqpBackend struct {
+	url        string
+	queueName  string
+	bindingKey string
+
+	// connection and confirm mode channel used for publishing and queue inspection
+	mu          sync.Mutex
+	conn        *amqp.Connection
+	ch          *amqp.Channel
+	confirmChan chan amqp.Confirmation
+}
+
+func newAMQPBackend(url string, lane string) (*amqpBackend, error) {
+	backend := &amqpBackend{
+		url:        url,
+		queueName:  QueueName + laneSuffix(lane, "_"),
+		bindingKey: amqpBindingKey + laneSuffix(lane, "_"),
+	}
+
+	// fail fast on unreachable broker
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	if _, err := backend.channel(); err != nil {
+		return nil, err
+	}
+
+	return backend, nil
+}
+
+// declare declares exchange and task queue on ch, same as machinery amqp broker
+func (ab *amqpBackend) declare(ch *amqp.Channel) error {
+	if err := ch.ExchangeDeclare(amqpExchange, amqpExchangeType, true, false, false, false, nil); err != nil {
+		return err
+	}
+
+	if _, err := ch.QueueDeclare(ab.queueName, true, false, false, false, nil); err != nil {
+		return err
+	}
+
+	return ch.QueueBind(ab.queueName, ab.bindingKey, amqpExchange, false, nil)
+}
+
+// channel returns open publish channel, dialing again if connection was lost. Caller holds mu.
+func (ab *amqpBackend) channel() (*amqp.Channel, error) {
+	if ab.ch != nil && !ab.conn.IsClosed() {
+		return ab.ch, nil
+	}
+	ab.closeChannel()
+
+	conn, err := amqp.Dial(ab.url)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err == nil {
+		err = ab.declare(ch)
+	}
+	if err == nil {
+		err = ch.Confirm(false)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	ab.conn, ab.ch = conn, ch
+	ab.confirmChan = ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+	return ch, nil
+}
+
+// closeChannel closes publish connection, caller holds mu
+func (ab *amqpBackend) closeChannel() {
+	if ab.conn != nil {
+		// connection may be already closed by server
+		_ = ab.conn.Close()
+	}
+	ab.conn, ab.ch, ab.confirmChan = nil, nil, nil
+}
+
+// Publish implements QueueBackend, waiting for broker to confirm message
+func (ab *amqpBackend) Publish(body []byte) error {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	ch, err := ab.channel()
+	if err != nil {
+		return err
+	}
+
+	if err := ch.Publish(amqpExchange, ab.bindingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		Body:         body,
+		DeliveryMode: amqp.Persistent,
+	}); err != nil {
+		ab.closeChannel()
+		return err
+	}
+
+	if confirmed, ok := <-ab.confirmChan; !ok || !confirmed.Ack {
+		ab.closeChannel()
+		return errors.New("task was not confirmed by amqp broker")
+	}
+	return nil
+}
+
+// Consume implements QueueBackend on a dedicated connection
+func (ab *amqpBackend) Consume(ctx context.Context) (<-chan *Delivery, error) {
+	conn, err := amqp.Dial(ab.url)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err == nil {
+		err = ab.declare(ch)
+	}
+	if err == nil {
+		err = ch.Qos(amqpPrefetchCount, 0, false)
+	}
+	var deliveries <-chan amqp.Delivery
+	if err == nil {
+		deliveries, err = ch.Consume(ab.queueName, "", false, false, false, false, nil)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	out := make(chan *Delivery)
+	go func() {
+		defer close(out)
+		defer conn.Close()
+
+		for {
+			select {
+			case d, ok := <-deliveries:
+				if !ok {
+					return
+				}
+
+				select {
+				case out <- &Delivery{Body: d.Body, tag: d}:
+				case <-ctx.Done():
+					_ = d.Nack(false, true)
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Ack implements QueueBackend
+func (ab *amqpBackend) Ack(delivery *Delivery) error {
+	return delivery.tag.(amqp.Delivery).Ack(false)
+}
+
+// Nack implements QueueBackend
+func (ab *amqpBackend) Nack(delivery *Delivery, requeue bool) error {
+	return delivery.tag.(amqp.Delivery).Nack(false, requeue)
+}
+
+// Depth implements QueueBackend
+func (ab *amqpBackend) Depth() (int, error) {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	ch, err := ab.channel()
+	if err != nil {
+		return 0, err
+	}
+
+	queue, err := ch.QueueInspect(ab.queueName)
+	if err != nil {
+		// server closes channel on failed inspect, open new one on next call
+		ab.closeChannel()
+		return 0, err
+	}
+
+	return queue.Messages, nil
+}
+
+// Purge implements QueueBackend
+func (ab *amqpBackend) Purge() error {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	ch, err := ab.channel()
+	if err != nil {
+		return err
+	}
+
+	_, err = ch.QueuePurge(ab.queueName, false)
+	return err
+}
+
+// Close implements QueueBackend
+func (ab *amqpBackend) Close() error {
+	ab.mu.Lock()
+	defer ab.mu.Unlock()
+
+	ab.closeChannel()
+	return nil
+}
@@ -0,0 +1,88 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// Queue backends selectable with bridge_queue config
+const (
+	BackendAMQP    = "amqp"
+	BackendLevelDB = "leveldb"
+	BackendNATS    = "nats"
+)
+
+// Delivery is a message received from queue backend, acked or nacked once processed
+type Delivery struct {
+	Body []byte
+
+	// backend specific handle of message
+	tag interface{}
+
+	// index in Lanes of lane message was delivered from
+	lane int
+}
+
+// QueueBackend is the task queue bridge publishes tasks to and consumes them from
+type QueueBackend interface {
+	// Publish places message on queue
+	Publish(body []byte) error
+
+	// Consume delivers queued messages until ctx is done, channel is closed if backend fails
+	Consume(ctx context.Context) (<-chan *Delivery, error)
+
+	// Ack removes processed message from queue
+	Ack(delivery *Delivery) error
+
+	// Nack puts message back on queue if requeue is set, otherwise drops it
+	Nack(delivery *Delivery, requeue bool) error
+
+	// Depth returns number of messages waiting in queue
+	Depth() (int, error)
+
+	// Purge drops all messages waiting in queue
+	Purge() error
+
+	Close() error
+}
+
+// errPurgeNotSupported is returned by backends which do not keep messages
+var errPurgeNotSupported = errors.New("queue backend does not support purge")
+
+// NewQueueBackend creates queue backend of kind for lane. url is the broker url for amqp and nats,
+// embedded leveldb backend keeps queue in bridge db instead.
+func NewQueueBackend(kind string, url string, db *leveldb.DB, lane string) (QueueBackend, error) {
+	switch kind {
+	case "", BackendAMQP:
+		return newAMQPBackend(url, lane)
+	case BackendLevelDB:
+		if db == nil {
+			return nil, errors.New("bridge db is required for leveldb queue backend")
+		}
+		return newLevelDBBackend(db, lane)
+	case BackendNATS:
+		return newNATSBackend(url, lane)
+	}
+
+	return nil, fmt.Errorf("unknown queue backend %s", kind)
+}
+
+// NewLaneBackends creates queue backends of kind for each of Lanes, in same order
+func NewLaneBackends(kind string, url string, db *leveldb.DB) ([]QueueBackend, error) {
+	backends := make([]QueueBackend, 0, len(Lanes))
+	for _, lane := range Lanes {
+		backend, err := NewQueueBackend(kind, url, db, lane)
+		if err != nil {
+			for _, created := range backends {
+				created.Close()
+			}
+			return nil, err
+		}
+		backends = append(backends, backend)
+	}
+
+	return backends, nil
+}
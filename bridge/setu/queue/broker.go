@@ -0,0 +1,246 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/brokers/iface"
+	"github.com/RichardKnop/machinery/v1/common"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// errConsumerClosed is returned when queue backend stops delivering messages
+var errConsumerClosed = errors.New("queue backend closed consumer")
+
+// backendBroker is a machinery broker on top of queue backends, one for each lane
+type backendBroker struct {
+	common.Broker
+
+	logger       log.Logger
+	backends     []QueueBackend
+	processingWG sync.WaitGroup
+
+	// dead-lettered tasks are kept in db, tasks delivered more than maxAttempts times are
+	// dead-lettered without running, 0 allows any number of attempts
+	db          *leveldb.DB
+	maxAttempts int
+
+	// failure reasons of tasks being processed, keyed by task uuid
+	failures sync.Map
+
+	// tasks delivered and not acked yet, keyed by task uuid
+	trackedMu sync.Mutex
+	tracked   map[string]*trackedTask
+}
+
+// newBackendBroker creates broker on backends of Lanes, in same order
+func newBackendBroker(cnf *config.Config, backends []QueueBackend, db *leveldb.DB, maxAttempts int, logger log.Logger) *backendBroker {
+	return &backendBroker{
+		Broker:      common.NewBroker(cnf),
+		logger:      logger,
+		backends:    backends,
+		db:          db,
+		maxAttempts: maxAttempts,
+		tracked:     make(map[string]*trackedTask),
+	}
+}
+
+// StartConsuming implements iface.Broker, processing delivered tasks of all lanes until consuming
+// is stopped. Returns retry true if a backend failed so worker starts consuming again.
+func (b *backendBroker) StartConsuming(consumerTag string, concurrency int, taskProcessor iface.TaskProcessor) (bool, error) {
+	b.Broker.StartConsuming(consumerTag, concurrency, taskProcessor)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// deliveries of all lanes, tagged with their lane
+	deliveries := make(chan *Delivery)
+	closed := make(chan struct{}, len(b.backends))
+	for lane, backend := range b.backends {
+		laneDeliveries, err := backend.Consume(ctx)
+		if err != nil {
+			cancel()
+			b.GetRetryFunc()(b.GetRetryStopChan())
+			return b.GetRetry(), err
+		}
+
+		go b.forward(ctx, lane, laneDeliveries, deliveries, closed)
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	slots := newLaneSlots(concurrency)
+
+	for {
+		select {
+		case delivery := <-deliveries:
+			b.processingWG.Add(1)
+			go func() {
+				defer b.processingWG.Done()
+				b.consumeOne(delivery, slots, taskProcessor)
+			}()
+		case <-closed:
+			cancel()
+			b.processingWG.Wait()
+			return b.GetRetry(), errConsumerClosed
+		case <-b.GetStopChan():
+			cancel()
+			b.processingWG.Wait()
+			return false, nil
+		}
+	}
+}
+
+// forward passes deliveries of lane on until backend closes them or ctx is done
+func (b *backendBroker) forward(ctx context.Context, lane int, from <-chan *Delivery, to chan<- *Delivery, closed chan<- struct{}) {
+	defer func() { closed <- struct{}{} }()
+
+	for delivery := range from {
+		delivery.lane = lane
+		select {
+		case to <- delivery:
+		case <-ctx.Done():
+			b.nack(delivery, true)
+			return
+		}
+	}
+}
+
+// consumeOne processes delivered task once its ETA is reached, delayed tasks do not hold a worker
+// slot. Tasks of higher priority lanes get free worker slots first.
+func (b *backendBroker) consumeOne(delivery *Delivery, slots *laneSlots, taskProcessor iface.TaskProcessor) {
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(delivery.Body))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		b.deadLetter(delivery, signature, 0, fmt.Sprintf("malformed task: %v", err))
+		return
+	}
+
+	// there might be different workers for processing specific tasks
+	if !b.IsTaskRegistered(signature.Name) {
+		b.logger.Debug("Task not registered with this worker, requeueing", "taskName", signature.Name)
+		b.nack(delivery, true)
+		return
+	}
+
+	task := b.trackTask(delivery, signature)
+	defer b.untrackTask(signature.UUID, task)
+
+	if signature.ETA != nil {
+		if wait := time.Until(*signature.ETA); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-task.wake:
+			case <-b.GetStopChan():
+				b.nack(delivery, true)
+				return
+			}
+		}
+	}
+
+	// retried tasks are republished with attempts so far
+	attempts := taskAttempts(signature) + 1
+	if b.maxAttempts > 0 && attempts > b.maxAttempts {
+		b.deadLetter(delivery, signature, attempts-1, fmt.Sprintf("exceeded max attempts %d", b.maxAttempts))
+		return
+	}
+	setTaskAttempts(signature, attempts)
+	withDeadLetterCallback(signature)
+
+	if !slots.acquire(delivery.lane, b.GetStopChan()) {
+		b.nack(delivery, true)
+		return
+	}
+	defer slots.release()
+	b.startTask(task, attempts)
+
+	// failed tasks are republished by worker, delivery is done either way
+	if err := taskProcessor.Process(signature); err != nil {
+		b.logger.Error("Error while processing task", "taskName", signature.Name, "error", err)
+	}
+
+	// worker calls dead letter error callback once task has no retries left
+	if reason, failed := b.failures.LoadAndDelete(signature.UUID); failed {
+		b.deadLetter(delivery, signature, attempts, reason.(string))
+		return
+	}
+
+	b.ack(delivery)
+}
+
+// deadLetter records task which failed for good and removes it from queue
+func (b *backendBroker) deadLetter(delivery *Delivery, signature *tasks.Signature, attempts int, reason string) {
+	b.logger.Error("Dead-lettering task", "taskName", signature.Name, "uuid", signature.UUID, "attempts", attempts, "reason", reason)
+	deadLetteredCounter.WithLabelValues(signature.Name).Inc()
+
+	payload := delivery.Body
+	if !json.Valid(payload) {
+		// keep malformed payload readable in record
+		payload, _ = json.Marshal(string(payload))
+	}
+
+	uuid := signature.UUID
+	if uuid == "" {
+		uuid = fmt.Sprintf("malformed_%d", time.Now().UnixNano())
+	}
+
+	if err := saveDeadLetter(b.db, DeadLetter{
+		UUID:     uuid,
+		TaskName: signature.Name,
+		Reason:   reason,
+		Attempts: attempts,
+		FailedAt: time.Now().UTC(),
+		Payload:  payload,
+	}); err != nil {
+		// keep task queued rather than losing it
+		b.logger.Error("Error while saving dead-lettered task", "uuid", uuid, "error", err)
+		b.nack(delivery, true)
+		return
+	}
+
+	b.ack(delivery)
+}
+
+func (b *backendBroker) ack(delivery *Delivery) {
+	if err := b.backends[delivery.lane].Ack(delivery); err != nil {
+		b.logger.Error("Error while acking task", "error", err)
+	}
+}
+
+func (b *backendBroker) nack(delivery *Delivery, requeue bool) {
+	if err := b.backends[delivery.lane].Nack(delivery, requeue); err != nil {
+		b.logger.Error("Error while nacking task", "requeue", requeue, "error", err)
+	}
+}
+
+// Publish implements iface.Broker, publishing task to lane of its priority. Dead letter error
+// callbacks are recorded, not published.
+func (b *backendBroker) Publish(ctx context.Context, signature *tasks.Signature) error {
+	if uuid, reason, ok := deadLetterFailure(signature); ok {
+		b.failures.Store(uuid, reason)
+		return nil
+	}
+
+	b.AdjustRoutingKey(signature)
+	if signature.Priority == 0 {
+		signature.Priority = TaskPriority(signature.Name)
+	}
+	setTaskPublishedAt(signature, time.Now())
+
+	msg, err := json.Marshal(signature)
+	if err != nil {
+		return fmt.Errorf("JSON marshal error: %s", err)
+	}
+
+	return b.backends[laneIndex(signature.Priority)].Publish(msg)
+}
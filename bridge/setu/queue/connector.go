@@ -1,18 +1,31 @@
 package queue
 
 import (
-	"github.com/streadway/amqp"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/RichardKnop/machinery/v1"
+	nullBackend "github.com/RichardKnop/machinery/v1/backends/null"
 	"github.com/RichardKnop/machinery/v1/config"
+	eagerLock "github.com/RichardKnop/machinery/v1/locks/eager"
 
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
 type QueueConnector struct {
-	logger log.Logger
-	Server *machinery.Server
+	logger   log.Logger
+	backends []QueueBackend
+	broker   *backendBroker
+	Server   *machinery.Server
+
+	// bridge db keeping dead-lettered tasks and idempotency keys of published tasks
+	db *leveldb.DB
+
+	dedupMu      sync.Mutex
+	lastKeyPrune time.Time
 }
 
 const (
@@ -20,33 +33,37 @@ const (
 	QueueName = "machinery_tasks"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
-	// amqp dialer
-	_, err := amqp.Dial(dialer)
+// NewQueueConnector creates connector on queue backends of kind for each lane, see NewQueueBackend.
+// Tasks failing more than maxAttempts times are dead-lettered in db.
+func NewQueueConnector(kind string, url string, db *leveldb.DB, maxAttempts int) *QueueConnector {
+	logger := util.Logger().With("module", "QueueConnector")
+
+	backends, err := NewLaneBackends(kind, url, db)
 	if err != nil {
 		panic(err)
 	}
 
 	var cnf = &config.Config{
-		Broker:        dialer,
-		DefaultQueue:  QueueName,
-		ResultBackend: dialer,
+		Broker:       url,
+		DefaultQueue: QueueName,
 		AMQP: &config.AMQPConfig{
-			Exchange:     "machinery_exchange",
-			ExchangeType: "direct",
-			BindingKey:   "machinery_task",
+			Exchange:     amqpExchange,
+			ExchangeType: amqpExchangeType,
+			BindingKey:   amqpBindingKey,
 		},
 	}
 
-	server, err := machinery.NewServer(cnf)
-	if err != nil {
-		// do something with the error
-	}
+	// task results are not read by bridge
+	broker := newBackendBroker(cnf, backends, db, maxAttempts, logger)
+	server := machinery.NewServerWithBrokerBackendLock(cnf, broker, nullBackend.New(), eagerLock.New())
 
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:   logger,
+		backends: backends,
+		broker:   broker,
+		Server:   server,
+		db:       db,
 	}
 
 	// connector
@@ -56,7 +73,35 @@ func NewQueueConnector(dialer string) *QueueConnector {
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
 	worker := qc.Server.NewWorker("invoke-processor", 10)
+	worker.SetPreTaskHandler(newTaskTracker().observeTask)
 	qc.logger.Info("Starting machinery worker")
 	errors := make(chan error)
 	worker.LaunchAsync(errors)
 }
+
+// QueueDepth returns number of tasks waiting in all lanes of task queue
+func (qc *QueueConnector) QueueDepth() (int, error) {
+	depths, err := qc.LaneDepths()
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, depth := range depths {
+		total += depth
+	}
+	return total, nil
+}
+
+// LaneDepths returns number of tasks waiting in each lane of task queue
+func (qc *QueueConnector) LaneDepths() (map[string]int, error) {
+	depths := make(map[string]int, len(Lanes))
+	for i, lane := range Lanes {
+		depth, err := qc.backends[i].Depth()
+		if err != nil {
+			return nil, err
+		}
+		depths[lane] = depth
+	}
+	return depths, nil
+}
@@ -0,0 +1,166 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// deadLetterPrefix prefixes keys of dead-lettered tasks in bridge db
+	deadLetterPrefix = "dead-letter-"
+
+	// deadLetterTaskName is error callback attached to consumed tasks, broker records failure
+	// reason from it instead of publishing it
+	deadLetterTaskName = "deadLetterTask"
+
+	// replayRetryCount is retry count of replayed tasks, same as listeners publish tasks with
+	replayRetryCount = 3
+
+	// attemptsHeader counts deliveries of task, kept across retries as machinery republishes same signature
+	attemptsHeader = "attempts"
+)
+
+// ErrDeadLetterNotFound is returned when replaying unknown dead-lettered task
+var ErrDeadLetterNotFound = errors.New("dead-lettered task not found")
+
+// DeadLetter is record of a task which failed for good, kept in bridge db until replayed
+type DeadLetter struct {
+	UUID     string          `json:"uuid"`
+	TaskName string          `json:"task_name"`
+	Reason   string          `json:"reason"`
+	Attempts int             `json:"attempts"`
+	FailedAt time.Time       `json:"failed_at"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+func deadLetterKey(uuid string) []byte {
+	return []byte(deadLetterPrefix + uuid)
+}
+
+// taskAttempts returns number of times task was delivered before
+func taskAttempts(signature *tasks.Signature) int {
+	switch attempts := signature.Headers[attemptsHeader].(type) {
+	case json.Number:
+		n, _ := strconv.Atoi(attempts.String())
+		return n
+	case float64:
+		return int(attempts)
+	case int:
+		return attempts
+	}
+	return 0
+}
+
+// setTaskAttempts records delivery count of task in its headers
+func setTaskAttempts(signature *tasks.Signature, attempts int) {
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+	signature.Headers[attemptsHeader] = attempts
+}
+
+// withDeadLetterCallback replaces dead letter error callback of signature with a fresh one
+func withDeadLetterCallback(signature *tasks.Signature) {
+	onError := make([]*tasks.Signature, 0, len(signature.OnError)+1)
+	for _, callback := range signature.OnError {
+		if callback.Name != deadLetterTaskName {
+			onError = append(onError, callback)
+		}
+	}
+
+	signature.OnError = append(onError, &tasks.Signature{
+		Name: deadLetterTaskName,
+		Args: []tasks.Arg{{Type: "string", Value: signature.UUID}},
+	})
+}
+
+// deadLetterFailure returns task uuid and failure reason carried by dead letter error callback,
+// machinery prepends failure reason to callback args
+func deadLetterFailure(callback *tasks.Signature) (uuid string, reason string, ok bool) {
+	if callback.Name != deadLetterTaskName || len(callback.Args) < 2 {
+		return "", "", false
+	}
+
+	reason, _ = callback.Args[0].Value.(string)
+	uuid, _ = callback.Args[1].Value.(string)
+	return uuid, reason, true
+}
+
+// saveDeadLetter persists dead-lettered task
+func saveDeadLetter(db *leveldb.DB, deadLetter DeadLetter) error {
+	value, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return db.Put(deadLetterKey(deadLetter.UUID), value, nil)
+}
+
+// ListDeadLetters returns dead-lettered tasks kept in bridge db
+func ListDeadLetters(db *leveldb.DB) ([]DeadLetter, error) {
+	deadLetters := []DeadLetter{}
+
+	iter := db.NewIterator(leveldbUtil.BytesPrefix([]byte(deadLetterPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(iter.Value(), &deadLetter); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, iter.Error()
+}
+
+// ReplayDeadLetter publishes dead-lettered task again with attempts reset and removes its record
+func (qc *QueueConnector) ReplayDeadLetter(uuid string) error {
+	value, err := qc.db.Get(deadLetterKey(uuid), nil)
+	if err == leveldb.ErrNotFound {
+		return ErrDeadLetterNotFound
+	} else if err != nil {
+		return err
+	}
+
+	var deadLetter DeadLetter
+	if err := json.Unmarshal(value, &deadLetter); err != nil {
+		return err
+	}
+
+	// task starts over with no attempts
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(deadLetter.Payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		return fmt.Errorf("malformed task cannot be replayed: %v", err)
+	}
+	setTaskAttempts(signature, 0)
+	signature.RetryCount = replayRetryCount
+	signature.ETA = nil
+
+	body, err := json.Marshal(signature)
+	if err != nil {
+		return err
+	}
+
+	if err := qc.backends[laneIndex(signature.Priority)].Publish(body); err != nil {
+		return err
+	}
+
+	qc.logger.Info("Replayed dead-lettered task", "uuid", uuid, "taskName", deadLetter.TaskName)
+	return qc.db.Delete(deadLetterKey(uuid), nil)
+}
+
+// DeadLetters returns dead-lettered tasks
+func (qc *QueueConnector) DeadLetters() ([]DeadLetter, error) {
+	return ListDeadLetters(qc.db)
+}
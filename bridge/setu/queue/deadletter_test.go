@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// failingProcessor fails every task for good, calling error callbacks like machinery worker does
+type failingProcessor struct {
+	broker *backendBroker
+	reason string
+}
+
+func (fp *failingProcessor) Process(signature *tasks.Signature) error {
+	for _, callback := range signature.OnError {
+		callback.Args = append([]tasks.Arg{{Type: "string", Value: fp.reason}}, callback.Args...)
+		if err := fp.broker.Publish(context.Background(), callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fp *failingProcessor) CustomQueue() string { return "" }
+
+func (fp *failingProcessor) PreConsumeHandler() bool { return true }
+
+func TestDeadLetter(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	backends, err := NewLaneBackends(BackendLevelDB, "", db)
+	require.NoError(t, err)
+	broker := newBackendBroker(&config.Config{DefaultQueue: QueueName}, backends, db, 2, log.NewNopLogger())
+	broker.SetRegisteredTaskNames([]string{"sendTask"})
+	processor := &failingProcessor{broker: broker, reason: "boom"}
+	slots := newLaneSlots(1)
+
+	// tasks without priority go to normal lane
+	backend := backends[laneIndex(PriorityNormal)]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deliveries, err := backend.Consume(ctx)
+	require.NoError(t, err)
+
+	// task failing for good is dead-lettered with failure reason
+	require.NoError(t, broker.Publish(ctx, &tasks.Signature{UUID: "task_failed", Name: "sendTask"}))
+	delivery := receive(t, deliveries)
+	delivery.lane = laneIndex(PriorityNormal)
+	broker.consumeOne(delivery, slots, processor)
+
+	// task delivered more than max attempts is dead-lettered without running
+	exhausted := &tasks.Signature{UUID: "task_exhausted", Name: "sendTask"}
+	setTaskAttempts(exhausted, 2)
+	require.NoError(t, broker.Publish(ctx, exhausted))
+	delivery = receive(t, deliveries)
+	delivery.lane = laneIndex(PriorityNormal)
+	broker.consumeOne(delivery, slots, processor)
+
+	deadLetters, err := ListDeadLetters(db)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, "task_exhausted", deadLetters[0].UUID)
+	require.Equal(t, 2, deadLetters[0].Attempts)
+	require.Equal(t, "exceeded max attempts 2", deadLetters[0].Reason)
+	require.Equal(t, "task_failed", deadLetters[1].UUID)
+	require.Equal(t, 1, deadLetters[1].Attempts)
+	require.Equal(t, "boom", deadLetters[1].Reason)
+
+	depth, err := backend.Depth()
+	require.NoError(t, err)
+	require.Equal(t, 0, depth)
+
+	// replayed task is queued again with attempts reset
+	connector := &QueueConnector{logger: log.NewNopLogger(), backends: backends, db: db}
+	require.NoError(t, connector.ReplayDeadLetter("task_exhausted"))
+	require.Equal(t, ErrDeadLetterNotFound, connector.ReplayDeadLetter("task_exhausted"))
+
+	replayed := receive(t, deliveries)
+	require.Contains(t, string(replayed.Body), "task_exhausted")
+
+	deadLetters, err = connector.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+}
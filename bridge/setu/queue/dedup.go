@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// taskKeyPrefix prefixes idempotency keys of published tasks in bridge db
+const taskKeyPrefix = "task-key-"
+
+var (
+	// TaskKeyRetention is how long idempotency keys are kept, events are not seen again after that
+	TaskKeyRetention = 7 * 24 * time.Hour
+
+	// taskKeyPruneInterval is how often expired idempotency keys are removed
+	taskKeyPruneInterval = time.Hour
+)
+
+// NewTaskKey returns idempotency key of task published for an event. block identifies block the
+// event was emitted in, block hash on chains which may reorg so events of replacing blocks are
+// not taken for duplicates.
+func NewTaskKey(eventType string, chain string, block string, logIndex uint64) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%d", eventType, chain, block, logIndex)))
+	return hex.EncodeToString(hash[:])
+}
+
+func taskKey(key string) []byte {
+	return []byte(taskKeyPrefix + key)
+}
+
+// SendTaskOnce publishes task unless a task with same idempotency key was published before.
+// Returns false if task was dropped as duplicate.
+func (qc *QueueConnector) SendTaskOnce(key string, signature *tasks.Signature) (bool, error) {
+	qc.dedupMu.Lock()
+	if has, err := qc.db.Has(taskKey(key), nil); err != nil {
+		qc.dedupMu.Unlock()
+		return false, err
+	} else if has {
+		qc.dedupMu.Unlock()
+		duplicateTasksCounter.WithLabelValues(signature.Name).Inc()
+		return false, nil
+	}
+
+	// claim key before publishing so concurrent publishes of same event are dropped
+	now := time.Now()
+	if err := qc.db.Put(taskKey(key), []byte(strconv.FormatInt(now.Unix(), 10)), nil); err != nil {
+		qc.dedupMu.Unlock()
+		return false, err
+	}
+
+	if now.Sub(qc.lastKeyPrune) > taskKeyPruneInterval {
+		qc.lastKeyPrune = now
+		if err := pruneTaskKeys(qc.db, now); err != nil {
+			qc.logger.Error("Error while pruning task idempotency keys", "error", err)
+		}
+	}
+	qc.dedupMu.Unlock()
+
+	if _, err := qc.Server.SendTask(signature); err != nil {
+		// event is published again by next attempt
+		if err := qc.db.Delete(taskKey(key), nil); err != nil {
+			qc.logger.Error("Error while releasing task idempotency key", "error", err)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PurgeTaskKeys removes all idempotency keys, so events seen again are published again, and returns their number
+func PurgeTaskKeys(db *leveldb.DB) (int, error) {
+	return util.DeleteKeysWithPrefix(db, []byte(taskKeyPrefix))
+}
+
+// pruneTaskKeys removes idempotency keys older than retention
+func pruneTaskKeys(db *leveldb.DB, now time.Time) error {
+	batch := new(leveldb.Batch)
+	iter := db.NewIterator(leveldbUtil.BytesPrefix([]byte(taskKeyPrefix)), nil)
+	for iter.Next() {
+		publishedAt, err := strconv.ParseInt(string(iter.Value()), 10, 64)
+		if err != nil || now.Sub(time.Unix(publishedAt, 0)) > TaskKeyRetention {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return db.Write(batch, nil)
+}
@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestSendTaskOnce(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	connector := NewQueueConnector(BackendLevelDB, "", db, 0)
+
+	key := NewTaskKey("StateSynced", "ethereum", "0x01", 3)
+	require.NotEqual(t, key, NewTaskKey("StateSynced", "ethereum", "0x01", 4))
+
+	sent, err := connector.SendTaskOnce(key, &tasks.Signature{Name: "sendStateSyncedToHeimdall"})
+	require.NoError(t, err)
+	require.True(t, sent)
+
+	// same event seen again by another path is dropped
+	sent, err = connector.SendTaskOnce(key, &tasks.Signature{Name: "sendStateSyncedToHeimdall"})
+	require.NoError(t, err)
+	require.False(t, sent)
+
+	sent, err = connector.SendTaskOnce(NewTaskKey("StateSynced", "ethereum", "0x01", 4), &tasks.Signature{Name: "sendStateSyncedToHeimdall"})
+	require.NoError(t, err)
+	require.True(t, sent)
+
+	depth, err := connector.QueueDepth()
+	require.NoError(t, err)
+	require.Equal(t, 2, depth)
+}
@@ -0,0 +1,271 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// States of inspected tasks
+const (
+	TaskStatePending  = "pending"
+	TaskStateInFlight = "in-flight"
+	TaskStateFailed   = "failed"
+)
+
+// publishedAtHeader records when task was first published, kept across retries as machinery
+// republishes same signature
+const publishedAtHeader = "published_at"
+
+var (
+	// ErrTaskNotFound is returned when inspecting or requeueing unknown task
+	ErrTaskNotFound = errors.New("task not found")
+
+	// ErrTaskInFlight is returned when requeueing task being processed
+	ErrTaskInFlight = errors.New("task is being processed")
+)
+
+// TaskInfo describes task queued, being processed or dead-lettered by bridge
+type TaskInfo struct {
+	UUID        string          `json:"uuid"`
+	TaskName    string          `json:"task_name"`
+	State       string          `json:"state"`
+	Lane        string          `json:"lane"`
+	Attempts    int             `json:"attempts"`
+	RetryCount  int             `json:"retry_count"`
+	PublishedAt *time.Time      `json:"published_at,omitempty"`
+	ETA         *time.Time      `json:"eta,omitempty"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FailedAt    *time.Time      `json:"failed_at,omitempty"`
+	Reason      string          `json:"reason,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// TaskList is tasks of bridge. Queued tasks of lanes whose backend cannot list them without
+// consuming them are only counted in Unlisted.
+type TaskList struct {
+	Tasks    []TaskInfo     `json:"tasks"`
+	Unlisted map[string]int `json:"unlisted,omitempty"`
+}
+
+// taskLister is implemented by queue backends able to list queued messages without consuming them
+type taskLister interface {
+	// Queued returns bodies of messages waiting in queue, oldest first
+	Queued() ([][]byte, error)
+}
+
+// taskPublishedAt returns when task was first published, nil if it is not known
+func taskPublishedAt(signature *tasks.Signature) *time.Time {
+	value, ok := signature.Headers[publishedAtHeader].(string)
+	if !ok {
+		return nil
+	}
+
+	publishedAt, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &publishedAt
+}
+
+// setTaskPublishedAt records publish time of task in its headers unless it was published before
+func setTaskPublishedAt(signature *tasks.Signature, now time.Time) {
+	if signature.Headers == nil {
+		signature.Headers = tasks.Headers{}
+	}
+	if _, ok := signature.Headers[publishedAtHeader]; !ok {
+		signature.Headers[publishedAtHeader] = now.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// newTaskInfo describes task in state from its queued message body
+func newTaskInfo(state string, body []byte) TaskInfo {
+	info := TaskInfo{State: state, Lane: Lanes[laneIndex(0)], Payload: body}
+	if !json.Valid(body) {
+		// keep malformed payload readable
+		info.Payload, _ = json.Marshal(string(body))
+	}
+
+	signature := new(tasks.Signature)
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(signature); err != nil {
+		info.Reason = fmt.Sprintf("malformed task: %v", err)
+		return info
+	}
+
+	info.UUID = signature.UUID
+	info.TaskName = signature.Name
+	info.Lane = Lanes[laneIndex(signature.Priority)]
+	info.Attempts = taskAttempts(signature)
+	info.RetryCount = signature.RetryCount
+	info.PublishedAt = taskPublishedAt(signature)
+	info.ETA = signature.ETA
+	return info
+}
+
+// deadLetterTaskInfo describes dead-lettered task
+func deadLetterTaskInfo(deadLetter DeadLetter) TaskInfo {
+	info := newTaskInfo(TaskStateFailed, deadLetter.Payload)
+	failedAt := deadLetter.FailedAt
+
+	info.UUID = deadLetter.UUID
+	info.TaskName = deadLetter.TaskName
+	info.Attempts = deadLetter.Attempts
+	info.FailedAt = &failedAt
+	info.Reason = deadLetter.Reason
+	return info
+}
+
+// Tasks returns queued and in-flight tasks of all lanes and dead-lettered tasks
+func (qc *QueueConnector) Tasks() (TaskList, error) {
+	list := TaskList{Tasks: qc.broker.trackedTasks()}
+
+	for i, backend := range qc.backends {
+		lister, ok := backend.(taskLister)
+		if !ok {
+			depth, err := backend.Depth()
+			if err != nil {
+				return TaskList{}, err
+			}
+			if list.Unlisted == nil {
+				list.Unlisted = make(map[string]int)
+			}
+			list.Unlisted[Lanes[i]] = depth
+			continue
+		}
+
+		bodies, err := lister.Queued()
+		if err != nil {
+			return TaskList{}, err
+		}
+		for _, body := range bodies {
+			list.Tasks = append(list.Tasks, newTaskInfo(TaskStatePending, body))
+		}
+	}
+
+	deadLetters, err := qc.DeadLetters()
+	if err != nil {
+		return TaskList{}, err
+	}
+	for _, deadLetter := range deadLetters {
+		list.Tasks = append(list.Tasks, deadLetterTaskInfo(deadLetter))
+	}
+
+	return list, nil
+}
+
+// Task returns task with uuid
+func (qc *QueueConnector) Task(uuid string) (TaskInfo, error) {
+	list, err := qc.Tasks()
+	if err != nil {
+		return TaskInfo{}, err
+	}
+
+	for _, task := range list.Tasks {
+		if task.UUID == uuid {
+			return task, nil
+		}
+	}
+	return TaskInfo{}, ErrTaskNotFound
+}
+
+// RequeueTask replays dead-lettered task, or runs task waiting for its retry right away
+func (qc *QueueConnector) RequeueTask(uuid string) error {
+	if err := qc.ReplayDeadLetter(uuid); err != ErrDeadLetterNotFound {
+		return err
+	}
+
+	return qc.broker.wakeTask(uuid)
+}
+
+// trackedTask is task delivered to broker and not acked yet
+type trackedTask struct {
+	info TaskInfo
+
+	// closed to run task waiting for its ETA right away
+	wake chan struct{}
+}
+
+// trackTask records delivered task as pending until it is processed
+func (b *backendBroker) trackTask(delivery *Delivery, signature *tasks.Signature) *trackedTask {
+	task := &trackedTask{
+		info: newTaskInfo(TaskStatePending, delivery.Body),
+		wake: make(chan struct{}),
+	}
+	task.info.Lane = Lanes[delivery.lane]
+
+	if signature.UUID != "" {
+		b.trackedMu.Lock()
+		b.tracked[signature.UUID] = task
+		b.trackedMu.Unlock()
+	}
+	return task
+}
+
+// startTask marks tracked task in flight
+func (b *backendBroker) startTask(task *trackedTask, attempts int) {
+	now := time.Now().UTC()
+
+	b.trackedMu.Lock()
+	defer b.trackedMu.Unlock()
+
+	task.info.State = TaskStateInFlight
+	task.info.Attempts = attempts
+	task.info.StartedAt = &now
+}
+
+// untrackTask forgets delivered task once it is done, unless its retry was delivered meanwhile
+func (b *backendBroker) untrackTask(uuid string, task *trackedTask) {
+	b.trackedMu.Lock()
+	defer b.trackedMu.Unlock()
+
+	if b.tracked[uuid] == task {
+		delete(b.tracked, uuid)
+	}
+}
+
+// trackedTasks returns tasks delivered to broker and not acked yet, oldest delivered first
+func (b *backendBroker) trackedTasks() []TaskInfo {
+	b.trackedMu.Lock()
+	defer b.trackedMu.Unlock()
+
+	infos := make([]TaskInfo, 0, len(b.tracked))
+	for _, task := range b.tracked {
+		infos = append(infos, task.info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].PublishedAt == nil || infos[j].PublishedAt == nil {
+			return infos[j].PublishedAt == nil && infos[i].PublishedAt != nil
+		}
+		return infos[i].PublishedAt.Before(*infos[j].PublishedAt)
+	})
+	return infos
+}
+
+// wakeTask runs tracked task waiting for its ETA right away
+func (b *backendBroker) wakeTask(uuid string) error {
+	b.trackedMu.Lock()
+	defer b.trackedMu.Unlock()
+
+	task, ok := b.tracked[uuid]
+	if !ok {
+		return ErrTaskNotFound
+	}
+	if task.info.State != TaskStatePending {
+		return ErrTaskInFlight
+	}
+
+	select {
+	case <-task.wake:
+	default:
+		close(task.wake)
+	}
+	return nil
+}
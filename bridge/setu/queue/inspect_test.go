@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// blockingProcessor holds every task until released
+type blockingProcessor struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (bp *blockingProcessor) Process(signature *tasks.Signature) error {
+	bp.started <- struct{}{}
+	<-bp.release
+	return nil
+}
+
+func (bp *blockingProcessor) CustomQueue() string { return "" }
+
+func (bp *blockingProcessor) PreConsumeHandler() bool { return true }
+
+func TestInspectTasks(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	backends, err := NewLaneBackends(BackendLevelDB, "", db)
+	require.NoError(t, err)
+	broker := newBackendBroker(&config.Config{DefaultQueue: QueueName}, backends, db, 2, log.NewNopLogger())
+	broker.SetRegisteredTaskNames([]string{"sendTask", "sendCheckpointToRootchain"})
+	connector := &QueueConnector{logger: log.NewNopLogger(), backends: backends, broker: broker, db: db}
+	slots := newLaneSlots(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// queued task is listed with lane of its priority and publish time
+	require.NoError(t, broker.Publish(ctx, &tasks.Signature{UUID: "task_queued", Name: "sendCheckpointToRootchain", RetryCount: 3}))
+	task, err := connector.Task("task_queued")
+	require.NoError(t, err)
+	require.Equal(t, TaskStatePending, task.State)
+	require.Equal(t, LaneHigh, task.Lane)
+	require.Equal(t, 3, task.RetryCount)
+	require.NotNil(t, task.PublishedAt)
+
+	// task waiting for retry is run right away once requeued
+	deliveries, err := backends[laneIndex(PriorityNormal)].Consume(ctx)
+	require.NoError(t, err)
+
+	eta := time.Now().Add(time.Hour)
+	require.NoError(t, broker.Publish(ctx, &tasks.Signature{UUID: "task_delayed", Name: "sendTask", ETA: &eta}))
+	delivery := receive(t, deliveries)
+	delivery.lane = laneIndex(PriorityNormal)
+
+	processor := &blockingProcessor{started: make(chan struct{}), release: make(chan struct{})}
+	done := make(chan struct{})
+	go func() {
+		broker.consumeOne(delivery, slots, processor)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		task, err := connector.Task("task_delayed")
+		return err == nil && task.State == TaskStatePending && task.ETA != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, connector.RequeueTask("task_delayed"))
+	<-processor.started
+
+	task, err = connector.Task("task_delayed")
+	require.NoError(t, err)
+	require.Equal(t, TaskStateInFlight, task.State)
+	require.Equal(t, 1, task.Attempts)
+	require.NotNil(t, task.StartedAt)
+	require.Equal(t, ErrTaskInFlight, connector.RequeueTask("task_delayed"))
+
+	close(processor.release)
+	<-done
+	_, err = connector.Task("task_delayed")
+	require.Equal(t, ErrTaskNotFound, err)
+	require.Equal(t, ErrTaskNotFound, connector.RequeueTask("task_delayed"))
+
+	// dead-lettered task is listed as failed and replayed once requeued
+	require.NoError(t, broker.Publish(ctx, &tasks.Signature{UUID: "task_failed", Name: "sendTask"}))
+	delivery = receive(t, deliveries)
+	delivery.lane = laneIndex(PriorityNormal)
+	broker.consumeOne(delivery, slots, &failingProcessor{broker: broker, reason: "boom"})
+
+	task, err = connector.Task("task_failed")
+	require.NoError(t, err)
+	require.Equal(t, TaskStateFailed, task.State)
+	require.Equal(t, "boom", task.Reason)
+	require.Equal(t, 1, task.Attempts)
+	require.NotNil(t, task.FailedAt)
+
+	require.NoError(t, connector.RequeueTask("task_failed"))
+	replayed := receive(t, deliveries)
+	require.Contains(t, string(replayed.Body), "task_failed")
+}
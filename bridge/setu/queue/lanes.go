@@ -0,0 +1,132 @@
+package queue
+
+import (
+	"sync"
+)
+
+// Task priorities, tasks are published to lane of their priority and higher priority lanes are
+// consumed first. Signatures without priority go to normal lane.
+const (
+	PriorityLow    uint8 = 1
+	PriorityNormal uint8 = 5
+	PriorityHigh   uint8 = 9
+)
+
+// Lanes of task queue, in order they are consumed
+const (
+	LaneHigh   = "high"
+	LaneNormal = "normal"
+	LaneLow    = "low"
+)
+
+// Lanes lists queue lanes from highest priority
+var Lanes = []string{LaneHigh, LaneNormal, LaneLow}
+
+// taskPriorities are priorities of time-sensitive consensus tasks and of bulk tasks, tasks not
+// listed are normal priority
+var taskPriorities = map[string]uint8{
+	"sendCheckpointToRootchain":       PriorityHigh,
+	"sendCheckpointAckToHeimdall":     PriorityHigh,
+	"sendCheckpointSyncAckToHeimdall": PriorityHigh,
+	"sendTickToHeimdall":              PriorityHigh,
+	"sendTickToRootchain":             PriorityHigh,
+	"sendTickAckToHeimdall":           PriorityHigh,
+
+	"sendTopUpFeeToHeimdall": PriorityLow,
+}
+
+// TaskPriority returns priority tasks of taskName are published with
+func TaskPriority(taskName string) uint8 {
+	if priority, ok := taskPriorities[taskName]; ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// laneIndex returns index in Lanes of lane tasks with priority are published to
+func laneIndex(priority uint8) int {
+	switch {
+	case priority == 0:
+		return 1
+	case priority >= PriorityHigh:
+		return 0
+	case priority <= PriorityLow:
+		return 2
+	}
+	return 1
+}
+
+// laneSuffix distinguishes names of lane queues, normal lane keeps names of the single queue
+// used before lanes so tasks queued by then are still consumed
+func laneSuffix(lane string, separator string) string {
+	if lane == LaneNormal {
+		return ""
+	}
+	return separator + lane
+}
+
+// laneSlots hands worker slots to waiting tasks of highest priority lane first
+type laneSlots struct {
+	mu      sync.Mutex
+	free    int
+	waiting [][]chan struct{}
+}
+
+func newLaneSlots(size int) *laneSlots {
+	return &laneSlots{
+		free:    size,
+		waiting: make([][]chan struct{}, len(Lanes)),
+	}
+}
+
+// acquire blocks until task of lane gets a worker slot, returns false if stop is closed first
+func (ls *laneSlots) acquire(lane int, stop <-chan int) bool {
+	ls.mu.Lock()
+	if ls.free > 0 {
+		ls.free--
+		ls.mu.Unlock()
+		return true
+	}
+
+	granted := make(chan struct{})
+	ls.waiting[lane] = append(ls.waiting[lane], granted)
+	ls.mu.Unlock()
+
+	select {
+	case <-granted:
+		return true
+	case <-stop:
+		ls.mu.Lock()
+		defer ls.mu.Unlock()
+
+		for i, waiter := range ls.waiting[lane] {
+			if waiter == granted {
+				ls.waiting[lane] = append(ls.waiting[lane][:i], ls.waiting[lane][i+1:]...)
+				return false
+			}
+		}
+
+		// slot was granted meanwhile, pass it on
+		ls.releaseLocked()
+		return false
+	}
+}
+
+// release returns worker slot
+func (ls *laneSlots) release() {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	ls.releaseLocked()
+}
+
+func (ls *laneSlots) releaseLocked() {
+	for lane, waiters := range ls.waiting {
+		if len(waiters) > 0 {
+			close(waiters[0])
+			ls.waiting[lane] = waiters[1:]
+			return
+		}
+	}
+	ls.free++
+}
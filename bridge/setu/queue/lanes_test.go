@@ -0,0 +1,47 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskPriority(t *testing.T) {
+	require.Equal(t, 0, laneIndex(TaskPriority("sendCheckpointAckToHeimdall")))
+	require.Equal(t, 1, laneIndex(TaskPriority("sendStateSyncedToHeimdall")))
+	require.Equal(t, 2, laneIndex(TaskPriority("sendTopUpFeeToHeimdall")))
+
+	// signatures without priority go to normal lane
+	require.Equal(t, 1, laneIndex(0))
+}
+
+func TestLaneSlotsPreferHigherLanes(t *testing.T) {
+	slots := newLaneSlots(1)
+	stop := make(chan int)
+	require.True(t, slots.acquire(1, stop))
+
+	// low lane task waits first, high lane task arrives later
+	granted := make(chan int, 2)
+	go func() {
+		if slots.acquire(2, stop) {
+			granted <- 2
+		}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	go func() {
+		if slots.acquire(0, stop) {
+			granted <- 0
+		}
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	slots.release()
+	require.Equal(t, 0, <-granted)
+	slots.release()
+	require.Equal(t, 2, <-granted)
+
+	// waiting task gives up on stop
+	close(stop)
+	require.False(t, slots.acquire(1, stop))
+}
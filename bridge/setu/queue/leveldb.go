@@ -0,0 +1,245 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// levelDBTaskPrefix prefixes keys of queued tasks of normal lane in bridge db, keys sort in publish order
+const levelDBTaskPrefix = "queue-task-"
+
+// levelDBLanePrefix returns prefix of keys of queued tasks of lane
+func levelDBLanePrefix(lane string) string {
+	if lane == LaneNormal {
+		return levelDBTaskPrefix
+	}
+	return "queue-" + lane + "-task-"
+}
+
+var (
+	// levelDBPollInterval is how often consumer looks for requeued tasks without new publishes
+	levelDBPollInterval = time.Second
+
+	// levelDBRequeueDelay is how long a requeued task waits before it is delivered again
+	levelDBRequeueDelay = time.Second
+)
+
+// levelDBBackend is an embedded queue kept in bridge db, so small validators can run bridge
+// without operating a broker. Tasks delivered but not acked before a crash are delivered again.
+type levelDBBackend struct {
+	db     *leveldb.DB
+	prefix string
+
+	mu       sync.Mutex
+	seq      uint64
+	inFlight map[string]struct{}
+	// requeued tasks are not delivered before their time
+	notBefore map[string]time.Time
+
+	// signals consumer that a task was published
+	published chan struct{}
+}
+
+func newLevelDBBackend(db *leveldb.DB, lane string) (*levelDBBackend, error) {
+	backend := &levelDBBackend{
+		db:        db,
+		prefix:    levelDBLanePrefix(lane),
+		inFlight:  make(map[string]struct{}),
+		notBefore: make(map[string]time.Time),
+		published: make(chan struct{}, 1),
+	}
+
+	// continue sequence after last queued task
+	iter := db.NewIterator(leveldbUtil.BytesPrefix([]byte(backend.prefix)), nil)
+	if iter.Last() {
+		seq, err := strconv.ParseUint(strings.TrimPrefix(string(iter.Key()), backend.prefix), 10, 64)
+		if err != nil {
+			iter.Release()
+			return nil, fmt.Errorf("invalid queued task key %s: %v", iter.Key(), err)
+		}
+		backend.seq = seq
+	}
+	iter.Release()
+
+	return backend, iter.Error()
+}
+
+func (lb *levelDBBackend) taskKey(seq uint64) string {
+	return fmt.Sprintf("%s%020d", lb.prefix, seq)
+}
+
+// Publish implements QueueBackend
+func (lb *levelDBBackend) Publish(body []byte) error {
+	lb.mu.Lock()
+	lb.seq++
+	key := lb.taskKey(lb.seq)
+	lb.mu.Unlock()
+
+	if err := lb.db.Put([]byte(key), body, nil); err != nil {
+		return err
+	}
+
+	select {
+	case lb.published <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// next returns oldest task ready for delivery and marks it in flight, nil if there is none
+func (lb *levelDBBackend) next(now time.Time) (*Delivery, error) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	iter := lb.db.NewIterator(leveldbUtil.BytesPrefix([]byte(lb.prefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		key := string(iter.Key())
+		if _, ok := lb.inFlight[key]; ok {
+			continue
+		}
+		if notBefore, ok := lb.notBefore[key]; ok && now.Before(notBefore) {
+			continue
+		}
+
+		lb.inFlight[key] = struct{}{}
+		delete(lb.notBefore, key)
+		return &Delivery{Body: append([]byte{}, iter.Value()...), tag: key}, nil
+	}
+
+	return nil, iter.Error()
+}
+
+// Consume implements QueueBackend
+func (lb *levelDBBackend) Consume(ctx context.Context) (<-chan *Delivery, error) {
+	out := make(chan *Delivery)
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(levelDBPollInterval)
+		defer ticker.Stop()
+
+		for {
+			delivery, err := lb.next(time.Now())
+			if err != nil {
+				return
+			}
+
+			if delivery == nil {
+				select {
+				case <-lb.published:
+				case <-ticker.C:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+
+			select {
+			case out <- delivery:
+			case <-ctx.Done():
+				_ = lb.Nack(delivery, true)
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Ack implements QueueBackend
+func (lb *levelDBBackend) Ack(delivery *Delivery) error {
+	key := delivery.tag.(string)
+	if err := lb.db.Delete([]byte(key), nil); err != nil {
+		return err
+	}
+
+	lb.mu.Lock()
+	delete(lb.inFlight, key)
+	lb.mu.Unlock()
+	return nil
+}
+
+// Nack implements QueueBackend
+func (lb *levelDBBackend) Nack(delivery *Delivery, requeue bool) error {
+	if !requeue {
+		return lb.Ack(delivery)
+	}
+
+	key := delivery.tag.(string)
+
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	delete(lb.inFlight, key)
+	lb.notBefore[key] = time.Now().Add(levelDBRequeueDelay)
+	return nil
+}
+
+// Depth implements QueueBackend, counting tasks not delivered yet
+func (lb *levelDBBackend) Depth() (int, error) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	iter := lb.db.NewIterator(leveldbUtil.BytesPrefix([]byte(lb.prefix)), nil)
+	defer iter.Release()
+
+	depth := 0
+	for iter.Next() {
+		if _, ok := lb.inFlight[string(iter.Key())]; !ok {
+			depth++
+		}
+	}
+	return depth, iter.Error()
+}
+
+// Queued implements taskLister, listing tasks not delivered yet
+func (lb *levelDBBackend) Queued() ([][]byte, error) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	iter := lb.db.NewIterator(leveldbUtil.BytesPrefix([]byte(lb.prefix)), nil)
+	defer iter.Release()
+
+	var bodies [][]byte
+	for iter.Next() {
+		if _, ok := lb.inFlight[string(iter.Key())]; !ok {
+			bodies = append(bodies, append([]byte{}, iter.Value()...))
+		}
+	}
+	return bodies, iter.Error()
+}
+
+// Purge implements QueueBackend, tasks in flight are kept
+func (lb *levelDBBackend) Purge() error {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+
+	batch := new(leveldb.Batch)
+	iter := lb.db.NewIterator(leveldbUtil.BytesPrefix([]byte(lb.prefix)), nil)
+	for iter.Next() {
+		if _, ok := lb.inFlight[string(iter.Key())]; !ok {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	lb.notBefore = make(map[string]time.Time)
+	return lb.db.Write(batch, nil)
+}
+
+// Close implements QueueBackend, bridge db is closed by its owner
+func (lb *levelDBBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func newTestLevelDBBackend(t *testing.T, db *leveldb.DB) *levelDBBackend {
+	backend, err := newLevelDBBackend(db, LaneNormal)
+	require.NoError(t, err)
+	return backend
+}
+
+func receive(t *testing.T, deliveries <-chan *Delivery) *Delivery {
+	select {
+	case delivery := <-deliveries:
+		return delivery
+	case <-time.After(5 * time.Second):
+		t.Fatal("no task delivered")
+	}
+	return nil
+}
+
+func TestLevelDBBackend(t *testing.T) {
+	levelDBRequeueDelay = 0
+
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	backend := newTestLevelDBBackend(t, db)
+	require.NoError(t, backend.Publish([]byte("first")))
+	require.NoError(t, backend.Publish([]byte("second")))
+
+	depth, err := backend.Depth()
+	require.NoError(t, err)
+	require.Equal(t, 2, depth)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	deliveries, err := backend.Consume(ctx)
+	require.NoError(t, err)
+
+	// tasks are delivered in publish order
+	first := receive(t, deliveries)
+	require.Equal(t, []byte("first"), first.Body)
+	second := receive(t, deliveries)
+	require.Equal(t, []byte("second"), second.Body)
+
+	// requeued task is delivered again, acked one is gone
+	require.NoError(t, backend.Nack(first, true))
+	require.NoError(t, backend.Ack(second))
+	require.Equal(t, []byte("first"), receive(t, deliveries).Body)
+
+	// queued tasks survive restart and sequence continues after them
+	cancel()
+	restarted := newTestLevelDBBackend(t, db)
+	require.NoError(t, restarted.Publish([]byte("third")))
+
+	depth, err = restarted.Depth()
+	require.NoError(t, err)
+	require.Equal(t, 2, depth)
+
+	require.NoError(t, restarted.Purge())
+	depth, err = restarted.Depth()
+	require.NoError(t, err)
+	require.Equal(t, 0, depth)
+}
@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"sync"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// maxTrackedTasks bounds number of task ids remembered to tell retries from first attempts
+var maxTrackedTasks = 10000
+
+var (
+	// taskAttemptsCounter counts task runs started by worker
+	taskAttemptsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: "queue",
+		Name:      "task_attempts_total",
+		Help:      "Number of task runs started by bridge worker, by task.",
+	}, []string{"task"})
+
+	// taskRetriesCounter counts task runs which are retries of a failed run
+	taskRetriesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: "queue",
+		Name:      "task_retries_total",
+		Help:      "Number of task runs retrying a failed run, by task.",
+	}, []string{"task"})
+
+	// deadLetteredCounter counts tasks moved to dead-letter queue
+	deadLetteredCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: "queue",
+		Name:      "dead_lettered_total",
+		Help:      "Number of tasks which failed for good and were dead-lettered, by task.",
+	}, []string{"task"})
+
+	// duplicateTasksCounter counts tasks dropped before publishing as duplicates
+	duplicateTasksCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bridge",
+		Subsystem: "queue",
+		Name:      "duplicate_tasks_total",
+		Help:      "Number of tasks dropped before publishing as duplicates of published ones, by task.",
+	}, []string{"task"})
+)
+
+func init() {
+	prometheus.MustRegister(taskAttemptsCounter, taskRetriesCounter, deadLetteredCounter, duplicateTasksCounter)
+}
+
+// taskTracker remembers recently started task ids, machinery republishes failed
+// tasks with same id so a known id marks a retry
+type taskTracker struct {
+	mu    sync.Mutex
+	ids   map[string]struct{}
+	order []string
+}
+
+func newTaskTracker() *taskTracker {
+	return &taskTracker{ids: make(map[string]struct{})}
+}
+
+// started records task run and returns true if it is a retry
+func (tt *taskTracker) started(uuid string) bool {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if _, ok := tt.ids[uuid]; ok {
+		return true
+	}
+
+	tt.ids[uuid] = struct{}{}
+	tt.order = append(tt.order, uuid)
+	if len(tt.order) > maxTrackedTasks {
+		delete(tt.ids, tt.order[0])
+		tt.order = tt.order[1:]
+	}
+	return false
+}
+
+// observeTask records task run metrics, used as worker pre task handler
+func (tt *taskTracker) observeTask(signature *tasks.Signature) {
+	taskAttemptsCounter.WithLabelValues(signature.Name).Inc()
+	if tt.started(signature.UUID) {
+		taskRetriesCounter.WithLabelValues(signature.Name).Inc()
+	}
+}
@@ -0,0 +1,343 @@
+package queue
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// natsSubject tasks of normal lane are published to, other lanes append lane to it
+	natsSubject = "bridge.tasks"
+
+	// natsQueueGroup load balances tasks between bridges consuming same subject
+	natsQueueGroup = "bridge"
+
+	natsDialTimeout = 10 * time.Second
+)
+
+var errNATSClosed = errors.New("nats connection closed")
+
+// natsBackend publishes tasks to NATS subject and consumes them in a queue group. Core NATS keeps
+// no messages, tasks published while no bridge consumes are lost and delivery is at most once.
+type natsBackend struct {
+	url     string
+	subject string
+
+	// connection used for publishing
+	mu   sync.Mutex
+	conn *natsConn
+
+	// tasks delivered but not acked yet
+	pending int64
+}
+
+func newNATSBackend(url string, lane string) (*natsBackend, error) {
+	backend := &natsBackend{
+		url:     url,
+		subject: natsSubject + laneSuffix(lane, "."),
+	}
+
+	// fail fast on unreachable server
+	conn, err := dialNATS(url, nil)
+	if err != nil {
+		return nil, err
+	}
+	backend.conn = conn
+
+	return backend, nil
+}
+
+// Publish implements QueueBackend
+func (nb *natsBackend) Publish(body []byte) error {
+	nb.mu.Lock()
+	defer nb.mu.Unlock()
+
+	if nb.conn == nil || nb.conn.isClosed() {
+		conn, err := dialNATS(nb.url, nil)
+		if err != nil {
+			return err
+		}
+		nb.conn = conn
+	}
+
+	if err := nb.conn.publish(nb.subject, body); err != nil {
+		nb.conn.close()
+		nb.conn = nil
+		return err
+	}
+	return nil
+}
+
+// Consume implements QueueBackend on a dedicated connection
+func (nb *natsBackend) Consume(ctx context.Context) (<-chan *Delivery, error) {
+	msgs := make(chan []byte)
+	conn, err := dialNATS(nb.url, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.subscribe(nb.subject, natsQueueGroup); err != nil {
+		conn.close()
+		return nil, err
+	}
+
+	out := make(chan *Delivery)
+	go func() {
+		defer close(out)
+		defer conn.close()
+
+		for {
+			select {
+			case body := <-msgs:
+				atomic.AddInt64(&nb.pending, 1)
+				select {
+				case out <- &Delivery{Body: body}:
+				case <-ctx.Done():
+					_ = nb.Nack(&Delivery{Body: body}, true)
+					return
+				}
+			case <-conn.closed:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Ack implements QueueBackend, NATS does not track delivered messages
+func (nb *natsBackend) Ack(delivery *Delivery) error {
+	atomic.AddInt64(&nb.pending, -1)
+	return nil
+}
+
+// Nack implements QueueBackend, requeued task is published again
+func (nb *natsBackend) Nack(delivery *Delivery, requeue bool) error {
+	atomic.AddInt64(&nb.pending, -1)
+	if !requeue {
+		return nil
+	}
+	return nb.Publish(delivery.Body)
+}
+
+// Depth implements QueueBackend. NATS does not queue messages, so it counts tasks delivered to
+// this bridge which are not processed yet.
+func (nb *natsBackend) Depth() (int, error) {
+	return int(atomic.LoadInt64(&nb.pending)), nil
+}
+
+// Purge implements QueueBackend
+func (nb *natsBackend) Purge() error {
+	return errPurgeNotSupported
+}
+
+// Close implements QueueBackend
+func (nb *natsBackend) Close() error {
+	nb.mu.Lock()
+	defer nb.mu.Unlock()
+
+	if nb.conn != nil {
+		nb.conn.close()
+		nb.conn = nil
+	}
+	return nil
+}
+
+// natsConn is a minimal client of NATS text protocol, enough to publish and subscribe
+type natsConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+
+	wmu sync.Mutex
+	w   *bufio.Writer
+
+	// received message payloads, nil for publish only connections
+	msgs chan<- []byte
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// natsConnectOptions is sent to server in CONNECT
+type natsConnectOptions struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	User     string `json:"user,omitempty"`
+	Pass     string `json:"pass,omitempty"`
+}
+
+// dialNATS connects to server at rawURL, messages of subscriptions are sent to msgs
+func dialNATS(rawURL string, msgs chan<- []byte) (*natsConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	conn, err := net.DialTimeout("tcp", host, natsDialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	nc := &natsConn{
+		conn:   conn,
+		r:      bufio.NewReader(conn),
+		w:      bufio.NewWriter(conn),
+		msgs:   msgs,
+		closed: make(chan struct{}),
+	}
+
+	if err := nc.handshake(u); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	go nc.readLoop()
+	return nc, nil
+}
+
+// handshake reads server INFO, sends CONNECT and waits for PONG confirming it
+func (nc *natsConn) handshake(u *url.URL) error {
+	_ = nc.conn.SetDeadline(time.Now().Add(natsDialTimeout))
+	defer nc.conn.SetDeadline(time.Time{}) //nolint:errcheck
+
+	line, err := nc.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected nats greeting: %s", line)
+	}
+
+	options := natsConnectOptions{Name: "bridge"}
+	if u.User != nil {
+		options.User = u.User.Username()
+		options.Pass, _ = u.User.Password()
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	if err := nc.write(fmt.Sprintf("CONNECT %s\r\nPING\r\n", connect)); err != nil {
+		return err
+	}
+
+	for {
+		line, err := nc.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats connect failed: %s", line)
+		}
+	}
+}
+
+// readLoop answers server pings and forwards messages until connection fails
+func (nc *natsConn) readLoop() {
+	defer nc.close()
+
+	for {
+		line, err := nc.readLine()
+		if err != nil {
+			return
+		}
+
+		switch {
+		case line == "PING":
+			if err := nc.write("PONG\r\n"); err != nil {
+				return
+			}
+		case strings.HasPrefix(line, "MSG "):
+			// MSG <subject> <sid> [reply-to] <#bytes>
+			fields := strings.Fields(line)
+			size, err := strconv.Atoi(fields[len(fields)-1])
+			if err != nil {
+				return
+			}
+
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(nc.r, payload); err != nil {
+				return
+			}
+
+			if nc.msgs != nil {
+				select {
+				case nc.msgs <- payload[:size]:
+				case <-nc.closed:
+					return
+				}
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return
+		}
+	}
+}
+
+func (nc *natsConn) readLine() (string, error) {
+	line, err := nc.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (nc *natsConn) write(data string) error {
+	nc.wmu.Lock()
+	defer nc.wmu.Unlock()
+
+	if nc.isClosed() {
+		return errNATSClosed
+	}
+	if _, err := nc.w.WriteString(data); err != nil {
+		return err
+	}
+	return nc.w.Flush()
+}
+
+func (nc *natsConn) publish(subject string, body []byte) error {
+	return nc.write(fmt.Sprintf("PUB %s %d\r\n%s\r\n", subject, len(body), body))
+}
+
+func (nc *natsConn) subscribe(subject string, queueGroup string) error {
+	return nc.write(fmt.Sprintf("SUB %s %s 1\r\n", subject, queueGroup))
+}
+
+func (nc *natsConn) isClosed() bool {
+	select {
+	case <-nc.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (nc *natsConn) close() {
+	nc.closeOnce.Do(func() {
+		close(nc.closed)
+		nc.conn.Close()
+	})
+}
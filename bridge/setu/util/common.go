@@ -38,11 +38,13 @@ const (
 	CheckpointActivationURL   = "/checkpoints/activation-height/%v"
 	ChainManagerParamsURL     = "/chainmanager/params"
 	ChainNewParamsURL         = "/chainmanager/newparams/%v" //for new eth forkChain such as bsc, replace address of params
+	RootChainsURL             = "/chainmanager/root-chains"
 	ProposersURL              = "/staking/proposer/%v"
 	BufferedCheckpointURL     = "/checkpoints/buffer/%v"
 	BufferedCheckpointSyncURL = "/checkpoints/sync/%v"
 	LatestCheckpointURL       = "/checkpoints/latest/%v"
 	CurrentProposerURL        = "/staking/current-proposer"
+	BackupProposersURL        = "/checkpoints/backup-proposers"
 	LatestSpanURL             = "/bor/latest-span"
 	NextSpanInfoURL           = "/bor/prepare-next-span"
 	NextSpanSeedURL           = "/bor/next-span-seed"
@@ -254,6 +256,30 @@ func IsCurrentProposer(cliCtx cliContext.CLIContext) (bool, error) {
 	return false, nil
 }
 
+// IsBackupProposer checks if we may propose checkpoint in place of offline proposers
+func IsBackupProposer(cliCtx cliContext.CLIContext) (bool, error) {
+	var backups checkpointTypes.BackupProposers
+	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(BackupProposersURL))
+	if err != nil {
+		logger.Error("Error fetching backup proposers", "error", err)
+		return false, err
+	}
+
+	if err := json.Unmarshal(result.Result, &backups); err != nil {
+		logger.Error("error unmarshalling backup proposers", "error", err)
+		return false, err
+	}
+
+	for _, proposer := range backups.Proposers {
+		if bytes.Equal(proposer.Signer.Bytes(), helper.GetAddress()) {
+			logger.Debug("Backup proposer after no-acks", "noAckStreak", backups.NoAckStreak)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // IsEventSender check if we are the EventSender
 func IsEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
 	var validator hmtypes.Validator
@@ -396,6 +422,27 @@ func GetNewChainParams(cliCtx cliContext.CLIContext, rootChain string) (*chainMa
 	return &params, nil
 }
 
+// GetRootChains returns root chains registered with chain manager
+func GetRootChains(cliCtx cliContext.CLIContext) ([]chainManagerTypes.RootChain, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(RootChainsURL),
+	)
+
+	if err != nil {
+		logger.Error("Error fetching registered root chains", "err", err)
+		return nil, err
+	}
+
+	var rootChains []chainManagerTypes.RootChain
+	if err := json.Unmarshal(response.Result, &rootChains); err != nil {
+		logger.Error("Error unmarshalling registered root chains", "url", RootChainsURL, "err", err)
+		return nil, err
+	}
+
+	return rootChains, nil
+}
+
 // GetCheckpointParams return params
 func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
 	response, err := helper.FetchFromAPI(
@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
 )
 
 var bridgeDB *leveldb.DB
@@ -27,3 +28,18 @@ func CloseBridgeDBInstance() {
 		}
 	})
 }
+
+// DeleteKeysWithPrefix deletes all keys with prefix from db and returns number of deleted keys
+func DeleteKeysWithPrefix(db *leveldb.DB, prefix []byte) (int, error) {
+	batch := new(leveldb.Batch)
+	iter := db.NewIterator(leveldbUtil.BytesPrefix(prefix), nil)
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), db.Write(batch, nil)
+}
@@ -0,0 +1,59 @@
+package util
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const orphanedBlockPrefix = "orphaned-block-"
+
+// OrphanedBlockRetention is how long orphaned block marks are kept, queued tasks are long done by then
+var OrphanedBlockRetention = 24 * time.Hour
+
+func orphanedBlockKey(hash common.Hash) []byte {
+	return []byte(orphanedBlockPrefix + hash.Hex())
+}
+
+// MarkBlocksOrphaned records block hashes orphaned by a reorg, so queued tasks derived
+// from them are dropped. Marks older than retention are removed.
+func MarkBlocksOrphaned(db *leveldb.DB, hashes []common.Hash) error {
+	now := time.Now()
+
+	batch := new(leveldb.Batch)
+	iter := db.NewIterator(leveldbUtil.BytesPrefix([]byte(orphanedBlockPrefix)), nil)
+	for iter.Next() {
+		markedAt, err := strconv.ParseInt(string(iter.Value()), 10, 64)
+		if err != nil || now.Sub(time.Unix(markedAt, 0)) > OrphanedBlockRetention {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		batch.Put(orphanedBlockKey(hash), []byte(strconv.FormatInt(now.Unix(), 10)))
+	}
+
+	return db.Write(batch, nil)
+}
+
+// IsBlockOrphaned returns true if block hash was orphaned by a reorg
+func IsBlockOrphaned(db *leveldb.DB, hash common.Hash) bool {
+	if db == nil {
+		return false
+	}
+
+	has, err := db.Has(orphanedBlockKey(hash), nil)
+	return err == nil && has
+}
+
+// PurgeOrphanedBlocks removes all orphaned block marks and returns their number
+func PurgeOrphanedBlocks(db *leveldb.DB) (int, error) {
+	return DeleteKeysWithPrefix(db, []byte(orphanedBlockPrefix))
+}
@@ -27,6 +27,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetQueryParams(cdc),
 			GetQueryProposalChainParam(cdc),
+			GetQueryRootChains(cdc),
 		)...,
 	)
 	return txCmd
@@ -109,3 +110,39 @@ $ %s query chainmanager params
 		},
 	}
 }
+
+// GetQueryRootChains implements the registered root chains query command.
+func GetQueryRootChains(cdc *codec.Codec) *cobra.Command {
+	//nolint: exhaustivestruct
+	return &cobra.Command{
+		Use:   "root-chains",
+		Args:  cobra.NoArgs,
+		Short: "show root chains registered with chain manager",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query root chains registered with chain manager, with their ids and activation heights.
+Example:
+$ %s query chainmanager root-chains
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRootChains)
+
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var rootChains []types.RootChain
+			if err = json.Unmarshal(bz, &rootChains); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(rootChains)
+		},
+	}
+}
@@ -10,4 +10,5 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/chainmanager/params", paramsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/chainmanager/newparams/{root}", queryNewParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/chainmanager/root-chains", rootChainsHandlerFn(cliCtx)).Methods("GET")
 }
@@ -9,16 +9,28 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	// root chains registered through governance are known before their chain infos are added
+	if data.ParamsWithMultiChains != nil {
+		keeper.SetParamsWithMultiChain(ctx, *data.ParamsWithMultiChains)
+	}
+
 	for _, chainInfo := range data.ChainInfos {
-		keeper.AddNewChainParams(ctx, chainInfo)
+		if err := keeper.AddNewChainParams(ctx, chainInfo); err != nil {
+			keeper.Logger(ctx).Error("InitGenesis | AddNewChainParams", "root", chainInfo.RootChainType, "error", err)
+		}
 	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		params,
 		keeper.GetNewChainParamsList(ctx),
 	)
+	if paramsWithMultiChains := keeper.GetParamsWithMultiChain(ctx); len(paramsWithMultiChains.ChainParameterMap) != 0 {
+		genesis.ParamsWithMultiChains = &paramsWithMultiChains
+	}
+	return genesis
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/maticnetwork/heimdall/chainmanager/types"
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // NewHandler new handler
@@ -30,7 +29,7 @@ func HandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, contrac
 
 	k.Logger(ctx).Debug("✅ Validating new chain msg", "msg", msg)
 
-	if hmTypes.GetRootChainID(msg.RootChainType) == 0 {
+	if k.GetRootChainID(ctx, msg.RootChainType) == 0 {
 		k.Logger(ctx).Error("Wrong root chain type", "root", msg.RootChainType)
 		return hmCommon.ErrWrongRootChain(k.Codespace()).Result()
 	}
@@ -2,6 +2,8 @@ package chainmanager
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -86,7 +88,7 @@ func (k *Keeper) GetChainParams(ctx sdk.Context, rootChain string) (types.ChainI
 
 	store := ctx.KVStore(k.storeKey)
 
-	key := append(NewChainParamsKey, hmTypes.GetRootChainID(rootChain)) //nolint:gocritic
+	key := append(NewChainParamsKey, k.GetRootChainID(ctx, rootChain)) //nolint:gocritic
 	if store.Has(key) {
 		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &chainInfo)
 		if err != nil {
@@ -104,7 +106,12 @@ func (k *Keeper) GetChainParams(ctx sdk.Context, rootChain string) (types.ChainI
 
 // AddNewChainParams adds new chain into chain list
 func (k *Keeper) AddNewChainParams(ctx sdk.Context, chainInfo types.ChainInfo) error {
-	key := append(NewChainParamsKey, hmTypes.GetRootChainID(chainInfo.RootChainType))
+	rootChainID := k.GetRootChainID(ctx, chainInfo.RootChainType)
+	if rootChainID == 0 {
+		return fmt.Errorf("root chain %s is not registered", chainInfo.RootChainType)
+	}
+
+	key := append(NewChainParamsKey, rootChainID)
 	value, err := k.cdc.MarshalBinaryBare(chainInfo)
 	if err != nil {
 		k.Logger(ctx).Error("Error marshalling chain info", "root", chainInfo.RootChainType, "error", err)
@@ -161,6 +168,86 @@ func (k *Keeper) GetChainActivationHeight(ctx sdk.Context, rootChain string) uin
 	return res
 }
 
+// getRootChainIDs returns ids of built in root chains and of eth fork chains added through
+// governance params with a root chain id. Chain with reserved name or reserved or taken id is
+// skipped, chains are taken in name order so conflicting ids resolve the same way on every node.
+// Lookup charges no gas, so resolving root chain keeps gas of txs for built in chains unchanged.
+func (k *Keeper) getRootChainIDs(ctx sdk.Context) map[string]byte {
+	builtIn := hmTypes.GetRootChainIDMap()
+
+	rootChainIDs := make(map[string]byte, len(builtIn))
+	takenIDs := make(map[byte]bool, len(builtIn))
+	for rootChain, rootChainID := range builtIn {
+		rootChainIDs[rootChain] = rootChainID
+		takenIDs[rootChainID] = true
+	}
+
+	paramMap := k.GetParamsWithMultiChain(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())).ChainParameterMap
+	names := make([]string, 0, len(paramMap))
+	for rootChain, chainData := range paramMap {
+		if chainData.RootChainID != nil {
+			names = append(names, rootChain)
+		}
+	}
+	sort.Strings(names)
+
+	testRootChainID := hmTypes.GetRootChainID(hmTypes.RootChainTypeTest)
+	for _, rootChain := range names {
+		rootChainID := *paramMap[rootChain].RootChainID
+		if _, ok := builtIn[rootChain]; ok || rootChain == hmTypes.RootChainTypeTest {
+			k.Logger(ctx).Error("Root chain name is reserved", "root", rootChain)
+			continue
+		}
+		if rootChainID == 0 || rootChainID >= uint64(testRootChainID) || takenIDs[byte(rootChainID)] {
+			k.Logger(ctx).Error("Root chain id is reserved or taken", "root", rootChain, "id", rootChainID)
+			continue
+		}
+
+		rootChainIDs[rootChain] = byte(rootChainID)
+		takenIDs[byte(rootChainID)] = true
+	}
+
+	return rootChainIDs
+}
+
+// GetRootChains returns registered root chains ordered by root chain id, without charging gas
+func (k *Keeper) GetRootChains(ctx sdk.Context) []types.RootChain {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	rootChainIDs := k.getRootChainIDs(ctx)
+
+	rootChains := make([]types.RootChain, 0, len(rootChainIDs))
+	for rootChain, rootChainID := range rootChainIDs {
+		rootChains = append(rootChains, types.RootChain{
+			RootChainType:    rootChain,
+			RootChainID:      uint64(rootChainID),
+			ActivationHeight: k.GetChainActivationHeight(ctx, rootChain),
+		})
+	}
+
+	sort.Slice(rootChains, func(i, j int) bool {
+		return rootChains[i].RootChainID < rootChains[j].RootChainID
+	})
+	return rootChains
+}
+
+// GetRootChainID returns id of root chain, 0 if it is not registered. Built in root chains are
+// resolved without reading store.
+func (k *Keeper) GetRootChainID(ctx sdk.Context, rootChain string) byte {
+	if rootChainID := hmTypes.GetRootChainID(rootChain); rootChainID != 0 {
+		return rootChainID
+	}
+
+	return k.getRootChainIDs(ctx)[rootChain]
+}
+
+// IsEthForkRootChain returns true for registered root chains with eth contracts and rpc, every
+// root chain but tron
+func (k *Keeper) IsEthForkRootChain(ctx sdk.Context, rootChain string) bool {
+	return rootChain != hmTypes.RootChainTypeTron &&
+		rootChain != hmTypes.RootChainTypeTest &&
+		k.GetRootChainID(ctx, rootChain) != 0
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -37,3 +38,29 @@ func (suite *KeeperTestSuite) TestParamsGetterSetter() {
 
 	require.Equal(t, params, actualParams)
 }
+
+func (suite *KeeperTestSuite) TestGetRootChains() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	rootChainID := uint64(0x20)
+	takenRootChainID := uint64(hmTypes.GetRootChainID(hmTypes.RootChainTypeEth))
+	params := types.DefaultParamsWithMultiChains()
+	params.ChainParameterMap["keeper-test"] = types.ChainData{RootChainID: &rootChainID}
+	params.ChainParameterMap["keeper-taken"] = types.ChainData{RootChainID: &takenRootChainID}
+	app.ChainKeeper.SetParamsWithMultiChain(ctx, params)
+
+	require.Equal(t, byte(rootChainID), app.ChainKeeper.GetRootChainID(ctx, "keeper-test"))
+	require.Equal(t, byte(0), app.ChainKeeper.GetRootChainID(ctx, "keeper-taken"))
+	require.Equal(t, hmTypes.GetRootChainID(hmTypes.RootChainTypeEth), app.ChainKeeper.GetRootChainID(ctx, hmTypes.RootChainTypeEth))
+
+	require.True(t, app.ChainKeeper.IsEthForkRootChain(ctx, "keeper-test"))
+	require.False(t, app.ChainKeeper.IsEthForkRootChain(ctx, hmTypes.RootChainTypeTron))
+	require.False(t, app.ChainKeeper.IsEthForkRootChain(ctx, "keeper-taken"))
+
+	rootChains := app.ChainKeeper.GetRootChains(ctx)
+	require.Len(t, rootChains, len(hmTypes.GetRootChainIDMap())+1)
+	require.Equal(t, types.RootChain{
+		RootChainType: "keeper-test",
+		RootChainID:   rootChainID,
+	}, rootChains[len(rootChains)-1])
+}
@@ -21,6 +21,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParamsWithTargetChain(ctx, req, keeper)
 		case types.QueryProposalChainParamMap:
 			return queryPropsoalChainParamMap(ctx, keeper)
+		case types.QueryRootChains:
+			return queryRootChains(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown chainmanager query endpoint")
 		}
@@ -43,7 +45,7 @@ func queryNewChainParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 	response := keeper.GetParams(ctx)
 	newChainParams, _ := keeper.GetChainParams(ctx, params.RootChain)
-	if params.RootChain == hmTpyes.RootChainTypeBsc {
+	if params.RootChain != hmTpyes.RootChainTypeEth && keeper.IsEthForkRootChain(ctx, params.RootChain) {
 		response.MainchainTxConfirmations = newChainParams.TxConfirmations
 		response.ChainParams.RootChainAddress = newChainParams.RootChainAddress
 		response.ChainParams.StateSenderAddress = newChainParams.StateSenderAddress
@@ -66,6 +68,16 @@ func queryPropsoalChainParamMap(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Err
 	return bz, nil
 }
 
+// queryRootChains returns registered root chains with their activation heights
+func queryRootChains(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetRootChains(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 // queryParamsWithTargetChain will always return chain parameters including tron, bttc and target chain.
 func queryParamsWithTargetChain(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryChainParams
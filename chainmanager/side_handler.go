@@ -49,7 +49,7 @@ func SideHandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, con
 		err             error
 	)
 	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	receipt, err = contractCaller.GetTronTransactionReceipt(ctx.Context(), msg.TxHash.Hex())
 	if err != nil || receipt == nil {
 		return common.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
@@ -64,7 +64,7 @@ func SideHandleMsgNewChain(ctx sdk.Context, msg types.MsgNewChain, k Keeper, con
 			"MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
 	}
-	if uint64(hmTypes.GetRootChainID(msg.RootChainType)) != eventLog.RootChainId.Uint64() {
+	if uint64(k.GetRootChainID(ctx, msg.RootChainType)) != eventLog.RootChainId.Uint64() {
 		k.Logger(ctx).Error("RootChainType in message doesn't match with receipt",
 			"MsgRootChainType", msg.RootChainType, "ReceiptRootChainType", eventLog.RootChainId.Uint64())
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
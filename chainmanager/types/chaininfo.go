@@ -25,3 +25,10 @@ func (s *ChainInfo) String() string {
 		s.RootChainType, s.ActivationHeight, s.TxConfirmations, s.RootChainAddress, s.StateSenderAddress, s.StakingManagerAddress, s.StakingInfoAddress,
 	)
 }
+
+// RootChain represents root chain registered with chain manager
+type RootChain struct {
+	RootChainType    string `json:"root_chain_type" yaml:"root_chain_type"`
+	RootChainID      uint64 `json:"root_chain_id" yaml:"root_chain_id"`
+	ActivationHeight uint64 `json:"activation_height" yaml:"activation_height"`
+}
@@ -13,6 +13,9 @@ type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
 	ChainInfos []ChainInfo `json:"chain_infos" yaml:"chain_infos"`
+
+	// chain params set through governance, registering root chains
+	ParamsWithMultiChains *ParamsWithMultiChains `json:"params_with_multi_chains,omitempty" yaml:"params_with_multi_chains,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -194,6 +194,9 @@ type ChainData struct {
 	TxConfirmations *uint64 `json:"tx_confirmations" yaml:"tx_confirmations"`
 	ActivateHeight  *uint64 `json:"activate_height" yaml:"activate_height"`
 
+	// id root chain is registered with, set for eth fork chains other than built in ones
+	RootChainID *uint64 `json:"root_chain_id,omitempty" yaml:"root_chain_id,omitempty"`
+
 	// main chain
 	StakingManagerAddress *hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
 	SlashManagerAddress   *hmTypes.HeimdallAddress `json:"slash_manager_address" yaml:"slash_manager_address"`
@@ -211,6 +214,7 @@ type ParamsWithMultiChains struct {
 type PlainChainData struct {
 	TxConfirmations uint64 `json:"tx_confirmations" yaml:"tx_confirmations"`
 	ActivateHeight  uint64 `json:"activate_height" yaml:"activate_height"`
+	RootChainID     uint64 `json:"root_chain_id" yaml:"root_chain_id"`
 
 	// main chain
 	StakingManagerAddress hmTypes.HeimdallAddress `json:"staking_manager_address" yaml:"staking_manager_address"`
@@ -229,6 +233,10 @@ func (cd *ChainData) Plainify() (pc PlainChainData) {
 		pc.ActivateHeight = *cd.ActivateHeight
 	}
 
+	if cd.RootChainID != nil {
+		pc.RootChainID = *cd.RootChainID
+	}
+
 	if cd.StakingManagerAddress != nil {
 		pc.StakingManagerAddress = *cd.StakingManagerAddress
 	}
@@ -275,6 +283,7 @@ func (pmc ParamsWithMultiChains) String() string {
 		[chain]: %s
 		TxConfirmations:					%v,
 		ActivateHeight:						%v,
+		RootChainID:						%v,
 
 		StakingManagerAddress:				%s,
 		SlashManagerAddress:				%s,
@@ -282,7 +291,7 @@ func (pmc ParamsWithMultiChains) String() string {
 		StakingInfoAddress:					%s,
 		StateSenderAddress:					%s,
 		`,
-			key, pVal.TxConfirmations, pVal.ActivateHeight,
+			key, pVal.TxConfirmations, pVal.ActivateHeight, pVal.RootChainID,
 			pVal.StakingManagerAddress, pVal.SlashManagerAddress, pVal.RootChainAddress,
 			pVal.StakingInfoAddress, pVal.StateSenderAddress)
 	}
@@ -5,6 +5,7 @@ const (
 	QueryParams                = "params"
 	QueryNewChainParam         = "chain-params"
 	QueryProposalChainParamMap = "proposal-chain-param-map"
+	QueryRootChains            = "root-chains"
 )
 
 // QueryChainParams defines the params for querying accounts.
@@ -0,0 +1,263 @@
+package checkpoint
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Dividend account tree
+//
+// Nodes of account tree of every root chain are kept in store, so fee update of dividend account
+// rehashes path from its leaf to root only. New or removed accounts shift sorted leaves and rebuild tree.
+//
+
+var (
+	accountRootRebuild     = []byte{0x01} // account root dirty value when account tree has to be rebuilt
+	accountRootIncremental = []byte{0x02} // account root dirty value when only dirty accounts changed
+)
+
+// GetAccountRootDirtyUserKey appends prefix to address of changed dividend account
+func GetAccountRootDirtyUserKey(user hmTypes.HeimdallAddress) []byte {
+	return append(append([]byte{}, AccountRootDirtyUserKey...), user.Bytes()...)
+}
+
+// GetAccountTreeNodeKey appends prefix to root chain id, level and index of account tree node
+func GetAccountTreeNodeKey(rootID byte, level int, index uint64) []byte {
+	key := append(append([]byte{}, AccountTreeNodeKey...), rootID, byte(level))
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	return append(key, indexBytes...)
+}
+
+// GetAccountTreeLeafKey appends prefix to address of dividend account
+func GetAccountTreeLeafKey(user hmTypes.HeimdallAddress) []byte {
+	return append(append([]byte{}, AccountTreeLeafKey...), user.Bytes()...)
+}
+
+// getAccountTreeSize returns number of account tree leaves
+func (k *Keeper) getAccountTreeSize(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(AccountTreeSizeKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// getAccountTreeLeaf returns position of dividend account among account tree leaves
+func (k *Keeper) getAccountTreeLeaf(ctx sdk.Context, user hmTypes.HeimdallAddress) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetAccountTreeLeafKey(user))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// rebuildAccountTree builds account tree of every root chain from current dividend accounts and
+// persists its nodes and root
+func (k *Keeper) rebuildAccountTree(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AccountRootDirtyKey)
+	k.clearAccountTree(ctx)
+
+	dividendAccounts := hmTypes.SortDividendAccountByAddress(k.moduleCommunicator.GetAllDividendAccounts(ctx))
+	leaves := make([][]byte, 0, len(dividendAccounts))
+	for i, dividendAccount := range dividendAccounts {
+		leaf, err := dividendAccount.CalculateHash()
+		if err != nil {
+			k.Logger(ctx).Error("Error while hashing dividend account", "user", dividendAccount.User.String(), "error", err)
+			return
+		}
+		leaves = append(leaves, leaf)
+
+		indexBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(indexBytes, uint64(i))
+		store.Set(GetAccountTreeLeafKey(dividendAccount.User), indexBytes)
+	}
+	k.consumeAccountHashGas(ctx, len(leaves))
+
+	sizeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sizeBytes, uint64(len(leaves)))
+	store.Set(AccountTreeSizeKey, sizeBytes)
+
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		rootChain, rootID := registered.RootChainType, byte(registered.RootChainID)
+		levels, err := types.AccountTreeLevels(leaves, types.GetAccountHashStrategy(rootChain))
+		if err != nil {
+			k.Logger(ctx).Error("Error while computing account root hash", "root", rootChain, "error", err)
+			store.Delete(GetAccountRootKey(rootID))
+			continue
+		}
+
+		for level, nodes := range levels {
+			for index, node := range nodes {
+				store.Set(GetAccountTreeNodeKey(rootID, level, uint64(index)), node)
+			}
+
+			// leaves are charged once for all root chains
+			if level > 0 {
+				k.consumeAccountHashGas(ctx, len(nodes))
+			}
+		}
+		store.Set(GetAccountRootKey(rootID), levels[len(levels)-1][0])
+	}
+}
+
+// updateAccountTree rehashes account tree paths of dividend accounts changed since last update.
+// It returns false without changing tree if tree has to be rebuilt instead.
+func (k *Keeper) updateAccountTree(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	size := k.getAccountTreeSize(ctx)
+	if size == 0 {
+		return false
+	}
+
+	rootChains := k.ck.GetRootChains(ctx)
+	for _, registered := range rootChains {
+		// root chain registered after tree was built has no nodes
+		if !store.Has(GetAccountRootKey(byte(registered.RootChainID))) {
+			return false
+		}
+	}
+
+	var dirtyKeys [][]byte
+	leaves := make(map[uint64][]byte)
+	iterator := sdk.KVStorePrefixIterator(store, AccountRootDirtyUserKey)
+	for ; iterator.Valid(); iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		user := hmTypes.BytesToHeimdallAddress(key[len(AccountRootDirtyUserKey):])
+		dirtyKeys = append(dirtyKeys, key)
+
+		// new account shifts leaves
+		index, ok := k.getAccountTreeLeaf(ctx, user)
+		if !ok {
+			iterator.Close()
+			return false
+		}
+
+		dividendAccount, err := k.moduleCommunicator.GetDividendAccountByAddress(ctx, user)
+		if err != nil {
+			iterator.Close()
+			return false
+		}
+
+		leaf, err := dividendAccount.CalculateHash()
+		if err != nil {
+			iterator.Close()
+			return false
+		}
+		leaves[index] = leaf
+	}
+	iterator.Close()
+	k.consumeAccountHashGas(ctx, len(leaves))
+
+	indexes := make([]uint64, 0, len(leaves))
+	for index := range leaves {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	sizes := types.AccountTreeLevelSizes(size)
+	for _, registered := range rootChains {
+		rootID := byte(registered.RootChainID)
+		hashStrategy := types.GetAccountHashStrategy(registered.RootChainType)
+		k.consumeAccountHashGas(ctx, len(indexes)*(len(sizes)-1))
+
+		for _, index := range indexes {
+			root := k.updateAccountTreePath(ctx, rootID, hashStrategy, sizes, index, leaves[index])
+			store.Set(GetAccountRootKey(rootID), root)
+		}
+	}
+
+	for _, key := range dirtyKeys {
+		store.Delete(key)
+	}
+	store.Delete(AccountRootDirtyKey)
+	return true
+}
+
+// updateAccountTreePath sets leaf of account tree and rehashes nodes on its path, returning new root
+func (k *Keeper) updateAccountTreePath(ctx sdk.Context, rootID byte, hashStrategy types.AccountHashStrategy, sizes []uint64, index uint64, leaf []byte) []byte {
+	store := ctx.KVStore(k.storeKey)
+
+	node := leaf
+	store.Set(GetAccountTreeNodeKey(rootID, 0, index), node)
+	for level := 0; level < len(sizes)-1; level++ {
+		// last node of odd level is hashed with itself
+		sibling := node
+		if index^1 < sizes[level] {
+			sibling = store.Get(GetAccountTreeNodeKey(rootID, level, index^1))
+		}
+
+		if index%2 == 0 {
+			node = types.AccountTreeParent(node, sibling, hashStrategy)
+		} else {
+			node = types.AccountTreeParent(sibling, node, hashStrategy)
+		}
+
+		index /= 2
+		store.Set(GetAccountTreeNodeKey(rootID, level+1, index), node)
+	}
+
+	return node
+}
+
+// clearAccountTree deletes nodes, leaf positions and changed accounts of account tree
+func (k *Keeper) clearAccountTree(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	for _, prefix := range [][]byte{AccountTreeNodeKey, AccountTreeLeafKey, AccountRootDirtyUserKey} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, append([]byte{}, iterator.Key()...))
+		}
+		iterator.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(AccountTreeSizeKey)
+}
+
+// GetAccountTreeProof returns merkle proof of dividend account against account root of root chain and
+// position of account among leaves, read from persisted account tree without loading all accounts
+func (k *Keeper) GetAccountTreeProof(ctx sdk.Context, rootChain string, user hmTypes.HeimdallAddress) ([]byte, uint64, error) {
+	k.UpdateAccountRootIfDirty(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	rootID := k.ck.GetRootChainID(ctx, rootChain)
+	if !store.Has(GetAccountRootKey(rootID)) {
+		return nil, 0, fmt.Errorf("no account tree for root chain %v", rootChain)
+	}
+
+	leafIndex, ok := k.getAccountTreeLeaf(ctx, user)
+	if !ok {
+		return nil, 0, fmt.Errorf("no dividend account %v in account tree", user.String())
+	}
+
+	sizes := types.AccountTreeLevelSizes(k.getAccountTreeSize(ctx))
+
+	var proof []byte
+	index := leafIndex
+	for level := 0; level < len(sizes)-1; level++ {
+		// last node of odd level is its own sibling
+		sibling := index
+		if index^1 < sizes[level] {
+			sibling = index ^ 1
+		}
+
+		proof = append(proof, store.Get(GetAccountTreeNodeKey(rootID, level, sibling))...)
+		index /= 2
+	}
+
+	return proof, leafIndex, nil
+}
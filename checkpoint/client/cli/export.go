@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+// GetExportCheckpointsCmd exports checkpoints of a root chain within epoch range as csv or json
+func GetExportCheckpointsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-checkpoints",
+		Args:  cobra.NoArgs,
+		Short: "export checkpoints of a root chain within epoch range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export checkpoints numbered from-epoch..to-epoch to stdout, fetched page by page.
+Pruned and skipped checkpoint numbers are left out.
+
+Example:
+$ %s export-checkpoints --root-chain=eth --from-epoch=1 --to-epoch=500 --format=csv
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rootChain := viper.GetString(FlagRootChain)
+			if rootChain == "" {
+				rootChain = hmTypes.RootChainTypeStake
+			}
+			if hmTypes.GetRootChainID(rootChain) == 0 {
+				return fmt.Errorf("invalid root chain: %s", rootChain)
+			}
+
+			from := viper.GetUint64(FlagFromEpoch)
+			to := viper.GetUint64(FlagToEpoch)
+			if from == 0 || to < from {
+				return fmt.Errorf("invalid epoch range: %d..%d", from, to)
+			}
+
+			format := viper.GetString(FlagFormat)
+			if format != exportFormatCSV && format != exportFormatJSON {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, exportFormatCSV, exportFormatJSON)
+			}
+
+			pageSize, err := getMaxCheckpointBatchSize(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			writer := newCheckpointExportWriter(cmd.OutOrStdout(), format)
+			if err := writer.begin(); err != nil {
+				return err
+			}
+
+			for start := from; start <= to; start += pageSize {
+				end := start + pageSize - 1
+				if end > to || end < start {
+					end = to
+				}
+
+				checkpoints, err := queryCheckpointRange(cliCtx, start, end, rootChain)
+				if err != nil {
+					return err
+				}
+
+				for _, checkpoint := range checkpoints {
+					if err := writer.write(checkpoint); err != nil {
+						return err
+					}
+				}
+
+				// avoid overflow when to is max uint64
+				if end == to {
+					break
+				}
+			}
+
+			return writer.end()
+		},
+	}
+
+	cmd.Flags().String(FlagRootChain, hmTypes.RootChainTypeStake, "--root-chain=<root-chain>")
+	cmd.Flags().Uint64(FlagFromEpoch, 1, "--from-epoch=<first-checkpoint-number>")
+	cmd.Flags().Uint64(FlagToEpoch, 0, "--to-epoch=<last-checkpoint-number>")
+	cmd.Flags().String(FlagFormat, exportFormatCSV, "--format=csv|json")
+	if err := cmd.MarkFlagRequired(FlagToEpoch); err != nil {
+		logger.Error("GetExportCheckpointsCmd | MarkFlagRequired | FlagToEpoch", "Error", err)
+	}
+
+	return client.GetCommands(cmd)[0]
+}
+
+func getMaxCheckpointBatchSize(cliCtx context.CLIContext) (uint64, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var params types.Params
+	if err := json.Unmarshal(res, &params); err != nil {
+		return 0, err
+	}
+
+	if params.MaxCheckpointBatchSize == 0 {
+		return types.DefaultMaxCheckpointBatchSize, nil
+	}
+	return params.MaxCheckpointBatchSize, nil
+}
+
+func queryCheckpointRange(cliCtx context.CLIContext, from uint64, to uint64, rootChain string) ([]types.RootChainCheckpoint, error) {
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointRangeParams(from, to, rootChain))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRange), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []types.RootChainCheckpoint
+	if err := json.Unmarshal(res, &checkpoints); err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// checkpointExportWriter writes exported checkpoints as they are fetched
+type checkpointExportWriter struct {
+	out    io.Writer
+	format string
+	csv    *csv.Writer
+	count  int
+}
+
+func newCheckpointExportWriter(out io.Writer, format string) *checkpointExportWriter {
+	w := &checkpointExportWriter{out: out, format: format}
+	if format == exportFormatCSV {
+		w.csv = csv.NewWriter(out)
+	}
+	return w
+}
+
+func (w *checkpointExportWriter) begin() error {
+	if w.format == exportFormatJSON {
+		_, err := io.WriteString(w.out, "[")
+		return err
+	}
+
+	return w.csv.Write([]string{"root_chain", "number", "start_block", "end_block", "root_hash", "proposer", "bor_chain_id", "timestamp"})
+}
+
+func (w *checkpointExportWriter) write(checkpoint types.RootChainCheckpoint) error {
+	defer func() { w.count++ }()
+
+	if w.format == exportFormatJSON {
+		bz, err := json.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+
+		sep := ",\n"
+		if w.count == 0 {
+			sep = "\n"
+		}
+		_, err = io.WriteString(w.out, sep+string(bz))
+		return err
+	}
+
+	if err := w.csv.Write([]string{
+		checkpoint.RootChain,
+		strconv.FormatUint(checkpoint.Number, 10),
+		strconv.FormatUint(checkpoint.Checkpoint.StartBlock, 10),
+		strconv.FormatUint(checkpoint.Checkpoint.EndBlock, 10),
+		checkpoint.Checkpoint.RootHash.String(),
+		checkpoint.Checkpoint.Proposer.String(),
+		checkpoint.Checkpoint.BorChainID,
+		strconv.FormatUint(checkpoint.Checkpoint.TimeStamp, 10),
+	}); err != nil {
+		return err
+	}
+
+	// flush each row so output streams into downstream pipelines
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *checkpointExportWriter) end() error {
+	if w.format == exportFormatJSON {
+		_, err := io.WriteString(w.out, "\n]\n")
+		return err
+	}
+
+	w.csv.Flush()
+	return w.csv.Error()
+}
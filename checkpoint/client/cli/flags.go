@@ -13,4 +13,7 @@ const (
 	FlagAutoConfigure      = "auto-configure"
 	FlagEpoch              = "epoch"
 	FlagRootChain          = "root-chain"
+	FlagFromEpoch          = "from-epoch"
+	FlagToEpoch            = "to-epoch"
+	FlagFormat             = "format"
 )
@@ -34,7 +34,9 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetQueryParams(cdc),
 			GetCheckpointBuffer(cdc),
+			GetCheckpointSyncBuffer(cdc),
 			GetLastNoACK(cdc),
+			GetNoACKCountdown(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
 			GetQueryActivateHeight(cdc),
@@ -110,6 +112,39 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCheckpointSyncBuffer get checkpoint sync present in buffer
+func GetCheckpointSyncBuffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-sync-buffer",
+		Short: "show checkpoint sync present in buffer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return errors.New("rootChain Error :" + rootChain)
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointSyncBuffer), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("No checkpoint sync buffer found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>")
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("GetCheckpointSyncBuffer | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
+	return cmd
+}
+
 // GetLastNoACK get last no ack time
 func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -140,6 +175,39 @@ func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetNoACKCountdown get seconds left before no-ack is allowed
+func GetNoACKCountdown(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "noack-countdown",
+		Short: "get seconds left before no-ack is allowed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			rootChain := viper.GetString(FlagRootChain)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return errors.New("rootChain Error :" + rootChain)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNoAckCountdown), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var countdown uint64
+			if err := json.Unmarshal(res, &countdown); err != nil {
+				return err
+			}
+
+			fmt.Printf("No-ACK allowed in %v seconds", countdown)
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>, stake root chain if not set")
+
+	return cmd
+}
+
 // GetHeaderFromIndex get checkpoint given header index
 func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
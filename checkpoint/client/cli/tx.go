@@ -3,6 +3,7 @@ package cli
 // nolint
 import (
 	"bytes"
+	gocontext "context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -42,6 +43,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendCheckpointTx(cdc),
 			SendCheckpointACKTx(cdc),
 			SendCheckpointNoACKTx(cdc),
+			SendCheckpointCancelTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -229,13 +231,13 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 			var rootChainAddress common.Address
 			switch rootChain {
 			case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc:
-				receipt, err = contractCallerObj.GetConfirmedTxReceipt(txHash.EthHash(), chainmanagerParams.MainchainTxConfirmations, rootChain)
+				receipt, err = contractCallerObj.GetConfirmedTxReceipt(gocontext.Background(), txHash.EthHash(), chainmanagerParams.MainchainTxConfirmations, rootChain)
 				if err != nil || receipt == nil {
 					return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
 				}
 				rootChainAddress = chainmanagerParams.ChainParams.RootChainAddress.EthAddress()
 			case hmTypes.RootChainTypeTron:
-				receipt, err = contractCallerObj.GetTronTransactionReceipt(txHash.Hex())
+				receipt, err = contractCallerObj.GetTronTransactionReceipt(gocontext.Background(), txHash.Hex())
 				if err != nil || receipt == nil {
 					return errors.New("transaction is not confirmed yet. Please wait for sometime and try again")
 				}
@@ -306,6 +308,7 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 			// create new checkpoint no-ack
 			msg := types.NewMsgCheckpointNoAck(
 				proposer,
+				viper.GetString(FlagRootChain),
 			)
 
 			// broadcast messages
@@ -314,5 +317,71 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain>, stake root chain if not set")
+	return cmd
+}
+
+// SendCheckpointCancelTx send cancel transaction for checkpoint in buffer
+func SendCheckpointCancelTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-cancel",
+		Short: "cancel checkpoint in buffer without waiting for buffer time",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			rootChain := viper.GetString(FlagRootChain)
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+			if err != nil {
+				return err
+			}
+
+			// get checkpoint in buffer
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var checkpoint hmTypes.Checkpoint
+			if err := json.Unmarshal(res, &checkpoint); err != nil {
+				return err
+			}
+
+			// get ack count
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var ackCount uint64
+			if err := json.Unmarshal(res, &ackCount); err != nil {
+				return err
+			}
+
+			// create new checkpoint cancel
+			msg := types.NewMsgCheckpointCancel(
+				proposer,
+				ackCount,
+				checkpoint.StartBlock,
+				checkpoint.EndBlock,
+				rootChain,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagRootChain, "", "--root-chain=<root-chain-type>")
+
+	if err := cmd.MarkFlagRequired(FlagRootChain); err != nil {
+		logger.Error("SendCheckpointCancelTx | MarkFlagRequired | FlagRootChain", "Error", err)
+	}
 	return cmd
 }
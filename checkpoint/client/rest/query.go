@@ -37,12 +37,48 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	r.HandleFunc("/checkpoints/last-no-ack", noackHandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/no-ack-countdown", noackCountdownHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/list", checkpointListhandlerFn(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/ack-status/{root}/{number}", checkpointAckStatusHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/cost-estimate/{root}", checkpointCostEstimateHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/max-committed-block", maxCommittedBlockHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/handler-stats", handlerStatsHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/batch", checkpointBatchHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/continuity/{root}", checkpointContinuityHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/by-time", checkpointsByTimeRangeHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/lifecycle", checkpointLifecycleHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/proposer-rotations", proposerRotationsHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/backup-proposers", backupProposersHandlerFn(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/stale-buffers", staleBuffersHandlerFn(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/epoch", currentEpochHandlerFunc(cliCtx)).Methods("GET")
 
 	r.HandleFunc("/checkpoints/activation-height/{root}", checkpointActivationHeightHandlerFunc(cliCtx)).Methods("GET")
 
+	r.HandleFunc("/checkpoints/power/{root}", checkpointPowerHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/next-shape/{root}", nextCheckpointShapeHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/raw/{root}/{number}", checkpointRawHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/by-block/{root}/{block}", checkpointByBlockHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/proof/{root}/{block}/{txHash}", checkpointProofHandlerFunc(cliCtx)).Methods("GET")
+
+	r.HandleFunc("/checkpoints/next/{root}", nextCheckpointHandlerFunc(cliCtx)).Methods("GET")
+
 	r.HandleFunc("/checkpoints/{root}/{number}", checkpointByNumberHandlerFunc(cliCtx)).Methods("GET")
 }
 
@@ -200,74 +236,99 @@ func prepareCheckpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// Get params
 		params := r.URL.Query()
 
-		var result []byte
-		var height int64
-		var validatorSetBytes []byte
-
 		// get start and start
-		if params.Get("start") != "" && params.Get("end") != "" {
-			start, err := strconv.ParseUint(params.Get("start"), 10, 64)
-			if err != nil {
-				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-				return
-			}
+		if params.Get("start") == "" || params.Get("end") == "" {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "`start` and `end` query params required")
+			return
+		}
 
-			end, err := strconv.ParseUint(params.Get("end"), 10, 64)
-			if err != nil {
-				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
-				return
-			}
+		start, err := strconv.ParseUint(params.Get("start"), 10, 64)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
-			if err != nil {
-				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				RestLogger.Error("Unable to get checkpoint params", "Error", err)
-				return
-			}
+		end, err := strconv.ParseUint(params.Get("end"), 10, 64)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-			var params types.Params
-			json.Unmarshal(res, &params)
-			contractCallerObj, err := helper.NewContractCaller()
+		// optional root chain
+		root := params.Get("rootchain")
+		if root == "" {
+			root = hmTypes.RootChainTypeStake
+		} else if hmTypes.GetRootChainID(root) == 0 {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid root chain %v", root))
+			return
+		}
 
-			// get headers
-			roothash, err := contractCallerObj.GetRootHash(uint64(start), uint64(end), params.MaxCheckpointLength)
-			if err != nil {
-				RestLogger.Error("Unable to get roothash", "Start", start, "End", end, "Error", err)
-				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			RestLogger.Error("Unable to get checkpoint params", "Error", err)
+			return
+		}
 
-			//
-			// Get current validator set
-			//
-
-			var validatorSet hmTypes.ValidatorSet
-			validatorSetBytes, height, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryCurrentValidatorSet), nil)
-			if err == nil {
-				err := json.Unmarshal(validatorSetBytes, &validatorSet)
-				if err != nil {
-					hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-					RestLogger.Error("Unable to get validator set to form proposer", "Error", err)
-					return
-				}
-			}
+		var checkpointParams types.Params
+		if err := json.Unmarshal(res, &checkpointParams); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 
-			// header block -- checkpoint
-			checkpoint := HeaderBlockResult{
-				Proposer:   validatorSet.Proposer.Signer,
-				StartBlock: uint64(start),
-				EndBlock:   uint64(end),
-				RootHash:   common.BytesToHash(roothash),
-			}
+		contractCallerObj, err := helper.NewContractCaller()
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 
-			result, err = json.Marshal(checkpoint)
-			if err != nil {
-				RestLogger.Error("Error while marshalling resposne to Json", "error", err)
-				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-				return
-			}
-		} else {
-			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "`start` and `end` query params required")
+		// get headers
+		roothash, err := contractCallerObj.GetRootHash(r.Context(), start, end, checkpointParams.MaxCheckpointLength)
+		if err != nil {
+			RestLogger.Error("Unable to get roothash", "Start", start, "End", end, "Error", err)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// checks checkpoint handler runs on msg of this range
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointReadinessParams(root, start, end))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointReadiness), queryParams)
+		if err != nil {
+			RestLogger.Error("Unable to check checkpoint readiness", "Start", start, "End", end, "Error", err)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var readiness types.CheckpointReadiness
+		if err := json.Unmarshal(res, &readiness); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// header block -- checkpoint
+		checkpoint := HeaderBlockResult{
+			Proposer:        readiness.Proposer,
+			StartBlock:      start,
+			EndBlock:        end,
+			RootHash:        common.BytesToHash(roothash),
+			RootChain:       root,
+			AccountRootHash: readiness.AccountRootHash,
+			Epoch:           readiness.Epoch,
+			BufferFree:      readiness.BufferFree,
+			BufferExpiry:    readiness.BufferExpiry,
+			Ready:           readiness.Ready,
+			Errors:          readiness.Errors,
+		}
+
+		result, err := json.Marshal(checkpoint)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -276,12 +337,19 @@ func prepareCheckpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// HeaderBlockResult represents header block result
+// HeaderBlockResult represents header block result along with checks checkpoint of it would face
 type HeaderBlockResult struct {
-	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
-	RootHash   common.Hash             `json:"rootHash"`
-	StartBlock uint64                  `json:"startBlock"`
-	EndBlock   uint64                  `json:"endBlock"`
+	Proposer        hmTypes.HeimdallAddress `json:"proposer"`
+	RootHash        common.Hash             `json:"rootHash"`
+	StartBlock      uint64                  `json:"startBlock"`
+	EndBlock        uint64                  `json:"endBlock"`
+	RootChain       string                  `json:"rootChain"`
+	AccountRootHash hmTypes.HeimdallHash    `json:"accountRootHash"`
+	Epoch           uint64                  `json:"epoch"`
+	BufferFree      bool                    `json:"bufferFree"`
+	BufferExpiry    uint64                  `json:"bufferExpiry,omitempty"`
+	Ready           bool                    `json:"ready"`
+	Errors          []string                `json:"errors,omitempty"`
 }
 
 func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -291,7 +359,23 @@ func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+		// optional root chain
+		var queryParams []byte
+		if root := r.URL.Query().Get("root"); root != "" {
+			if hmTypes.GetRootChainID(root) == 0 {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid root chain %v", root).Error())
+				return
+			}
+
+			var err error
+			queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, root))
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -320,6 +404,53 @@ func noackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func noackCountdownHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// optional root chain
+		var queryParams []byte
+		if root := r.URL.Query().Get("root"); root != "" {
+			if hmTypes.GetRootChainID(root) == 0 {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid root chain %v", root).Error())
+				return
+			}
+
+			var err error
+			queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, root))
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNoAckCountdown), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var countdown uint64
+		if err := json.Unmarshal(res, &countdown); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, err := json.Marshal(map[string]interface{}{"result": countdown})
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
 type stateDump struct {
 	ACKCount         uint64               `json:"ack_count"`
 	CheckpointBuffer *hmTypes.Checkpoint  `json:"checkpoint_buffer"`
@@ -544,55 +675,677 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func checkpointListhandlerFn(
-	cliCtx context.CLIContext,
-) http.HandlerFunc {
+// checkpointPowerHandlerFunc returns voting power backing last checkpoint of root chain
+func checkpointPowerHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := r.URL.Query()
+		vars := mux.Vars(r)
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get page
-		root := vars.Get("root")
-		if hmTypes.GetRootChainID(root) == 0 {
-			err := fmt.Errorf("valid root chain %v", root)
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, vars["root"]))
+		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// get page
-		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		// query checkpoint power
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointPower), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// nextCheckpointShapeHandlerFunc returns expected start block, epoch, account root hash and proposer of next checkpoint
+func nextCheckpointShapeHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get limit
-		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, vars["root"]))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query next checkpoint shape
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextCheckpointShape), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointRawHandlerFunc returns stored bytes of checkpoint, served only if node enables debug queries
+func checkpointRawHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
 		if !ok {
 			return
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit, root))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, vars["root"]))
 		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// query checkpoint
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointList), queryParams)
+		// query raw checkpoint
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRaw), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// check content
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoints found"); !ok {
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointAckStatusHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		rootChain := vars["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query ack status
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointAckStatus), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointByBlockHandlerFunc returns checkpoint of root chain containing bor block
+func checkpointByBlockHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		block, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		rootChain := vars["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointByBlockParams(block, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
+		// query checkpoint containing block
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// checkpointProofHandlerFunc returns merkle proof of bor block containing tx against root hash of checkpoint
+func checkpointProofHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get bor block number
+		block, ok := rest.ParseUint64OrReturnBadRequest(w, vars["block"])
+		if !ok {
+			return
+		}
+
+		rootChain := vars["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txHash := vars["txHash"]
+		if len(common.FromHex(txHash)) != common.HashLength {
+			err := fmt.Errorf("'%s' is not a valid tx hash", txHash)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointProofParams(block, common.HexToHash(txHash), rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query proof of block against checkpoint containing it
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// nextCheckpointHandlerFunc returns checkpoint expected to be proposed next for root chain
+func nextCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain := vars["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		borChainID := r.URL.Query().Get("bor_chain_id")
+		if borChainID == "" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "bor_chain_id is required")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryNextCheckpointParams(borChainID, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query next checkpoint
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointListhandlerFn(
+	cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		root := vars.Get("root")
+		if hmTypes.GetRootChainID(root) == 0 {
+			err := fmt.Errorf("valid root chain %v", root)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit, root))
+		if err != nil {
+			return
+		}
+
+		// query checkpoint
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointList), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoints found"); !ok {
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointCostEstimateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain := mux.Vars(r)["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		start, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("start"))
+		if !ok {
+			return
+		}
+
+		end, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("end"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointCostEstimateParams(rootChain, start, end))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointCostEstimate), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func maxCommittedBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMaxCommittedBlock), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// handlerStatsHandlerFn returns success count and last successful height of each checkpoint message type
+func handlerStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHandlerStats), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func backupProposersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBackupProposers), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointBatchHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// optional root chain
+		root := vars.Get("root")
+		if root != "" && hmTypes.GetRootChainID(root) == 0 {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid root chain %v", root).Error())
+			return
+		}
+
+		// checkpoint numbers as json array
+		var numbers []uint64
+		if err := json.Unmarshal([]byte(vars.Get("numbers")), &numbers); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid checkpoint numbers: %v", err).Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointBatchParams(numbers, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBatch), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointContinuityHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		rootChain := mux.Vars(r)["root"]
+		if hmTypes.GetRootChainID(rootChain) == 0 {
+			err := fmt.Errorf("'%s' is not a valid rootChain", rootChain)
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(0, rootChain))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointContinuity), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointsByTimeRangeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// optional root chain
+		root := vars.Get("root")
+		if root != "" && hmTypes.GetRootChainID(root) == 0 {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("invalid root chain %v", root).Error())
+			return
+		}
+
+		from, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("from"))
+		if !ok {
+			return
+		}
+
+		to, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("to"))
+		if !ok {
+			return
+		}
+
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointsByTimeRangeParams(from, to, page, limit, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointsByTime), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoints found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkpointLifecycleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit, ""))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query lifecycle log
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointLifecycle), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint lifecycle entries found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func proposerRotationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit, ""))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query rotation log
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerRotations), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer rotations found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func staleBuffersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get age threshold in seconds
+		threshold, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("threshold"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStaleBuffersParams(threshold))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// query stale buffers
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStaleBuffers), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
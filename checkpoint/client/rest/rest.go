@@ -19,4 +19,5 @@ func init() {
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 	registerTxRoutes(cliCtx, r)
+	registerStreamRoutes(cliCtx, r)
 }
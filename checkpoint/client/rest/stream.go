@@ -0,0 +1,247 @@
+package rest
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	// checkpointEventSubscriber is name under which rest server subscribes to tendermint events
+	checkpointEventSubscriber = "checkpoint-event-stream"
+
+	// checkpointEventBuffer is number of events kept for stream client before it is dropped as too slow
+	checkpointEventBuffer = 64
+
+	// checkpointEventKeepAlive is interval of comments sent to idle stream clients
+	checkpointEventKeepAlive = 30 * time.Second
+)
+
+func registerStreamRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	hub := &checkpointEventHub{
+		cliCtx:      cliCtx,
+		subscribers: make(map[chan types.CheckpointEvent]string),
+	}
+
+	r.HandleFunc("/checkpoints/events", checkpointEventsHandlerFn(hub)).Methods("GET")
+}
+
+// checkpointEventHub subscribes to tendermint events once and fans checkpoint lifecycle events out to
+// stream clients
+type checkpointEventHub struct {
+	cliCtx context.CLIContext
+
+	startMu sync.Mutex
+	started bool
+
+	mu          sync.Mutex
+	subscribers map[chan types.CheckpointEvent]string // root chain filter of subscriber, empty for all
+}
+
+// subscribe returns channel of checkpoint events of root chain, closed when subscriber falls behind
+func (h *checkpointEventHub) subscribe(rootChain string) (chan types.CheckpointEvent, error) {
+	h.startMu.Lock()
+	if !h.started {
+		if err := h.start(); err != nil {
+			h.startMu.Unlock()
+			return nil, err
+		}
+		h.started = true
+	}
+	h.startMu.Unlock()
+
+	ch := make(chan types.CheckpointEvent, checkpointEventBuffer)
+
+	h.mu.Lock()
+	h.subscribers[ch] = rootChain
+	h.mu.Unlock()
+
+	return ch, nil
+}
+
+// unsubscribe removes subscriber unless it was already dropped
+func (h *checkpointEventHub) unsubscribe(ch chan types.CheckpointEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[ch]; ok {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+// start subscribes to txs and new blocks of tendermint node, side tx results land in begin block events
+func (h *checkpointEventHub) start() error {
+	nodeURI := h.cliCtx.NodeURI
+	if nodeURI == "" {
+		nodeURI = helper.GetConfig().TendermintRPCUrl
+	}
+
+	client := httpClient.NewHTTP(nodeURI, "/websocket")
+	if err := client.Start(); err != nil {
+		return err
+	}
+
+	txCh, err := client.Subscribe(gocontext.Background(), checkpointEventSubscriber, tmTypes.EventQueryTx.String())
+	if err != nil {
+		client.Stop()
+		return err
+	}
+
+	blockCh, err := client.Subscribe(gocontext.Background(), checkpointEventSubscriber, tmTypes.EventQueryNewBlock.String())
+	if err != nil {
+		client.Stop()
+		return err
+	}
+
+	go func() {
+		// subscription is started again by next stream client
+		defer h.stop(client)
+
+		for {
+			select {
+			case event, ok := <-txCh:
+				if !ok {
+					RestLogger.Error("Checkpoint event subscription closed")
+					return
+				}
+				if data, ok := event.Data.(tmTypes.EventDataTx); ok {
+					h.publish(types.CheckpointEventsFromABCI(data.Height, data.Result.Events))
+				}
+			case event, ok := <-blockCh:
+				if !ok {
+					RestLogger.Error("Checkpoint event subscription closed")
+					return
+				}
+				if data, ok := event.Data.(tmTypes.EventDataNewBlock); ok {
+					h.publish(types.CheckpointEventsFromABCI(data.Block.Height, data.ResultBeginBlock.Events))
+					h.publish(types.CheckpointEventsFromABCI(data.Block.Height, data.ResultEndBlock.Events))
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// stop stops tendermint client and drops all stream clients
+func (h *checkpointEventHub) stop(client *httpClient.HTTP) {
+	h.startMu.Lock()
+	defer h.startMu.Unlock()
+
+	if err := client.Stop(); err != nil {
+		RestLogger.Error("Error while stopping checkpoint event subscription", "error", err)
+	}
+	h.started = false
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+// publish sends events to subscribers of their root chain, dropping subscribers with full buffer
+func (h *checkpointEventHub) publish(events []types.CheckpointEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch, rootChain := range h.subscribers {
+	Events:
+		for _, event := range events {
+			if rootChain != "" && event.RootChain != rootChain {
+				continue
+			}
+
+			select {
+			case ch <- event:
+			default:
+				RestLogger.Info("Dropping slow checkpoint event stream client")
+				delete(h.subscribers, ch)
+				close(ch)
+				break Events
+			}
+		}
+	}
+}
+
+// checkpointEventsHandlerFn streams checkpoint lifecycle events as server-sent events
+func checkpointEventsHandlerFn(hub *checkpointEventHub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rootChain := r.URL.Query().Get("root")
+		if rootChain != "" && hmTypes.GetRootChainID(rootChain) == 0 && rootChain != hmTypes.RootChainTypeStake {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid root chain %v", rootChain))
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, "streaming not supported")
+			return
+		}
+
+		ch, err := hub.subscribe(rootChain)
+		if err != nil {
+			RestLogger.Error("Error while subscribing to checkpoint events", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer hub.unsubscribe(ch)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(checkpointEventKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case event, ok := <-ch:
+				if !ok {
+					// dropped by hub, client reconnects
+					return
+				}
+
+				data, err := json.Marshal(event)
+				if err != nil {
+					RestLogger.Error("Error while marshalling checkpoint event", "error", err)
+					continue
+				}
+
+				if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Transition, data); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
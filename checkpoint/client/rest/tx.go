@@ -57,7 +57,8 @@ type (
 	HeaderNoACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		Proposer hmTypes.HeimdallAddress `json:"proposer"`
+		Proposer  hmTypes.HeimdallAddress `json:"proposer"`
+		RootChain string                  `json:"root_chain"`
 	}
 )
 
@@ -135,6 +136,7 @@ func newCheckpointNoACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		// draft a message and send response
 		msg := types.NewMsgCheckpointNoAck(
 			req.Proposer,
+			req.RootChain,
 		)
 
 		// send response
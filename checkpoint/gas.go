@@ -0,0 +1,32 @@
+package checkpoint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//
+// Gas metering
+//
+// Every tx pays same flat fee and gets auth MaxTxGas, whatever work it causes. Store access is
+// metered by gas kv store, hashing of dividend accounts and walks over validator set are charged
+// here, so checkpoint msgs get costlier as dividend accounts and validator set grow.
+//
+
+// consumeGas charges gas per unit of work once checkpoint upgrade is active
+func (k *Keeper) consumeGas(ctx sdk.Context, gasPerUnit uint64, units int, descriptor string) {
+	if gasPerUnit == 0 || units <= 0 || !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	ctx.GasMeter().ConsumeGas(gasPerUnit*uint64(units), descriptor)
+}
+
+// consumeAccountHashGas charges gas for account tree nodes hashed
+func (k *Keeper) consumeAccountHashGas(ctx sdk.Context, nodes int) {
+	k.consumeGas(ctx, k.GetParams(ctx).AccountHashGas, nodes, "checkpoint: hash dividend accounts")
+}
+
+// consumeValidatorGas charges gas for validators of validator set worked through
+func (k *Keeper) consumeValidatorGas(ctx sdk.Context, validators int) {
+	k.consumeGas(ctx, k.GetParams(ctx).ValidatorGas, validators, "checkpoint: walk validator set")
+}
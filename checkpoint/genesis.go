@@ -2,6 +2,8 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -11,6 +13,9 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// upgrade height first, state below is written by upgraded rules
+	keeper.SetUpgradeHeight(ctx, data.UpgradeHeight)
+
 	keeper.SetParams(ctx, data.Params)
 
 	// Set last no-ack
@@ -18,17 +23,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
 	}
 
+	for _, lastNoAck := range data.LastNoAcks {
+		mustBeRegisteredRootChain(ctx, keeper, lastNoAck.RootChain)
+		keeper.SetLastNoAckByRootChain(ctx, lastNoAck.RootChain, lastNoAck.LastNoAck)
+	}
+
+	// seed stake root chain last no-ack from global one, if state dump has none for it
+	keeper.MigrateLastNoAck(ctx)
+	keeper.SetNoAckStreak(ctx, data.NoAckStreak)
+
+	// checkpoints in state dump follow pruned ones
+	for _, pruned := range data.PrunedCheckpoints {
+		mustBeRegisteredRootChain(ctx, keeper, pruned.RootChain)
+		keeper.SetPrunedCheckpoints(ctx, pruned)
+	}
+
 	// Add finalised checkpoints to state
 	if len(data.Checkpoints) != 0 {
 		// check if we are provided all the headers
-		if int(data.AckCount) != len(data.Checkpoints) {
+		if data.AckCount != uint64(len(data.Checkpoints))+data.GetPrunedCheckpoints(hmTypes.RootChainTypeEth).Count {
 			panic(errors.New("Incorrect state in state-dump , Please Check "))
 		}
 		// sort headers before loading to state
 		data.Checkpoints = hmTypes.SortHeaders(data.Checkpoints)
 		// load checkpoints to state
 		for i, checkpoint := range data.Checkpoints {
-			checkpointIndex := uint64(i) + 1
+			checkpointIndex := data.GetPrunedCheckpoints(hmTypes.RootChainTypeEth).LastNumber + uint64(i) + 1
 			if err := keeper.AddCheckpoint(ctx, checkpointIndex, checkpoint, hmTypes.RootChainTypeEth); err != nil {
 				keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "error", err)
 			}
@@ -48,20 +68,62 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	// Add finalised checkpoints to state
 	if len(data.TronCheckpoints) != 0 {
 		// check if we are provided all the headers
-		if int(data.TronAckCount) != len(data.TronCheckpoints) {
+		if data.TronAckCount != uint64(len(data.TronCheckpoints))+data.GetPrunedCheckpoints(hmTypes.RootChainTypeTron).Count {
 			panic(errors.New("Incorrect state in state-dump , Please Check "))
 		}
 		// sort headers before loading to state
 		data.TronCheckpoints = hmTypes.SortHeaders(data.TronCheckpoints)
 		// load checkpoints to state
 		for i, checkpoint := range data.TronCheckpoints {
-			checkpointIndex := uint64(i) + 1
+			checkpointIndex := data.GetPrunedCheckpoints(hmTypes.RootChainTypeTron).LastNumber + uint64(i) + 1
 			if err := keeper.AddCheckpoint(ctx, checkpointIndex, checkpoint, hmTypes.RootChainTypeTron); err != nil {
 				keeper.Logger(ctx).Error("InitGenesis | TronAddCheckpoint", "error", err)
 			}
 		}
 	}
 	keeper.UpdateACKCountWithValue(ctx, data.TronAckCount, hmTypes.RootChainTypeTron)
+
+	// Add finalised checkpoints of other root chains
+	for _, rootChainCheckpoints := range data.RootChainCheckpoints {
+		rootChain := rootChainCheckpoints.RootChain
+		mustBeRegisteredRootChain(ctx, keeper, rootChain)
+
+		pruned := data.GetPrunedCheckpoints(rootChain)
+		if len(rootChainCheckpoints.Checkpoints) != 0 && rootChainCheckpoints.AckCount != uint64(len(rootChainCheckpoints.Checkpoints))+pruned.Count {
+			panic(fmt.Errorf("Incorrect state of root chain %s in state-dump , Please Check ", rootChain))
+		}
+
+		for i, checkpoint := range hmTypes.SortHeaders(rootChainCheckpoints.Checkpoints) {
+			if err := keeper.AddCheckpoint(ctx, pruned.LastNumber+uint64(i)+1, checkpoint, rootChain); err != nil {
+				keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "root", rootChain, "error", err)
+			}
+		}
+		keeper.UpdateACKCountWithValue(ctx, rootChainCheckpoints.AckCount, rootChain)
+	}
+
+	// Add checkpoints in other root chain buffers
+	for _, buffer := range data.RootChainBuffers {
+		mustBeRegisteredRootChain(ctx, keeper, buffer.RootChain)
+		if err := keeper.SetCheckpointBuffer(ctx, buffer.Checkpoint, buffer.RootChain); err != nil {
+			keeper.Logger(ctx).Error("InitGenesis | SetCheckpointBuffer", "root", buffer.RootChain, "error", err)
+		}
+	}
+
+	// Add checkpoints in sync buffers
+	for _, buffer := range data.SyncBuffers {
+		mustBeRegisteredRootChain(ctx, keeper, buffer.RootChain)
+		if err := keeper.SetCheckpointSyncBuffer(ctx, buffer.Checkpoint, buffer.RootChain); err != nil {
+			keeper.Logger(ctx).Error("InitGenesis | SetCheckpointSyncBuffer", "root", buffer.RootChain, "error", err)
+		}
+	}
+}
+
+// mustBeRegisteredRootChain panics if root chain is not registered, chain manager genesis
+// registering root chains is initialised before checkpoint one
+func mustBeRegisteredRootChain(ctx sdk.Context, keeper Keeper, rootChain string) {
+	if keeper.ck.GetRootChainID(ctx, rootChain) == 0 {
+		panic(fmt.Errorf("Unknown root chain %s in state-dump", rootChain))
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -69,13 +131,57 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx, hmTypes.RootChainTypeEth),
-		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
+		hmTypes.SortHeaders(keeper.GetOtherCheckpoints(ctx, hmTypes.RootChainTypeEth)),
 		keeper.GetACKCount(ctx, hmTypes.RootChainTypeTron),
 		hmTypes.SortHeaders(keeper.GetOtherCheckpoints(ctx, hmTypes.RootChainTypeTron)),
 	)
+
+	// chain restarted from export keeps its activation, unknown one activates from genesis
+	genesis.UpgradeHeight, _ = keeper.GetUpgradeHeight(ctx)
+	genesis.NoAckStreak = keeper.GetNoAckStreak(ctx)
+
+	// root chains in fixed order keep export deterministic
+	registered := keeper.ck.GetRootChains(ctx)
+	rootChains := make([]string, 0, len(registered))
+	for _, rootChain := range registered {
+		rootChains = append(rootChains, rootChain.RootChainType)
+	}
+	sort.Strings(rootChains)
+
+	for _, rootChain := range rootChains {
+		if rootChain != hmTypes.RootChainTypeEth {
+			if checkpoint, err := keeper.GetCheckpointFromBuffer(ctx, rootChain); err == nil {
+				genesis.RootChainBuffers = append(genesis.RootChainBuffers, types.RootChainBufferedCheckpoint{RootChain: rootChain, Checkpoint: *checkpoint})
+			}
+		}
+
+		if checkpoint, err := keeper.GetCheckpointSyncFromBuffer(ctx, rootChain); err == nil {
+			genesis.SyncBuffers = append(genesis.SyncBuffers, types.RootChainBufferedCheckpoint{RootChain: rootChain, Checkpoint: *checkpoint})
+		}
+
+		if pruned := keeper.GetPrunedCheckpoints(ctx, rootChain); pruned.LastNumber > 0 {
+			genesis.PrunedCheckpoints = append(genesis.PrunedCheckpoints, pruned)
+		}
+
+		if rootChain != hmTypes.RootChainTypeEth && rootChain != hmTypes.RootChainTypeTron {
+			if ackCount := keeper.GetACKCount(ctx, rootChain); ackCount > 0 {
+				genesis.RootChainCheckpoints = append(genesis.RootChainCheckpoints, types.RootChainCheckpoints{
+					RootChain:   rootChain,
+					AckCount:    ackCount,
+					Checkpoints: hmTypes.SortHeaders(keeper.GetOtherCheckpoints(ctx, rootChain)),
+				})
+			}
+		}
+
+		if lastNoAck := keeper.GetLastNoAckByRootChain(ctx, rootChain); lastNoAck > 0 {
+			genesis.LastNoAcks = append(genesis.LastNoAcks, types.RootChainLastNoAck{RootChain: rootChain, LastNoAck: lastNoAck})
+		}
+	}
+
+	return genesis
 }
@@ -84,3 +84,88 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 
 }
+
+func (suite *GenesisTestSuite) TestExportImportGenesisBuffers() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	rootChains := []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron, hmTypes.RootChainTypeBsc}
+	for i, rootChain := range rootChains {
+		buffered := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(1600000000+i))
+		require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, rootChain))
+
+		synced := hmTypes.CreateBlock(256, 511, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("456"), "1234", uint64(1600001000+i))
+		require.NoError(t, keeper.SetCheckpointSyncBuffer(ctx, synced, rootChain))
+	}
+
+	exported := checkpoint.ExportGenesis(ctx, keeper)
+	require.NoError(t, types.ValidateGenesis(exported))
+	require.Len(t, exported.RootChainBuffers, 2)
+	require.Len(t, exported.SyncBuffers, 3)
+
+	// re-import into fresh chain
+	newApp, newCtx, _ := createTestApp(true)
+	checkpoint.InitGenesis(newCtx, newApp.CheckpointKeeper, exported)
+
+	reExported := checkpoint.ExportGenesis(newCtx, newApp.CheckpointKeeper)
+	require.Equal(t, app.Codec().MustMarshalJSON(exported), newApp.Codec().MustMarshalJSON(reExported))
+
+	// buffers are byte identical in store
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	newStore := newCtx.KVStore(newApp.GetKey(types.StoreKey))
+	for _, rootChain := range rootChains {
+		rootID := hmTypes.GetRootChainID(rootChain)
+		for _, prefix := range [][]byte{checkpoint.BufferCheckpointKey, checkpoint.BufferCheckpointSyncKey} {
+			key := append(append([]byte{}, prefix...), rootID)
+			require.NotNil(t, store.Get(key))
+			require.Equal(t, store.Get(key), newStore.Get(key), "buffer of %s differs", rootChain)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestExportImportGenesisRootChainCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// every root chain has own number of acked checkpoints and own last no-ack
+	rootChains := []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron, hmTypes.RootChainTypeBsc}
+	for i, rootChain := range rootChains {
+		for number := uint64(1); number <= uint64(i+1); number++ {
+			acked := hmTypes.CreateBlock((number-1)*256, number*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1600000000+number)
+			require.NoError(t, keeper.AddCheckpoint(ctx, number, acked, rootChain))
+		}
+		keeper.UpdateACKCountWithValue(ctx, uint64(i+1), rootChain)
+		keeper.SetLastNoAckByRootChain(ctx, rootChain, uint64(1600002000+i))
+	}
+
+	exported := checkpoint.ExportGenesis(ctx, keeper)
+	require.NoError(t, types.ValidateGenesis(exported))
+	require.Len(t, exported.Checkpoints, 1)
+	require.Len(t, exported.TronCheckpoints, 2)
+	require.Len(t, exported.RootChainCheckpoints, 1)
+	require.Equal(t, hmTypes.RootChainTypeBsc, exported.RootChainCheckpoints[0].RootChain)
+	require.Equal(t, uint64(3), exported.RootChainCheckpoints[0].AckCount)
+	require.Len(t, exported.RootChainCheckpoints[0].Checkpoints, 3)
+	require.Len(t, exported.LastNoAcks, 3)
+
+	// re-import into fresh chain
+	newApp, newCtx, _ := createTestApp(true)
+	checkpoint.InitGenesis(newCtx, newApp.CheckpointKeeper, exported)
+
+	reExported := checkpoint.ExportGenesis(newCtx, newApp.CheckpointKeeper)
+	require.Equal(t, app.Codec().MustMarshalJSON(exported), newApp.Codec().MustMarshalJSON(reExported))
+
+	for _, rootChain := range rootChains {
+		lastCheckpoint, err := keeper.GetLastCheckpoint(ctx, rootChain)
+		require.NoError(t, err)
+		newLastCheckpoint, err := newApp.CheckpointKeeper.GetLastCheckpoint(newCtx, rootChain)
+		require.NoError(t, err)
+		require.Equal(t, lastCheckpoint, newLastCheckpoint, "last checkpoint of %s differs", rootChain)
+		require.Equal(t, keeper.GetACKCount(ctx, rootChain), newApp.CheckpointKeeper.GetACKCount(newCtx, rootChain))
+		require.Equal(t, keeper.GetLastNoAckByRootChain(ctx, rootChain), newApp.CheckpointKeeper.GetLastNoAckByRootChain(newCtx, rootChain))
+	}
+
+	// ack count not matching checkpoints is rejected
+	exported.RootChainCheckpoints[0].AckCount = 2
+	require.Error(t, types.ValidateGenesis(exported))
+}
@@ -17,20 +17,30 @@ import (
 func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		var result sdk.Result
 		switch msg := msg.(type) {
 		case types.MsgCheckpoint:
-			return handleMsgCheckpoint(ctx, msg, k, contractCaller)
+			result = handleMsgCheckpoint(ctx, msg, k, contractCaller)
 		case types.MsgCheckpointAck:
-			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
+			result = handleMsgCheckpointAck(ctx, msg, k, contractCaller)
 		case types.MsgCheckpointNoAck:
-			return handleMsgCheckpointNoAck(ctx, msg, k)
+			result = handleMsgCheckpointNoAck(ctx, msg, k)
 		case types.MsgCheckpointSync:
-			return handleMsgCheckpointSync(ctx, msg, k)
+			result = handleMsgCheckpointSync(ctx, msg, k)
 		case types.MsgCheckpointSyncAck:
-			return handleMsgCheckpointSyncAck(ctx, msg, k)
+			result = handleMsgCheckpointSyncAck(ctx, msg, k)
+		case types.MsgCheckpointCancel:
+			result = handleMsgCheckpointCancel(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
+
+		// heartbeat, tells idle module apart from one rejecting everything
+		if result.IsOK() {
+			k.RecordHandlerSuccess(ctx, msg.Type())
+		}
+		return result
 	}
 }
 
@@ -41,17 +51,31 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	timeStamp := uint64(ctx.BlockTime().Unix())
 	params := k.GetParams(ctx)
 
+	// checks added by checkpoint upgrade apply from its height only
+	upgradeActive := k.IsUpgradeActive(ctx)
+
+	if upgradeActive && !params.IsRootChainAllowed(msg.RootChainType) {
+		logger.Error("Root chain is not enabled", "root", msg.RootChainType)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+
+	if upgradeActive && params.IsRootChainPaused(msg.RootChainType) {
+		logger.Error("Checkpoints are paused for root chain", "root", msg.RootChainType)
+		return common.ErrRootChainPaused(k.Codespace(), msg.RootChainType).Result()
+	}
+
 	//
 	// Check checkpoint buffer
 	//
 
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 	if err == nil {
-		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
+		checkpointBufferTime := uint64(params.GetCheckpointBufferTime(msg.RootChainType).Seconds())
 
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "root", msg.RootChainType, "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
 			k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+			k.AppendCheckpointLifecycle(ctx, types.LifecycleFlushed, msg.RootChainType, checkpointBuffer.StartBlock, checkpointBuffer.EndBlock)
 		} else {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
 			logger.Error("Checkpoint already exits in buffer", "root", msg.RootChainType, "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
@@ -59,6 +83,15 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		}
 	}
 
+	//
+	// Validate checkpoint span
+	//
+	if span := msg.EndBlock - msg.StartBlock + 1; upgradeActive && msg.EndBlock >= msg.StartBlock && span > params.MaxCheckpointLength {
+		logger.Error("Checkpoint span exceeds max checkpoint length",
+			"startBlock", msg.StartBlock, "endBlock", msg.EndBlock, "span", span, "maxCheckpointLength", params.MaxCheckpointLength)
+		return common.ErrCheckpointTooLarge(k.Codespace(), span, params.MaxCheckpointLength).Result()
+	}
+
 	//
 	// Validate last checkpoint
 	//
@@ -84,11 +117,13 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
 		}
 	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() {
-		activation := k.ck.GetChainActivationHeight(ctx, msg.RootChainType)
-		if activation != msg.StartBlock {
-			logger.Error("First checkpoint to start from block active height",
-				"activation", activation, "start", msg.StartBlock, "root", msg.RootChainType)
-			return common.ErrBadBlockDetails(k.Codespace()).Result()
+		firstStart := k.GetFirstCheckpointStart(ctx, msg.RootChainType)
+		if firstStart != msg.StartBlock {
+			logger.Error("First checkpoint to start from first checkpoint start block",
+				"firstStart", firstStart, "start", msg.StartBlock, "root", msg.RootChainType)
+			return typedError(upgradeActive,
+				common.ErrInvalidCheckpointStart(k.Codespace(), msg.RootChainType, firstStart, msg.StartBlock),
+				common.ErrBadBlockDetails(k.Codespace())).Result()
 		}
 	}
 
@@ -96,27 +131,29 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	// Validate account hash
 	//
 
-	// Make sure latest AccountRootHash matches
-	// Calculate new account root hash
-	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
-	logger.Debug("DividendAccounts of all validators", "dividendAccountsLength", len(dividendAccounts))
-
-	// Get account root has from dividend accounts
-	accountRoot, err := types.GetAccountRootHash(dividendAccounts)
-	if err != nil {
-		logger.Error("Error while fetching account root hash", "error", err)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
-	}
-	logger.Debug("Validator account root hash generated", "accountRootHash", hmTypes.BytesToHeimdallHash(accountRoot).String())
-
-	// Compare stored root hash to msg root hash
-	if !bytes.Equal(accountRoot, msg.AccountRootHash.Bytes()) {
-		logger.Error(
-			"AccountRootHash of current state doesn't match from msg",
-			"hash", hmTypes.BytesToHeimdallHash(accountRoot).String(),
-			"msgHash", msg.AccountRootHash,
-		)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+	// test root chain has no contract to check account root against, and
+	// root chains not using dividend accounts may relax the check
+	if !upgradeActive || (msg.RootChainType != hmTypes.RootChainTypeTest && params.IsAccountRootRequired(msg.RootChainType)) {
+		// Make sure latest AccountRootHash matches
+		// Get account root persisted on dividend accounts change
+		accountRoot, err := k.GetAccountRoot(ctx, msg.RootChainType)
+		if err != nil {
+			logger.Error("Error while fetching account root hash", "error", err)
+			return common.ErrBadBlockDetails(k.Codespace()).Result()
+		}
+		logger.Debug("Validator account root hash generated", "accountRootHash", hmTypes.BytesToHeimdallHash(accountRoot).String())
+
+		// Compare stored root hash to msg root hash
+		if !bytes.Equal(accountRoot, msg.AccountRootHash.Bytes()) {
+			logger.Error(
+				"AccountRootHash of current state doesn't match from msg",
+				"hash", hmTypes.BytesToHeimdallHash(accountRoot).String(),
+				"msgHash", msg.AccountRootHash,
+			)
+			return typedError(upgradeActive,
+				common.ErrAccountRootMismatch(k.Codespace(), msg.RootChainType, hmTypes.BytesToHeimdallHash(accountRoot), msg.AccountRootHash),
+				common.ErrBadBlockDetails(k.Codespace())).Result()
+		}
 	}
 
 	//
@@ -125,18 +162,53 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 
 	// Check proposer in message
 	validatorSet := k.sk.GetValidatorSet(ctx)
+	k.consumeValidatorGas(ctx, len(validatorSet.Validators))
 	if validatorSet.Proposer == nil {
 		logger.Error("No proposer in validator set", "msgProposer", msg.Proposer.String())
-		return common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set").Result()
+		return typedError(upgradeActive,
+			common.ErrNoProposer(k.Codespace()),
+			common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set")).Result()
 	}
 
-	if !bytes.Equal(msg.Proposer.Bytes(), validatorSet.Proposer.Signer.Bytes()) {
-		logger.Error(
-			"Invalid proposer in msg",
-			"proposer", validatorSet.Proposer.Signer.String(),
-			"msgProposer", msg.Proposer.String(),
-		)
-		return common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg").Result()
+	proposer := *validatorSet.Proposer
+	if !bytes.Equal(msg.Proposer.Bytes(), proposer.Signer.Bytes()) {
+		// backup proposers are added by checkpoint upgrade, they are found by rotating copy of validator set
+		backup, ok := hmTypes.Validator{}, false
+		if upgradeActive {
+			k.consumeValidatorGas(ctx, len(validatorSet.Validators))
+			backup, ok = getBackupProposer(ctx, k, msg.Proposer)
+		}
+		if !ok {
+			logger.Error(
+				"Invalid proposer in msg",
+				"proposer", proposer.Signer.String(),
+				"msgProposer", msg.Proposer.String(),
+			)
+			return typedError(upgradeActive,
+				common.ErrProposerMismatch(k.Codespace(), proposer.Signer, msg.Proposer),
+				common.ErrInvalidMsg(k.Codespace(), "Invalid proposer in msg")).Result()
+		}
+
+		logger.Info("Checkpoint proposed by backup proposer",
+			"proposer", proposer.Signer.String(), "backupProposer", backup.Signer.String(), "noAckStreak", k.GetNoAckStreak(ctx))
+		proposer = backup
+	}
+
+	// Check proposer holds enough voting power
+	minPowerFraction := params.MinProposerPowerFraction
+	if upgradeActive && !minPowerFraction.IsNil() && minPowerFraction.IsPositive() {
+		proposerPower := proposer.VotingPower
+		totalPower := validatorSet.TotalVotingPower()
+		if sdk.NewDec(proposerPower).LT(minPowerFraction.MulInt64(totalPower)) {
+			logger.Error(
+				"Proposer voting power below threshold",
+				"proposer", proposer.Signer.String(),
+				"power", proposerPower,
+				"totalPower", totalPower,
+				"minFraction", minPowerFraction.String(),
+			)
+			return common.ErrLowProposerPower(k.Codespace(), proposerPower, totalPower).Result()
+		}
 	}
 
 	//
@@ -145,21 +217,22 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	epoch := k.GetACKCount(ctx, hmTypes.RootChainTypeStake) + 1
 	if epoch != msg.Epoch {
 		logger.Error("Current epoch does not match msg", "msg.epoch", msg.Epoch, "current", epoch)
-		return common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set").Result()
+		return typedError(upgradeActive,
+			common.ErrEpochMismatch(k.Codespace(), epoch, msg.Epoch),
+			common.ErrInvalidMsg(k.Codespace(), "No proposer in stored validator set")).Result()
 	}
 
 	// Emit event for checkpoint
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpoint,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
-			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		params.EventTypeMode, types.EventTypeCheckpoint, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -177,20 +250,42 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		"start", msg.StartBlock,
 		"end", msg.EndBlock,
 	)
+
+	// checks added by checkpoint upgrade apply from its height only
+	upgradeActive := k.IsUpgradeActive(ctx)
+
+	if upgradeActive && !k.GetParams(ctx).IsRootChainAllowed(msg.RootChainType) {
+		logger.Error("Root chain is not enabled", "root", msg.RootChainType)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
+	if err != nil && upgradeActive {
+		logger.Error("No checkpoint in buffer to ack", "root", msg.RootChainType, "number", msg.Number)
+		return ackBufferNotFoundError(ctx, k, msg).Result()
+	}
 
 	if err == nil {
 		if msg.StartBlock != headerBlock.StartBlock {
 			logger.Error("Invalid start block", "startExpected", headerBlock.StartBlock, "startReceived", msg.StartBlock)
 			return common.ErrBadAck(k.Codespace()).Result()
 		}
+		// End block may be cut down by root chain contract but never past buffered checkpoint
+		if upgradeActive && (msg.EndBlock < msg.StartBlock || msg.EndBlock > headerBlock.EndBlock) {
+			logger.Error("Invalid end block", "endExpected", headerBlock.EndBlock, "endReceived", msg.EndBlock, "rootChain", msg.RootChainType)
+			return common.ErrBadAck(k.Codespace()).Result()
+		}
+		if upgradeActive && !msg.Proposer.Equals(headerBlock.Proposer) {
+			logger.Error("Invalid proposer", "proposerExpected", headerBlock.Proposer.String(), "proposerReceived", msg.Proposer.String(), "rootChain", msg.RootChainType)
+			return common.ErrBadAck(k.Codespace()).Result()
+		}
 		// Return err if start and end matches but contract root hash doesn't match
 		if msg.StartBlock == headerBlock.StartBlock && msg.EndBlock == headerBlock.EndBlock && !msg.RootHash.Equals(headerBlock.RootHash) {
 			logger.Error("Invalid ACK",
 				"startExpected", headerBlock.StartBlock,
 				"startReceived", msg.StartBlock,
 				"endExpected", headerBlock.EndBlock,
-				"endReceived", msg.StartBlock,
+				"endReceived", msg.EndBlock,
 				"rootExpected", headerBlock.RootHash.String(),
 				"rootRecieved", msg.RootHash.String(),
 				"rootChain", msg.RootChainType,
@@ -199,19 +294,147 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 		}
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointAck,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
-		),
-	})
+	// Validate ack number, contract numbering may skip within tolerance
+	if upgradeActive {
+		expectedNumber := k.GetExpectedAckNumber(ctx, msg.RootChainType)
+		tolerance := k.GetParams(ctx).GetAckNumberTolerance(msg.RootChainType)
+		if msg.Number < expectedNumber || msg.Number > expectedNumber+tolerance {
+			logger.Error("Invalid ack number",
+				"expected", expectedNumber,
+				"tolerance", tolerance,
+				"received", msg.Number,
+				"rootChain", msg.RootChainType,
+			)
+			return common.ErrInvalidAckNumber(k.Codespace(), expectedNumber, tolerance, msg.Number).Result()
+		}
+	}
+
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointAck, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
+// getBackupProposer returns backup proposer with address, false if address may not propose as backup
+func getBackupProposer(ctx sdk.Context, k Keeper, address hmTypes.HeimdallAddress) (hmTypes.Validator, bool) {
+	for _, backup := range k.GetBackupProposers(ctx) {
+		if backup.Signer.Equals(address) {
+			return backup, true
+		}
+	}
+	return hmTypes.Validator{}, false
+}
+
+// typedError returns error with distinct code and data once checkpoint upgrade is active. Before it
+// legacy error is returned, so results of blocks before upgrade don't change.
+func typedError(upgradeActive bool, typed sdk.Error, legacy sdk.Error) sdk.Error {
+	if !upgradeActive {
+		return legacy
+	}
+	return typed
+}
+
+// ackBufferNotFoundError returns error for ack without checkpoint in buffer. Ack for already
+// acked number is a replay and must be abandoned, otherwise ack may have arrived before
+// buffer is written and relayer should retry it. Before checkpoint upgrade it is always bad ack.
+func ackBufferNotFoundError(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck) sdk.Error {
+	if !k.IsUpgradeActive(ctx) || msg.Number < k.GetExpectedAckNumber(ctx, msg.RootChainType) {
+		return common.ErrBadAck(k.Codespace())
+	}
+	return common.ErrAckBufferNotFound(k.Codespace(), msg.RootChainType)
+}
+
+// noAckSchedule holds times from which no-ack is accepted
+type noAckSchedule struct {
+	Epoch      uint64
+	EpochStart uint64
+
+	CheckpointReadyAt     time.Time // buffer time, extended for large buffered checkpoint, after last checkpoint
+	NoAckReadyAt          time.Time // buffer time after last no-ack
+	DeadlineAt            time.Time // epoch deadline, zero if it is disabled or epoch start is unknown
+	ExpeditedNoAckReadyAt time.Time // shorter of buffer time and epoch deadline after last no-ack
+}
+
+// getNoAckSchedule computes no-ack schedule of root chain. Past epoch deadline stuck
+// proposer is rotated without waiting for buffer time after last checkpoint, epochs are only
+// tracked for stake root chain. Before first checkpoint there is no checkpoint to wait for and
+// CheckpointReadyAt is zero, no-acks are then only spaced by last no-ack. Wait after last
+// checkpoint scales with length of buffered checkpoint, see Params.GetNoAckBufferTime.
+func getNoAckSchedule(ctx sdk.Context, k Keeper, rootChain string) (noAckSchedule, error) {
+	params := k.GetParams(ctx)
+	bufferTime := params.GetCheckpointBufferTime(rootChain)
+	ackCount := k.GetACKCount(ctx, rootChain)
+	lastNoAckTime := time.Unix(int64(k.GetLastNoAckByRootChain(ctx, rootChain)), 0)
+
+	schedule := noAckSchedule{
+		Epoch:                 ackCount + 1,
+		NoAckReadyAt:          lastNoAckTime.Add(bufferTime),
+		ExpeditedNoAckReadyAt: lastNoAckTime.Add(bufferTime),
+	}
+
+	lastCheckpoint, found, err := k.readCheckpoint(ctx, ackCount, rootChain)
+	if err != nil {
+		return schedule, err
+	}
+	if found {
+		var bufferedLength uint64
+		if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, rootChain); err == nil && checkpointBuffer.EndBlock >= checkpointBuffer.StartBlock {
+			bufferedLength = checkpointBuffer.EndBlock - checkpointBuffer.StartBlock + 1
+		}
+
+		noAckBufferTime := params.GetNoAckBufferTime(rootChain, bufferedLength)
+		schedule.CheckpointReadyAt = time.Unix(int64(lastCheckpoint.TimeStamp), 0).Add(noAckBufferTime)
+	}
+
+	if rootChain != hmTypes.RootChainTypeStake {
+		return schedule, nil
+	}
+
+	epochStart, found := k.GetEpochStartTime(ctx, schedule.Epoch)
+	if found && params.EpochDeadline > 0 {
+		schedule.EpochStart = epochStart
+		schedule.DeadlineAt = time.Unix(int64(epochStart), 0).Add(params.EpochDeadline)
+
+		// expedited no-acks are spaced by epoch deadline, if it is shorter
+		if params.EpochDeadline < bufferTime {
+			schedule.ExpeditedNoAckReadyAt = lastNoAckTime.Add(params.EpochDeadline)
+		}
+	}
+
+	return schedule, nil
+}
+
+// IsExpedited returns true if epoch deadline passed at given time
+func (s noAckSchedule) IsExpedited(at time.Time) bool {
+	return !s.DeadlineAt.IsZero() && !at.Before(s.DeadlineAt)
+}
+
+// AllowedAt returns earliest time no-ack is accepted
+func (s noAckSchedule) AllowedAt() time.Time {
+	allowedAt := laterTime(s.CheckpointReadyAt, s.NoAckReadyAt)
+	if s.DeadlineAt.IsZero() {
+		return allowedAt
+	}
+
+	if expeditedAt := laterTime(s.DeadlineAt, s.ExpeditedNoAckReadyAt); expeditedAt.Before(allowedAt) {
+		return expeditedAt
+	}
+	return allowedAt
+}
+
+func laterTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
 // Handles checkpoint no-ack transaction
 func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k Keeper) sdk.Result {
 	logger := k.Logger(ctx)
@@ -219,65 +442,180 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	// Get current block time
 	currentTime := ctx.BlockTime()
 
-	// Get buffer time from params
-	bufferTime := k.GetParams(ctx).CheckpointBufferTime
+	params := k.GetParams(ctx)
+	rootChain := msg.GetRootChainType()
 
-	// Fetch last checkpoint from store
-	// TODO figure out how to handle this error
-	lastCheckpoint, _ := k.GetLastCheckpoint(ctx, hmTypes.RootChainTypeStake)
-	lastCheckpointTime := time.Unix(int64(lastCheckpoint.TimeStamp), 0)
+	// no-acks of other root chains are added by checkpoint upgrade
+	if rootChain != hmTypes.RootChainTypeStake && (!k.IsUpgradeActive(ctx) || !params.IsRootChainAllowed(rootChain)) {
+		logger.Error("Root chain is not enabled", "root", rootChain)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+
+	schedule, err := getNoAckSchedule(ctx, k, rootChain)
+	if err != nil {
+		logger.Error("Unable to fetch last checkpoint for no-ack", "root", rootChain, "error", err)
+		return common.ErrInvalidNoACK(k.Codespace()).Result()
+	}
+	epoch, epochStart := schedule.Epoch, schedule.EpochStart
+	// epoch deadline is added by checkpoint upgrade
+	expedited := k.IsUpgradeActive(ctx) && schedule.IsExpedited(currentTime)
 
 	// If last checkpoint is not present or last checkpoint happens before checkpoint buffer time -- thrown an error
-	if lastCheckpointTime.After(currentTime) || (currentTime.Sub(lastCheckpointTime) < bufferTime) {
-		logger.Debug("Invalid No ACK -- Waiting for last checkpoint ACK")
+	if !expedited && currentTime.Before(schedule.CheckpointReadyAt) {
+		logger.Debug("Invalid No ACK -- Waiting for last checkpoint ACK", "root", rootChain)
 		return common.ErrInvalidNoACK(k.Codespace()).Result()
 	}
 
 	// Check last no ack - prevents repetitive no-ack
-	lastNoAck := k.GetLastNoAck(ctx)
-	lastNoAckTime := time.Unix(int64(lastNoAck), 0)
-
-	if lastNoAckTime.After(currentTime) || (currentTime.Sub(lastNoAckTime) < bufferTime) {
-		logger.Debug("Too many no-ack")
+	noAckReadyAt := schedule.NoAckReadyAt
+	if expedited {
+		noAckReadyAt = schedule.ExpeditedNoAckReadyAt
+	}
+	if currentTime.Before(noAckReadyAt) {
+		logger.Debug("Too many no-ack", "root", rootChain)
 		return common.ErrTooManyNoACK(k.Codespace()).Result()
 	}
 
 	// Set new last no-ack
 	newLastNoAck := uint64(currentTime.Unix())
-	k.SetLastNoAck(ctx, newLastNoAck)
-	logger.Debug("Last No-ACK time set", "lastNoAck", newLastNoAck)
+	if rootChain == hmTypes.RootChainTypeStake {
+		k.SetLastNoAck(ctx, newLastNoAck)
+		k.SetNoAckStreak(ctx, k.GetNoAckStreak(ctx)+1)
+	}
+	k.SetLastNoAckByRootChain(ctx, rootChain, newLastNoAck)
+	logger.Debug("Last No-ACK time set", "root", rootChain, "lastNoAck", newLastNoAck)
+
+	// record no-ack against checkpoint waiting in buffer, if any
+	var startBlock, endBlock uint64
+	if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, rootChain); err == nil {
+		startBlock, endBlock = checkpointBuffer.StartBlock, checkpointBuffer.EndBlock
+	}
+	k.AppendCheckpointLifecycle(ctx, types.LifecycleNoAcked, rootChain, startBlock, endBlock)
 
 	//
 	// Update to new proposer
 	//
 
+	// Proposer being rotated out
+	var oldProposer hmTypes.HeimdallAddress
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if proposer := validatorSet.Proposer; proposer != nil {
+		oldProposer = proposer.Signer
+	}
+	k.consumeValidatorGas(ctx, len(validatorSet.Validators))
+
 	// Increment accum (selects new proposer)
 	k.sk.IncrementAccum(ctx, 1)
 
 	// Get new proposer
 	vs := k.sk.GetValidatorSet(ctx)
 	newProposer := vs.GetProposer()
+
+	// record rotation for post-mortems
+	k.AppendProposerRotation(ctx, rootChain, oldProposer, newProposer.Signer)
 	logger.Debug(
 		"New proposer selected",
+		"root", rootChain,
 		"validator", newProposer.Signer.String(),
 		"signer", newProposer.Signer.String(),
 		"power", newProposer.VotingPower,
 	)
 
 	// add events
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointNoAck,
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		params.EventTypeMode, types.EventTypeCheckpointNoAck, rootChain,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyNewProposer, newProposer.Signer.String()),
+		sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+	))
+
+	if expedited {
+		logger.Info("Epoch deadline passed, no-ack expedited", "epoch", epoch, "epochStart", epochStart, "deadline", params.EpochDeadline)
+		ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+			params.EventTypeMode, types.EventTypeNoAckExpedited, rootChain,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyNewProposer, newProposer.Signer.String()),
-		),
-	})
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpochStart, strconv.FormatUint(epochStart, 10)),
+			sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+		))
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgCheckpointCancel validates cancel of stuck checkpoint buffer. Buffer is flushed by post
+// handler once validators voted checkpoint is not submitted to root chain.
+func handleMsgCheckpointCancel(ctx sdk.Context, msg types.MsgCheckpointCancel, k Keeper) sdk.Result {
+	// cancel is added by checkpoint upgrade
+	if !k.IsUpgradeActive(ctx) {
+		return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
+	}
+
+	if _, err := validateCheckpointCancel(ctx, k, msg); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointCancel, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.From.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
+// validateCheckpointCancel returns buffered checkpoint cancel is for, or error if cancel doesn't match it
+func validateCheckpointCancel(ctx sdk.Context, k Keeper, msg types.MsgCheckpointCancel) (*hmTypes.Checkpoint, sdk.Error) {
+	logger := k.Logger(ctx)
+
+	if !k.GetParams(ctx).IsRootChainAllowed(msg.RootChainType) {
+		logger.Error("Root chain is not enabled", "root", msg.RootChainType)
+		return nil, common.ErrWrongRootChain(k.Codespace())
+	}
+
+	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
+	if err != nil || checkpointBuffer == nil {
+		logger.Error("No checkpoint in buffer to cancel", "root", msg.RootChainType)
+		return nil, common.ErrNoCheckpointBufferFound(k.Codespace())
+	}
+
+	// cancel created before ack landed must not flush next checkpoint
+	if ackCount := k.GetACKCount(ctx, msg.RootChainType); msg.AckCount != ackCount {
+		logger.Error("Ack count changed since cancel was created", "root", msg.RootChainType, "expected", ackCount, "received", msg.AckCount)
+		return nil, common.ErrInvalidCheckpointCancel(k.Codespace(), "ack count mismatch")
+	}
+
+	if msg.StartBlock != checkpointBuffer.StartBlock || msg.EndBlock != checkpointBuffer.EndBlock {
+		logger.Error("Cancel doesn't match checkpoint in buffer",
+			"root", msg.RootChainType,
+			"startExpected", checkpointBuffer.StartBlock,
+			"startReceived", msg.StartBlock,
+			"endExpected", checkpointBuffer.EndBlock,
+			"endReceived", msg.EndBlock,
+		)
+		return nil, common.ErrInvalidCheckpointCancel(k.Codespace(), "block range mismatch")
+	}
+
+	// only current proposer or proposer of buffered checkpoint may cancel it
+	var currentProposer hmTypes.HeimdallAddress
+	if proposer := k.sk.GetValidatorSet(ctx).Proposer; proposer != nil {
+		currentProposer = proposer.Signer
+	}
+	if !msg.From.Equals(currentProposer) && !msg.From.Equals(checkpointBuffer.Proposer) {
+		logger.Error("Cancel is not sent by proposer", "root", msg.RootChainType, "from", msg.From.String(), "proposer", currentProposer.String())
+		return nil, common.ErrInvalidCheckpointCancel(k.Codespace(), "sender is not proposer")
+	}
+
+	return checkpointBuffer, nil
+}
+
 // handleMsgCheckpointSync Validates if checkpoint sync submitted on chain is valid
 func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Keeper) sdk.Result {
 	logger := k.Logger(ctx)
@@ -287,12 +625,32 @@ func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Kee
 	)
 	timeStamp := uint64(ctx.BlockTime().Unix())
 	params := k.GetParams(ctx)
+
+	// checks added by checkpoint upgrade apply from its height only
+	upgradeActive := k.IsUpgradeActive(ctx)
+
+	if upgradeActive && params.IsRootChainPaused(msg.RootChainType) {
+		logger.Error("Checkpoints are paused for root chain", "root", msg.RootChainType)
+		return common.ErrRootChainPaused(k.Codespace(), msg.RootChainType).Result()
+	}
+
+	// sync sign bytes carry root chain id, which is known without state for built in root chains only
+	if upgradeActive && hmTypes.GetRootChainID(msg.RootChainType) == 0 {
+		logger.Error("Checkpoint sync is not supported for root chain", "root", msg.RootChainType)
+		return common.ErrWrongRootChain(k.Codespace()).Result()
+	}
+
+	if upgradeActive && params.VerifySyncProposerSignature && !verifyProposerSignature(msg.GetProposerSignBytes(), msg.ProposerSignature, msg.Proposer) {
+		logger.Error("Checkpoint sync is not signed by proposer", "root", msg.RootChainType, "proposer", msg.Proposer.String())
+		return common.ErrInvalidProposerSignature(k.Codespace(), msg.Proposer.String()).Result()
+	}
+
 	//
 	// Check checkpoint sync buffer
 	//
 	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType)
 	if err == nil {
-		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds() / 5)
+		checkpointBufferTime := uint64(params.GetCheckpointBufferTime(msg.RootChainType).Seconds() / 5)
 		if bufferSync.TimeStamp == 0 || ((timeStamp > bufferSync.TimeStamp) && timeStamp-bufferSync.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint sync has been timed out. Flushing buffer.", "root", msg.RootChainType)
 			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType)
@@ -303,15 +661,23 @@ func handleMsgCheckpointSync(ctx sdk.Context, msg types.MsgCheckpointSync, k Kee
 		}
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointSync,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
-		),
-	})
+	//
+	// First sync for root chain must start at its sync genesis block
+	//
+	if syncGenesisBlock, ok := params.GetSyncGenesisBlock(msg.RootChainType); upgradeActive && ok && msg.Number <= 1 && msg.StartBlock != syncGenesisBlock {
+		logger.Error("First checkpoint sync should start at sync genesis block",
+			"root", msg.RootChainType, "syncGenesisBlock", syncGenesisBlock, "start", msg.StartBlock)
+		return common.ErrInvalidSyncStart(k.Codespace(), syncGenesisBlock, msg.StartBlock).Result()
+	}
+
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		params.EventTypeMode, types.EventTypeCheckpointSync, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -327,29 +693,70 @@ func handleMsgCheckpointSyncAck(ctx sdk.Context, msg types.MsgCheckpointSyncAck,
 	)
 	timeStamp := uint64(ctx.BlockTime().Unix())
 	params := k.GetParams(ctx)
+
+	// checks added by checkpoint upgrade apply from its height only
+	upgradeActive := k.IsUpgradeActive(ctx)
+
+	if upgradeActive && params.IsRootChainPaused(msg.RootChainType) {
+		logger.Error("Checkpoints are paused for root chain", "root", msg.RootChainType)
+		return common.ErrRootChainPaused(k.Codespace(), msg.RootChainType).Result()
+	}
+
 	//
 	// Check checkpoint sync buffer
 	//
 	bufferSync, err := k.GetCheckpointSyncFromBuffer(ctx, msg.RootChainType)
+
+	// ack is bound to proposer of buffered sync, otherwise any relayer could sign it for itself
+	if upgradeActive && params.VerifySyncProposerSignature {
+		if err != nil || bufferSync == nil {
+			logger.Error("No checkpoint sync in buffer to bind ack proposer", "root", msg.RootChainType)
+			return common.ErrNoCheckpointBufferFound(k.Codespace()).Result()
+		}
+
+		if !msg.Proposer.Equals(bufferSync.Proposer) {
+			logger.Error("Checkpoint sync ack is not sent by sync proposer", "root", msg.RootChainType, "proposer", msg.Proposer.String(), "expected", bufferSync.Proposer.String())
+			return common.ErrBadProposerDetails(k.Codespace(), bufferSync.Proposer).Result()
+		}
+
+		if !verifyProposerSignature(msg.GetProposerSignBytes(), msg.ProposerSignature, bufferSync.Proposer) {
+			logger.Error("Checkpoint sync ack is not signed by proposer", "root", msg.RootChainType, "proposer", bufferSync.Proposer.String())
+			return common.ErrInvalidProposerSignature(k.Codespace(), bufferSync.Proposer.String()).Result()
+		}
+	}
+
 	if err == nil {
-		checkpointBufferTime := uint64(params.CheckpointBufferTime.Seconds())
+		checkpointBufferTime := uint64(params.GetCheckpointBufferTime(msg.RootChainType).Seconds())
 		if bufferSync.TimeStamp == 0 || ((timeStamp > bufferSync.TimeStamp) && timeStamp-bufferSync.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint sync has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", bufferSync.TimeStamp)
 			k.FlushCheckpointSyncBuffer(ctx, msg.RootChainType)
 		}
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointSyncAck,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointSyncAck, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// verifyProposerSignature checks signature over data was made by proposer's key.
+// It is defense in depth for sync messages relayed on behalf of proposer.
+func verifyProposerSignature(data []byte, sig []byte, proposer hmTypes.HeimdallAddress) bool {
+	if len(sig) == 0 {
+		return false
+	}
+
+	signer, err := helper.RecoverSigner(data, sig)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(signer, proposer.Bytes())
+}
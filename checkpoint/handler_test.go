@@ -1,12 +1,14 @@
 package checkpoint_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/maticnetwork/heimdall/app"
 	cmTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
@@ -15,10 +17,13 @@ import (
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type HandlerTestSuite struct {
@@ -86,7 +91,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpoint() {
 	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
 
 	dividendAccounts := topupKeeper.GetAllDividendAccounts(ctx)
-	accRootHash, err := types.GetAccountRootHash(dividendAccounts)
+	accRootHash, err := types.GetAccountRootHash(dividendAccounts, types.DefaultAccountHashStrategy)
 	require.NoError(t, err)
 	accountRoot := hmTypes.BytesToHeimdallHash(accRootHash)
 	suite.Run("Success", func() {
@@ -155,6 +160,208 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpoint() {
 	})
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointMinProposerPower() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	topupKeeper := app.TopupKeeper
+	start := uint64(0)
+	maxSize := uint64(256)
+	params := keeper.GetParams(ctx)
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	topupKeeper.AddDividendAccount(ctx, dividendAccount)
+
+	// generate proposer for validator set
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	header, err := chSim.GenRandCheckpoint(start, maxSize, params.MaxCheckpointLength)
+	require.NoError(t, err)
+
+	// add current proposer to header
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	accRootHash, err := types.GetAccountRootHash(topupKeeper.GetAllDividendAccounts(ctx), types.DefaultAccountHashStrategy)
+	require.NoError(t, err)
+
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		hmTypes.BytesToHeimdallHash(accRootHash),
+		"1234",
+		1,
+		hmTypes.RootChainTypeStake,
+	)
+
+	suite.Run("Below threshold", func() {
+		// proposer can never hold entire power of a 2 validator set
+		params.MinProposerPowerFraction = sdk.OneDec()
+		keeper.SetParams(ctx, params)
+
+		got := suite.handler(ctx, msgCheckpoint)
+		require.False(t, got.IsOK(), "expected send-checkpoint to fail")
+		require.Equal(t, errs.CodeLowProposerPower, got.Code)
+	})
+
+	suite.Run("Above threshold", func() {
+		params.MinProposerPowerFraction = sdk.ZeroDec()
+		keeper.SetParams(ctx, params)
+
+		got := suite.handler(ctx, msgCheckpoint)
+		require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointTooLarge() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		proposer,
+		0,
+		params.MaxCheckpointLength,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallHash("123"),
+		"1234",
+		1,
+		hmTypes.RootChainTypeStake,
+	)
+
+	got := suite.handler(ctx, msgCheckpoint)
+	require.False(t, got.IsOK(), "expected oversized checkpoint to fail")
+	require.Equal(t, errs.CodeCheckpointTooLarge, got.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointTestRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	// account root hash is not checked for test root chain
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		stakingKeeper.GetValidatorSet(ctx).Proposer.Signer,
+		0,
+		255,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallHash("456"),
+		"1234",
+		1,
+		hmTypes.RootChainTypeTest,
+	)
+
+	// test root chain is disabled by default
+	got := suite.handler(ctx, msgCheckpoint)
+	require.False(t, got.IsOK(), "expected test root chain checkpoint to fail")
+	require.Equal(t, errs.CodeWrongRootChain, got.Code)
+
+	params := keeper.GetParams(ctx)
+	params.EnableTestRootChain = true
+	keeper.SetParams(ctx, params)
+
+	got = suite.handler(ctx, msgCheckpoint)
+	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNamespacedEvents() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.EventTypeMode = types.EventTypeModeBoth
+	keeper.SetParams(ctx, params)
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	app.TopupKeeper.AddDividendAccount(ctx, dividendAccount)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	got := suite.SendCheckpoint(header)
+	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+
+	var eventTypes []string
+	for _, event := range got.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypeCheckpoint)
+	require.Contains(t, eventTypes, types.RootChainEventType(types.EventTypeCheckpoint, hmTypes.RootChainTypeStake))
+
+	// every event carries root chain
+	for _, event := range got.Events {
+		var rootChain string
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyRootChain {
+				rootChain = string(attr.Value)
+			}
+		}
+		require.Equal(t, hmTypes.RootChainTypeStake, rootChain, "event %s has no root chain", event.Type)
+	}
+}
+
+func (suite *HandlerTestSuite) TestHandlerStats() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	app.TopupKeeper.AddDividendAccount(ctx, dividendAccount)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	require.Empty(t, keeper.GetHandlerStats(ctx))
+
+	// rejected msg is not counted
+	tooLarge := types.NewMsgCheckpointBlock(
+		hmTypes.HexToHeimdallAddress("123"),
+		0,
+		params.MaxCheckpointLength,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallHash("123"),
+		"1234",
+		1,
+		hmTypes.RootChainTypeStake,
+	)
+	got := suite.handler(ctx, tooLarge)
+	require.False(t, got.IsOK(), "expected oversized checkpoint to fail")
+	require.Empty(t, keeper.GetHandlerStats(ctx))
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	ctx = ctx.WithBlockHeight(10)
+	suite.ctx = ctx
+	got = suite.SendCheckpoint(header)
+	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
+
+	stats := keeper.GetHandlerStats(ctx)
+	require.Equal(t, []types.HandlerStats{
+		{MsgType: tooLarge.Type(), SuccessCount: 1, LastHeight: 10},
+	}, stats)
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointAfterBufferTimeOut() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -198,6 +405,44 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAfterBufferTimeOut() {
 	require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointBufferTimePerRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	ctx = ctx.WithBlockTime(time.Unix(1000000, 0))
+	params := keeper.GetParams(ctx)
+	params.CheckpointBufferTime = 10 * time.Minute
+	params.CheckpointBufferTimes = []types.RootChainDuration{{RootChain: hmTypes.RootChainTypeEth, Duration: 30 * time.Second}}
+	keeper.SetParams(ctx, params)
+	require.Equal(t, 30*time.Second, params.GetCheckpointBufferTime(hmTypes.RootChainTypeEth))
+	require.Equal(t, 10*time.Minute, params.GetCheckpointBufferTime(hmTypes.RootChainTypeBsc))
+
+	// both buffers are a minute old
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	buffered := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), proposer, "1234", uint64(ctx.BlockTime().Unix())-60)
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, rootChain))
+	}
+
+	newCheckpoint := func(rootChain string) types.MsgCheckpoint {
+		return types.NewMsgCheckpointBlock(proposer, 0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("123"), "1234", 1, rootChain)
+	}
+
+	// bsc buffer is still waiting for ack
+	got := suite.handler(ctx, newCheckpoint(hmTypes.RootChainTypeBsc))
+	require.Equal(t, errs.CodeNoACK, got.Code)
+
+	// eth buffer timed out and is flushed
+	got = suite.handler(ctx, newCheckpoint(hmTypes.RootChainTypeEth))
+	require.NotEqual(t, errs.CodeNoACK, got.Code)
+	_, err := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeEth)
+	require.Error(t, err)
+
+	// buffer time must be positive
+	params.CheckpointBufferTimes = []types.RootChainDuration{{RootChain: hmTypes.RootChainTypeEth}}
+	require.Error(t, params.Validate())
+}
+
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointExistInBuffer() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -322,6 +567,174 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 		got := suite.handler(ctx, msgCheckpointAck)
 		require.True(t, !got.IsOK(), errs.CodeToDefaultMsg(got.Code))
 	})
+
+	suite.Run("Invalid end", func() {
+		newAck := func(end uint64) types.MsgCheckpointAck {
+			return types.NewMsgCheckpointAck(
+				hmTypes.HexToHeimdallAddress("123"),
+				headerId,
+				header.Proposer,
+				header.StartBlock,
+				end,
+				header.RootHash,
+				hmTypes.HexToHeimdallHash("123123"),
+				uint64(1),
+				hmTypes.RootChainTypeStake,
+			)
+		}
+
+		got := suite.handler(ctx, newAck(header.EndBlock+1))
+		require.Equal(t, errs.CodeInvalidACK, got.Code)
+
+		if header.StartBlock > 0 {
+			got = suite.handler(ctx, newAck(header.StartBlock-1))
+			require.Equal(t, errs.CodeInvalidACK, got.Code)
+		}
+
+		// end cut down by root chain contract is accepted
+		got = suite.handler(ctx, newAck(header.StartBlock))
+		require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+	})
+
+	suite.Run("Invalid proposer", func() {
+		msgCheckpointAck := types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			headerId,
+			hmTypes.HexToHeimdallAddress("456"),
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			hmTypes.RootChainTypeStake,
+		)
+
+		got := suite.handler(ctx, msgCheckpointAck)
+		require.Equal(t, errs.CodeInvalidACK, got.Code)
+	})
+
+	suite.Run("Invalid number", func() {
+		newAck := func(number uint64) types.MsgCheckpointAck {
+			return types.NewMsgCheckpointAck(
+				hmTypes.HexToHeimdallAddress("123"),
+				number,
+				header.Proposer,
+				header.StartBlock,
+				header.EndBlock,
+				header.RootHash,
+				hmTypes.HexToHeimdallHash("123123"),
+				uint64(1),
+				hmTypes.RootChainTypeStake,
+			)
+		}
+
+		got := suite.handler(ctx, newAck(headerId+2))
+		require.False(t, got.IsOK(), "expected skipped ack number to fail")
+		require.Equal(t, errs.CodeInvalidAckNumber, got.Code)
+
+		// skipped number is accepted within tolerance
+		params := keeper.GetParams(ctx)
+		params.AckNumberTolerances = []types.RootChainTolerance{{RootChain: hmTypes.RootChainTypeStake, Tolerance: 2}}
+		keeper.SetParams(ctx, params)
+
+		got = suite.handler(ctx, newAck(headerId+2))
+		require.True(t, got.IsOK(), "expected send-ack to be ok, got %v", got)
+
+		// stale number is never accepted
+		got = suite.handler(ctx, newAck(headerId-1))
+		require.Equal(t, errs.CodeInvalidAckNumber, got.Code)
+	})
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAckBufferNotFound() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	newAck := func(rootChain string, start uint64, hash hmTypes.HeimdallHash) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(proposer, 1, proposer, start, start+255, hash, hmTypes.HexToHeimdallHash("123123"), uint64(1), rootChain)
+	}
+
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		suite.Run(rootChain, func() {
+			// ack before buffer is written is retriable
+			result := suite.handler(ctx, newAck(rootChain, 0, rootHash))
+			require.Equal(t, errs.CodeAckBufferNotFound, result.Code)
+
+			result = suite.postHandler(ctx, newAck(rootChain, 0, rootHash), abci.SideTxResultType_Yes)
+			require.Equal(t, errs.CodeAckBufferNotFound, result.Code)
+
+			// ack not matching buffer is abandoned
+			checkpoint := hmTypes.CreateBlock(0, 255, rootHash, proposer, "1234", uint64(ctx.BlockTime().Unix()))
+			require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint, rootChain))
+
+			result = suite.handler(ctx, newAck(rootChain, 1, rootHash))
+			require.Equal(t, errs.CodeInvalidACK, result.Code)
+
+			result = suite.handler(ctx, newAck(rootChain, 0, hmTypes.HexToHeimdallHash("456")))
+			require.Equal(t, errs.CodeInvalidACK, result.Code)
+
+			// matching ack is accepted
+			result = suite.handler(ctx, newAck(rootChain, 0, rootHash))
+			require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointCancel() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	proposer := stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	rootChain := hmTypes.RootChainTypeEth
+	newCancel := func(from hmTypes.HeimdallAddress, ackCount uint64, start uint64) types.MsgCheckpointCancel {
+		return types.NewMsgCheckpointCancel(from, ackCount, start, start+255, rootChain)
+	}
+
+	// nothing to cancel
+	got := suite.handler(ctx, newCancel(proposer, 0, 0))
+	require.Equal(t, errs.CodeNoCheckpointBuffer, got.Code)
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("456"), "1234", uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint, rootChain))
+
+	// stale ack count, other range or non proposer sender are rejected
+	got = suite.handler(ctx, newCancel(proposer, 1, 0))
+	require.Equal(t, errs.CodeInvalidCheckpointCancel, got.Code)
+
+	got = suite.handler(ctx, newCancel(proposer, 0, 256))
+	require.Equal(t, errs.CodeInvalidCheckpointCancel, got.Code)
+
+	got = suite.handler(ctx, newCancel(hmTypes.HexToHeimdallAddress("789"), 0, 0))
+	require.Equal(t, errs.CodeInvalidCheckpointCancel, got.Code)
+
+	_, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err, "rejected cancel must keep buffer")
+
+	// current proposer's cancel is accepted, buffer is flushed by post handler after side tx votes
+	got = suite.handler(ctx, newCancel(proposer, 0, 0))
+	require.True(t, got.IsOK(), "expected cancel to be ok, got %v", got)
+
+	_, err = keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err, "cancel must keep buffer until side tx is approved")
+
+	var eventTypes []string
+	for _, event := range got.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypeCheckpointCancel)
+
+	// proposer of buffered checkpoint may cancel it as well
+	got = suite.handler(ctx, newCancel(checkpoint.Proposer, 0, 0))
+	require.True(t, got.IsOK(), "expected cancel to be ok, got %v", got)
+
+	// root chain is not read by handler
+	suite.contractCaller.AssertNotCalled(t, "GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
@@ -361,10 +774,103 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
 	// set time lastCheckpoint timestamp + checkpointBufferTime
 	newTime := lastCheckpoint.TimeStamp + uint64(checkpointBufferTime)
 	suite.ctx = ctx.WithBlockTime(time.Unix(0, int64(newTime)))
+	oldProposer := stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
 	result := suite.SendNoAck()
 	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", got)
 	ackCount := keeper.GetACKCount(ctx, hmTypes.RootChainTypeStake)
 	require.Equal(t, uint64(0), uint64(ackCount), "Should not update state")
+
+	// rotation is recorded
+	rotations := keeper.GetProposerRotations(ctx, 1, 10)
+	require.Len(t, rotations, 1)
+	require.Equal(t, oldProposer, rotations[0].OldProposer)
+	require.Equal(t, stakingKeeper.GetValidatorSet(ctx).Proposer.Signer, rotations[0].NewProposer)
+
+	require.Equal(t, uint64(1), keeper.GetNoAckStreak(ctx))
+
+	// no-ack is recorded for stake root chain
+	lastNoAck := uint64(suite.ctx.BlockTime().Unix())
+	require.Equal(t, lastNoAck, keeper.GetLastNoAck(ctx))
+	keeper.SetLastNoAck(ctx, 0)
+	require.Equal(t, lastNoAck, keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	bufferTime := params.GetCheckpointBufferTime(hmTypes.RootChainTypeEth) + params.GetCheckpointBufferTime(hmTypes.RootChainTypeStake)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(bufferTime))
+
+	// no-ack of eth root chain rotates proposer and is recorded for eth only
+	oldProposer := stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeEth)
+	result := suite.handler(ctx, msgNoAck)
+	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", result)
+
+	lastNoAck := uint64(ctx.BlockTime().Unix())
+	require.Equal(t, lastNoAck, keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeEth))
+	require.Equal(t, uint64(0), keeper.GetLastNoAck(ctx))
+	require.Equal(t, uint64(0), keeper.GetNoAckStreak(ctx))
+
+	rotations := keeper.GetProposerRotations(ctx, 1, 10)
+	require.Len(t, rotations, 1)
+	require.Equal(t, oldProposer, rotations[0].OldProposer)
+	require.Equal(t, hmTypes.RootChainTypeEth, rotations[0].RootChain)
+
+	// eth no-acks are spaced by last eth no-ack, stake no-ack is not affected
+	result = suite.handler(ctx, msgNoAck)
+	require.False(t, result.IsOK(), "expected repeated eth no-ack to fail")
+	require.Equal(t, errs.CodeTooManyNoAck, result.Code)
+
+	result = suite.handler(ctx, types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake))
+	require.True(t, result.IsOK(), "expected stake no-ack to be ok, got %v", result)
+	require.Equal(t, lastNoAck, keeper.GetLastNoAck(ctx))
+	require.Equal(t, uint64(1), keeper.GetNoAckStreak(ctx))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointValidatorGas() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	app.TopupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+	chSim.LoadValidatorSet(4, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	validators := uint64(len(stakingKeeper.GetValidatorSet(ctx).Validators))
+
+	params := keeper.GetParams(ctx)
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(params.GetCheckpointBufferTime(rootChain)))
+
+	accountRoot, err := keeper.GetAccountRoot(ctx, rootChain)
+	require.NoError(t, err)
+	_, start := keeper.GetExpectedCheckpointStart(ctx, rootChain)
+	msgCheckpoint := types.NewMsgCheckpointBlock(hmTypes.HexToHeimdallAddress("456"), start, start+255, hmTypes.HexToHeimdallHash("123"), hmTypes.BytesToHeimdallHash(accountRoot), "1234", 1, rootChain)
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), rootChain)
+
+	// gas of handling msg with validator gas param, store access costs same in both runs
+	measure := func(msg sdk.Msg, validatorGas uint64) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		params.ValidatorGas = validatorGas
+		keeper.SetParams(cacheCtx, params)
+
+		meter := sdk.NewInfiniteGasMeter()
+		suite.handler(cacheCtx.WithGasMeter(meter), msg)
+		return meter.GasConsumed()
+	}
+
+	// checkpoint of other than proposer walks validator set again looking for backup proposers
+	require.Equal(t, 2*validators*1000000, measure(msgCheckpoint, 2000000)-measure(msgCheckpoint, 1000000))
+	require.Equal(t, validators*1000000, measure(msgNoAck, 2000000)-measure(msgNoAck, 1000000))
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeBufferTimeout() {
@@ -403,13 +909,135 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeBufferTimeout()
 	require.True(t, !result.IsOK(), errs.CodeToDefaultMsg(result.Code))
 }
 
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckLargeCheckpoint() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	params := keeper.GetParams(ctx)
+	params.CheckpointBufferTime = 100 * time.Second
+	params.NoAckTimePerBlock = time.Second
+	keeper.SetParams(ctx, params)
+
+	lastTime := time.Unix(1600000000, 0)
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(lastTime.Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeStake)
+
+	// buffered checkpoint is twice average length, no-ack waits a second more per extra block
+	buffered := hmTypes.CreateBlock(256, 256+2*params.AvgCheckpointLength-1, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(lastTime.Add(time.Second).Unix()))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeStake))
+	extended := params.CheckpointBufferTime + time.Duration(params.AvgCheckpointLength)*time.Second
+	require.Equal(t, extended, params.GetNoAckBufferTime(hmTypes.RootChainTypeStake, 2*params.AvgCheckpointLength))
+
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake)
+	result := suite.handler(ctx.WithBlockTime(lastTime.Add(params.CheckpointBufferTime)), msgNoAck)
+	require.Equal(t, errs.CodeInvalidNoACK, result.Code)
+
+	result = suite.handler(ctx.WithBlockTime(lastTime.Add(extended)), msgNoAck)
+	require.True(t, result.IsOK(), "expected no-ack after extended buffer time to be ok, got %v", result)
+
+	// extension is capped, but never below buffer time
+	params.MaxNoAckBufferTime = 150 * time.Second
+	require.Equal(t, params.MaxNoAckBufferTime, params.GetNoAckBufferTime(hmTypes.RootChainTypeStake, 2*params.AvgCheckpointLength))
+	params.MaxNoAckBufferTime = time.Second
+	require.Equal(t, params.CheckpointBufferTime, params.GetNoAckBufferTime(hmTypes.RootChainTypeStake, 2*params.AvgCheckpointLength))
+	require.Equal(t, params.CheckpointBufferTime, params.GetNoAckBufferTime(hmTypes.RootChainTypeStake, params.AvgCheckpointLength))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	params := keeper.GetParams(ctx)
+
+	keeper.SetUpgradeHeight(ctx, 10)
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	ctx = ctx.WithBlockHeight(9).WithBlockTime(time.Unix(0, 0).Add(params.CheckpointBufferTime))
+
+	result := suite.handler(ctx, types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake))
+	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", result)
+	require.Equal(t, uint64(ctx.BlockTime().Unix()), keeper.GetLastNoAck(ctx))
+
+	// no-ack streak is added by checkpoint upgrade
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(checkpoint.NoAckStreakKey))
+
+	// and so are backup proposers
+	params.BackupProposerNoAcks = 1
+	params.BackupProposerCount = 1
+	keeper.SetParams(ctx, params)
+	store.Set(checkpoint.NoAckStreakKey, []byte("1"))
+	require.Empty(t, keeper.GetBackupProposers(ctx))
+	require.NotEmpty(t, keeper.GetBackupProposers(ctx.WithBlockHeight(10)))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointBackupProposer() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	topupKeeper := app.TopupKeeper
+	params := keeper.GetParams(ctx)
+
+	topupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+
+	chSim.LoadValidatorSet(3, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	params.BackupProposerNoAcks = 2
+	params.BackupProposerCount = 1
+	keeper.SetParams(ctx, params)
+
+	// backup is next proposer in rotation
+	validatorSet := stakingKeeper.GetValidatorSet(ctx)
+	rotation := validatorSet.Copy()
+	rotation.IncrementProposerPriority(1)
+	backup := rotation.GetProposer().Signer
+	require.NotEqual(t, validatorSet.Proposer.Signer, backup)
+
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	header.Proposer = backup
+
+	// backup may not propose before enough no-acks
+	keeper.SetNoAckStreak(ctx, 1)
+	require.Empty(t, keeper.GetBackupProposers(ctx))
+	result := suite.SendCheckpoint(header)
+	require.Equal(t, errs.CodeProposerMismatch, result.Code)
+
+	var proposerMismatch errs.ProposerMismatch
+	require.NoError(t, json.Unmarshal(result.Data, &proposerMismatch))
+	require.Equal(t, errs.ProposerMismatch{Expected: validatorSet.Proposer.Signer, Received: backup}, proposerMismatch)
+
+	keeper.SetNoAckStreak(ctx, 2)
+	backups := keeper.GetBackupProposers(ctx)
+	require.Len(t, backups, 1)
+	require.Equal(t, backup, backups[0].Signer)
+
+	result = suite.SendCheckpoint(header)
+	require.True(t, result.IsOK(), "expected checkpoint of backup proposer to be ok, got %v", result)
+
+	buffered, err := keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	require.Equal(t, backup, buffered.Proposer)
+}
+
 func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sdk.Result) {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	// keeper := app.CheckpointKeeper
 	topupKeeper := app.TopupKeeper
 
 	dividendAccounts := topupKeeper.GetAllDividendAccounts(ctx)
-	accRootHash, err := types.GetAccountRootHash(dividendAccounts)
+	accRootHash, err := types.GetAccountRootHash(dividendAccounts, types.DefaultAccountHashStrategy)
 	require.NoError(t, err)
 	accountRoot := hmTypes.BytesToHeimdallHash(accRootHash)
 
@@ -426,8 +1054,8 @@ func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sd
 		hmTypes.RootChainTypeStake,
 	)
 
-	suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
-	suite.contractCaller.On("GetRootHash", header.StartBlock, header.EndBlock, uint64(1024)).Return(header.RootHash.Bytes(), nil)
+	suite.contractCaller.On("CheckIfBlocksExist", mock.Anything, header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
+	suite.contractCaller.On("GetRootHash", mock.Anything, header.StartBlock, header.EndBlock, uint64(1024)).Return(header.RootHash.Bytes(), nil)
 
 	// send checkpoint to handler
 	result := suite.handler(ctx, msgCheckpoint)
@@ -438,10 +1066,280 @@ func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sd
 
 func (suite *HandlerTestSuite) SendNoAck() (res sdk.Result) {
 	_, _, ctx := suite.T(), suite.app, suite.ctx
-	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"))
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake)
 
 	result := suite.handler(ctx, msgNoAck)
 	sideResult := suite.sideHandler(ctx, msgNoAck)
 	suite.postHandler(ctx, msgNoAck, sideResult.Result)
 	return result
 }
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointSyncGenesisBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SyncGenesisBlocks = []types.RootChainBlock{{RootChain: hmTypes.RootChainTypeEth, Block: 1000}}
+	keeper.SetParams(ctx, params)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+
+	// first sync not starting at sync genesis block
+	msg := types.NewMsgCheckpointSync(proposer, proposer, 1, 0, 255, hmTypes.RootChainTypeEth)
+	result := suite.handler(ctx, msg)
+	require.False(t, result.IsOK(), "expected first sync with wrong start to fail")
+	require.Equal(t, errs.CodeInvalidSyncStart, result.Code)
+
+	// first sync starting at sync genesis block
+	msg = types.NewMsgCheckpointSync(proposer, proposer, 1, 1000, 1255, hmTypes.RootChainTypeEth)
+	result = suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected first sync at sync genesis block to be ok, got %v", result)
+
+	// subsequent sync is not bound to sync genesis block
+	msg = types.NewMsgCheckpointSync(proposer, proposer, 2, 1256, 1511, hmTypes.RootChainTypeEth)
+	result = suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected subsequent sync to be ok, got %v", result)
+
+	// root chain without sync genesis block accepts any start
+	msg = types.NewMsgCheckpointSync(proposer, proposer, 1, 500, 755, hmTypes.RootChainTypeBsc)
+	result = suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected sync without sync genesis block to be ok, got %v", result)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointFirstStartBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+	topupKeeper := app.TopupKeeper
+
+	params := keeper.GetParams(ctx)
+	params.FirstCheckpointStartBlocks = []types.RootChainBlock{{RootChain: hmTypes.RootChainTypeStake, Block: 1000}}
+	keeper.SetParams(ctx, params)
+
+	topupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	proposer := stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	accRootHash, err := types.GetAccountRootHash(topupKeeper.GetAllDividendAccounts(ctx), types.DefaultAccountHashStrategy)
+	require.NoError(t, err)
+	accountRoot := hmTypes.BytesToHeimdallHash(accRootHash)
+
+	// non-zero genesis chain expects configured start, eth defaults to 0
+	_, expectedStart := keeper.GetExpectedCheckpointStart(ctx, hmTypes.RootChainTypeStake)
+	require.Equal(t, uint64(1000), expectedStart)
+	require.Equal(t, uint64(0), keeper.GetFirstCheckpointStart(ctx, hmTypes.RootChainTypeEth))
+
+	// first checkpoint starting at block 0 is rejected
+	msgCheckpoint := types.NewMsgCheckpointBlock(proposer, 0, 255, hmTypes.HexToHeimdallHash("123"), accountRoot, "1234", 1, hmTypes.RootChainTypeStake)
+	result := suite.handler(ctx, msgCheckpoint)
+	require.False(t, result.IsOK(), "expected first checkpoint from block 0 to fail")
+	require.Equal(t, errs.CodeInvalidCheckpointStart, result.Code)
+
+	var startMismatch errs.CheckpointStartMismatch
+	require.NoError(t, json.Unmarshal(result.Data, &startMismatch))
+	require.Equal(t, errs.CheckpointStartMismatch{RootChain: hmTypes.RootChainTypeStake, Expected: 1000, Received: 0}, startMismatch)
+
+	// first checkpoint starting at configured block is accepted
+	msgCheckpoint = types.NewMsgCheckpointBlock(proposer, 1000, 1255, hmTypes.HexToHeimdallHash("123"), accountRoot, "1234", 1, hmTypes.RootChainTypeStake)
+	result = suite.handler(ctx, msgCheckpoint)
+	require.True(t, result.IsOK(), "expected first checkpoint from configured block to be ok, got %v", result)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointRequireAccountRoot() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.RequireAccountRoot = []types.RootChainRequirement{{RootChain: hmTypes.RootChainTypeBsc, Required: false}}
+	keeper.SetParams(ctx, params)
+	require.True(t, params.IsAccountRootRequired(hmTypes.RootChainTypeEth))
+	require.False(t, params.IsAccountRootRequired(hmTypes.RootChainTypeBsc))
+
+	app.TopupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+	proposer := stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	newCheckpoint := func(rootChain string, accountRoot hmTypes.HeimdallHash) types.MsgCheckpoint {
+		_, start := keeper.GetExpectedCheckpointStart(ctx, rootChain)
+		return types.NewMsgCheckpointBlock(proposer, start, start+255, hmTypes.HexToHeimdallHash("123"), accountRoot, "1234", 1, rootChain)
+	}
+
+	// strict chain rejects checkpoint not carrying current account root
+	result := suite.handler(ctx, newCheckpoint(hmTypes.RootChainTypeEth, hmTypes.HeimdallHash{}))
+	require.False(t, result.IsOK(), "expected checkpoint without account root to fail on strict chain")
+	require.Equal(t, errs.CodeAccountRootMismatch, result.Code)
+
+	// current account root is returned, so proposer can tell stale root from wrong dividend accounts
+	var rootMismatch errs.AccountRootMismatch
+	require.NoError(t, json.Unmarshal(result.Data, &rootMismatch))
+	accountRoot, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(accountRoot), rootMismatch.Expected)
+	require.Equal(t, hmTypes.HeimdallHash{}, rootMismatch.Received)
+
+	// relaxed chain doesn't check account root
+	result = suite.handler(ctx, newCheckpoint(hmTypes.RootChainTypeBsc, hmTypes.HeimdallHash{}))
+	require.True(t, result.IsOK(), "expected checkpoint on relaxed chain to be ok, got %v", result)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointPausedRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.PausedRootChains = []string{hmTypes.RootChainTypeEth}
+	keeper.SetParams(ctx, params)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+
+	// paused root chain rejects checkpoint and syncs
+	msgCheckpoint := types.NewMsgCheckpointBlock(proposer, 0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("456"), "1234", 1, hmTypes.RootChainTypeEth)
+	result := suite.handler(ctx, msgCheckpoint)
+	require.False(t, result.IsOK(), "expected checkpoint for paused root chain to fail")
+	require.Equal(t, errs.CodeRootChainPaused, result.Code)
+
+	result = suite.handler(ctx, types.NewMsgCheckpointSync(proposer, proposer, 1, 0, 255, hmTypes.RootChainTypeEth))
+	require.Equal(t, errs.CodeRootChainPaused, result.Code)
+
+	result = suite.handler(ctx, types.NewMsgCheckpointSyncAck(proposer, 1, 0, 255, hmTypes.RootChainTypeEth))
+	require.Equal(t, errs.CodeRootChainPaused, result.Code)
+
+	// other root chains continue normally
+	result = suite.handler(ctx, types.NewMsgCheckpointSync(proposer, proposer, 1, 0, 255, hmTypes.RootChainTypeBsc))
+	require.True(t, result.IsOK(), "expected sync for other root chain to be ok, got %v", result)
+
+	// pause is observed at end block
+	pauseCtx := ctx.WithEventManager(sdk.NewEventManager())
+	keeper.UpdatePausedRootChains(pauseCtx)
+	require.Equal(t, types.EventTypeRootChainPaused, pauseCtx.EventManager().Events()[0].Type)
+	require.Equal(t, []string{hmTypes.RootChainTypeEth}, keeper.GetObservedPausedRootChains(ctx))
+
+	// no events without change
+	noopCtx := ctx.WithEventManager(sdk.NewEventManager())
+	keeper.UpdatePausedRootChains(noopCtx)
+	require.Empty(t, noopCtx.EventManager().Events())
+
+	// unpause
+	params.PausedRootChains = nil
+	keeper.SetParams(ctx, params)
+
+	unpauseCtx := ctx.WithEventManager(sdk.NewEventManager())
+	keeper.UpdatePausedRootChains(unpauseCtx)
+	require.Equal(t, types.EventTypeRootChainUnpaused, unpauseCtx.EventManager().Events()[0].Type)
+	require.Empty(t, keeper.GetObservedPausedRootChains(ctx))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointSyncProposerSignature() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.VerifySyncProposerSignature = true
+	keeper.SetParams(ctx, params)
+
+	privKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	proposer := hmTypes.BytesToHeimdallAddress(ethCrypto.PubkeyToAddress(privKey.PublicKey).Bytes())
+	relayer := hmTypes.HexToHeimdallAddress("123")
+
+	// unsigned sync is rejected
+	msg := types.NewMsgCheckpointSync(relayer, proposer, 1, 0, 255, hmTypes.RootChainTypeEth)
+	result := suite.handler(ctx, msg)
+	require.Equal(t, errs.CodeInvalidProposerSignature, result.Code)
+
+	// sync signed by other key is rejected
+	otherKey, err := ethCrypto.GenerateKey()
+	require.NoError(t, err)
+	msg.ProposerSignature, err = helper.SignDataWithKey(msg.GetProposerSignBytes(), otherKey)
+	require.NoError(t, err)
+	result = suite.handler(ctx, msg)
+	require.Equal(t, errs.CodeInvalidProposerSignature, result.Code)
+
+	// sync ack can't be bound to proposer without sync in buffer
+	msg.ProposerSignature, err = helper.SignDataWithKey(msg.GetProposerSignBytes(), privKey)
+	require.NoError(t, err)
+	ack := types.NewMsgCheckpointSyncAck(proposer, 1, 0, 255, hmTypes.RootChainTypeEth)
+	ack.ProposerSignature, err = helper.SignDataWithKey(ack.GetProposerSignBytes(), privKey)
+	require.NoError(t, err)
+	result = suite.handler(ctx, ack)
+	require.Equal(t, errs.CodeNoCheckpointBuffer, result.Code)
+
+	// sync signed by proposer is accepted even if relayed
+	result = suite.handler(ctx, msg)
+	require.True(t, result.IsOK(), "expected signed sync to be ok, got %v", result)
+
+	syncCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), proposer, "1234", uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, keeper.SetCheckpointSyncBuffer(ctx, syncCheckpoint, hmTypes.RootChainTypeEth))
+
+	// sync signature can't be replayed as sync ack
+	replayed := ack
+	replayed.ProposerSignature = msg.ProposerSignature
+	result = suite.handler(ctx, replayed)
+	require.Equal(t, errs.CodeInvalidProposerSignature, result.Code)
+
+	// relayer signing ack for itself is not proposer of buffered sync
+	relayerAck := types.NewMsgCheckpointSyncAck(hmTypes.BytesToHeimdallAddress(ethCrypto.PubkeyToAddress(otherKey.PublicKey).Bytes()), 1, 0, 255, hmTypes.RootChainTypeEth)
+	relayerAck.ProposerSignature, err = helper.SignDataWithKey(relayerAck.GetProposerSignBytes(), otherKey)
+	require.NoError(t, err)
+	result = suite.handler(ctx, relayerAck)
+	require.Equal(t, errs.CodeInvalidProposerInput, result.Code)
+
+	// ack signed by proposer of buffered sync is accepted
+	result = suite.handler(ctx, ack)
+	require.True(t, result.IsOK(), "expected signed sync ack to be ok, got %v", result)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckEpochDeadline() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	stakingKeeper := app.StakingKeeper
+
+	chSim.LoadValidatorSet(2, t, stakingKeeper, ctx, false, 10)
+	stakingKeeper.IncrementAccum(ctx, 1)
+
+	// deadline must be shorter than buffer time to expedite no-ack
+	params := keeper.GetParams(ctx)
+	params.CheckpointBufferTime = types.DefaultCheckpointBufferTime
+	keeper.SetParams(ctx, params)
+
+	// epoch 2 began right after first checkpoint was acked
+	epochStart := time.Unix(1600000000, 0)
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(epochStart.Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeStake)
+	keeper.SetEpochStartTime(ctx, 2, uint64(epochStart.Unix()))
+
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake)
+	deadline := 100 * time.Second
+	deadlineCtx := ctx.WithBlockTime(epochStart.Add(deadline))
+
+	// without deadline no-ack waits for buffer time
+	result := suite.handler(deadlineCtx, msgNoAck)
+	require.Equal(t, errs.CodeInvalidNoACK, result.Code)
+
+	params.EpochDeadline = deadline
+	keeper.SetParams(ctx, params)
+
+	// past deadline no-ack is expedited
+	result = suite.handler(deadlineCtx, msgNoAck)
+	require.True(t, result.IsOK(), "expected expedited no-ack to be ok, got %v", result)
+
+	var eventTypes []string
+	for _, event := range result.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypeNoAckExpedited)
+
+	// rapid repeat is still rejected
+	result = suite.handler(deadlineCtx.WithBlockTime(epochStart.Add(deadline+time.Second)), msgNoAck)
+	require.Equal(t, errs.CodeTooManyNoAck, result.Code)
+}
@@ -0,0 +1,159 @@
+package checkpoint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers the checkpoint module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ack-count", AckCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "buffer-continuity", BufferContinuityInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tip-monotonicity", TipMonotonicityInvariant(k))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			AckCountInvariant(k),
+			BufferContinuityInvariant(k),
+			TipMonotonicityInvariant(k),
+		} {
+			if res, broken := invariant(ctx); broken {
+				return res, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// AckCountInvariant checks that ack count of each root chain matches number of stored and pruned checkpoints
+func AckCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, rootChain := range invariantRootChains(ctx, k) {
+			ackCount := k.GetACKCount(ctx, rootChain)
+			stored := uint64(len(storedCheckpoints(ctx, k, rootChain)))
+			pruned := k.GetPrunedCheckpoints(ctx, rootChain).Count
+			if ackCount != stored+pruned {
+				count++
+				msg += fmt.Sprintf("\t%s has ack count %d but %d stored and %d pruned checkpoints\n", rootChain, ackCount, stored, pruned)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "ack-count",
+			fmt.Sprintf("root chains with ack count mismatch %d\n%s", count, msg)), count != 0
+	}
+}
+
+// BufferContinuityInvariant checks that buffered checkpoint of each root chain extends its last checkpoint
+func BufferContinuityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, rootChain := range invariantRootChains(ctx, k) {
+			buffer, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+			if err != nil || buffer == nil {
+				continue
+			}
+
+			lastCheckpoint, err := k.GetLastCheckpoint(ctx, rootChain)
+			if err != nil {
+				continue
+			}
+
+			if buffer.StartBlock != lastCheckpoint.EndBlock+1 {
+				count++
+				msg += fmt.Sprintf("\t%s buffer starts at %d but last checkpoint ends at %d\n", rootChain, buffer.StartBlock, lastCheckpoint.EndBlock)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "buffer-continuity",
+			fmt.Sprintf("root chains with discontinuous buffer %d\n%s", count, msg)), count != 0
+	}
+}
+
+// TipMonotonicityInvariant checks that stored checkpoints of each root chain cover
+// strictly increasing block ranges in checkpoint number order
+func TipMonotonicityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, rootChain := range invariantRootChains(ctx, k) {
+			checkpoints := storedCheckpoints(ctx, k, rootChain)
+			for i, checkpoint := range checkpoints {
+				if checkpoint.EndBlock < checkpoint.StartBlock {
+					count++
+					msg += fmt.Sprintf("\t%s checkpoint %d has invalid range %d-%d\n",
+						rootChain, checkpoint.number, checkpoint.StartBlock, checkpoint.EndBlock)
+				}
+				if i > 0 && checkpoint.StartBlock <= checkpoints[i-1].EndBlock {
+					count++
+					msg += fmt.Sprintf("\t%s checkpoint %d starts at %d, not after checkpoint %d ending at %d\n",
+						rootChain, checkpoint.number, checkpoint.StartBlock, checkpoints[i-1].number, checkpoints[i-1].EndBlock)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tip-monotonicity",
+			fmt.Sprintf("non monotonic checkpoints %d\n%s", count, msg)), count != 0
+	}
+}
+
+// invariantRootChains returns root chains checked by invariants in deterministic order
+func invariantRootChains(ctx sdk.Context, k Keeper) []string {
+	registered := k.ck.GetRootChains(ctx)
+	rootChains := make([]string, 0, len(registered))
+	for _, rootChain := range registered {
+		rootChains = append(rootChains, rootChain.RootChainType)
+	}
+	sort.Strings(rootChains)
+	return rootChains
+}
+
+// numberedCheckpoint is a stored checkpoint along with its number
+type numberedCheckpoint struct {
+	hmTypes.Checkpoint
+	number uint64
+}
+
+// storedCheckpoints returns all stored checkpoints of root chain sorted by number.
+// Numbers are stored as decimal strings, so store order is not numeric order.
+func storedCheckpoints(ctx sdk.Context, k Keeper, rootChain string) []numberedCheckpoint {
+	prefix := GetCheckpointKey(0, k.ck.GetRootChainID(ctx, rootChain))
+	prefix = prefix[:len(prefix)-1]
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var checkpoints []numberedCheckpoint
+	for ; iterator.Valid(); iterator.Next() {
+		number, err := strconv.ParseUint(string(iterator.Key()[len(prefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		var checkpoint hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err != nil {
+			continue
+		}
+		checkpoints = append(checkpoints, numberedCheckpoint{Checkpoint: checkpoint, number: number})
+	}
+
+	sort.Slice(checkpoints, func(i, j int) bool {
+		return checkpoints[i].number < checkpoints[j].number
+	})
+	return checkpoints
+}
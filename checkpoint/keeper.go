@@ -1,8 +1,13 @@
 package checkpoint
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +16,7 @@ import (
 	"github.com/maticnetwork/heimdall/chainmanager"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -26,14 +32,39 @@ var (
 	EthCheckpointKey    = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
 
+	CheckpointLifecycleKey    = []byte{0x15} // prefix key for checkpoint lifecycle log entries
+	CheckpointLifecycleSeqKey = []byte{0x16} // key to store next checkpoint lifecycle sequence
+	CheckpointAckKey          = []byte{0x17} // prefix key for ack status of checkpoints
+	CheckpointTimeIndexKey    = []byte{0x18} // prefix key for timestamp index of checkpoints
+	AccountRootKey            = []byte{0x19} // prefix key for persisted dividend account root
+	LastAckNumberKey          = []byte{0x1A} // prefix key for last accepted ack number
+	PausedRootChainsKey       = []byte{0x1B} // key to store paused root chains observed at last end block
+	ProposerRotationKey       = []byte{0x1C} // prefix key for proposer rotation log entries
+	ProposerRotationSeqKey    = []byte{0x1D} // key to store next proposer rotation sequence
+	EpochStartKey             = []byte{0x1E} // prefix key for epoch start times
+	CheckpointPowerKey        = []byte{0x1F} // prefix key for voting power backing last checkpoint
+	HandlerStatsKey           = []byte{0x20} // prefix key for handler heartbeat per message type
+
 	TronCheckpointKey = []byte{0x21} // prefix key for when storing checkpoint after ACK
 	BscCheckpointKey  = []byte{0x22} // prefix key for when storing checkpoint after ACK
 
+	AccountRootDirtyKey  = []byte{0x23} // key set when dividend accounts changed since account root was persisted
+	PrunedCheckpointsKey = []byte{0x24} // prefix key for summary of pruned checkpoints
+	UpgradeHeightKey     = []byte{0x25} // key to store height checkpoint upgrade activates at
+	OtherCheckpointKey   = []byte{0x26} // prefix key for checkpoints of registered root chains, followed by root chain id
+
+	AccountRootDirtyUserKey = []byte{0x27} // prefix key for dividend accounts changed since account tree was updated
+	AccountTreeNodeKey      = []byte{0x28} // prefix key for dividend account tree nodes, followed by root chain id, level and index
+	AccountTreeLeafKey      = []byte{0x29} // prefix key for position of dividend account among account tree leaves
+	AccountTreeSizeKey      = []byte{0x2A} // key to store number of dividend account tree leaves
+
+	NoAckStreakKey = []byte{0x2B} // key to store number of no-acks since last checkpoint ack of stake root chain
 )
 
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []hmTypes.DividendAccount
+	GetDividendAccountByAddress(ctx sdk.Context, address hmTypes.HeimdallAddress) (hmTypes.DividendAccount, error)
 }
 
 // Keeper stores all related data
@@ -51,6 +82,9 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+
+	// checkpoint hooks
+	hooks types.CheckpointHooks
 }
 
 // NewKeeper create new keeper
@@ -75,6 +109,23 @@ func NewKeeper(
 	return keeper
 }
 
+// SetHooks sets checkpoint hooks, must be called once at wiring time
+func (k *Keeper) SetHooks(hooks types.CheckpointHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set checkpoint hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// AfterCheckpointAck calls registered hooks, if any
+func (k Keeper) AfterCheckpointAck(ctx sdk.Context, rootChain string, startBlock uint64, endBlock uint64) {
+	if k.hooks != nil {
+		k.hooks.AfterCheckpointAck(ctx, rootChain, startBlock, endBlock)
+	}
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -87,11 +138,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // AddCheckpoint adds checkpoint into final blocks
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpoint hmTypes.Checkpoint, rootChain string) error {
-	key := GetCheckpointKey(checkpointNumber, rootChain)
+	key := GetCheckpointKey(checkpointNumber, k.ck.GetRootChainID(ctx, rootChain))
 	err := k.addCheckpoint(ctx, key, checkpoint)
 	if err != nil {
 		return err
 	}
+
+	// index checkpoint by timestamp
+	if k.IsUpgradeActive(ctx) {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(GetCheckpointTimeIndexKey(checkpoint.TimeStamp, k.ck.GetRootChainID(ctx, rootChain), checkpointNumber), DefaultValue)
+	}
+
 	k.Logger(ctx).Info("Adding good checkpoint to state",
 		"root", rootChain, "checkpoint", checkpoint, "checkpointNumber", checkpointNumber)
 	return nil
@@ -103,7 +161,7 @@ func getCheckpointBufferKey(rootID byte) []byte {
 
 // SetCheckpointBuffer set Checkpoint Buffer
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
 	err := k.addCheckpoint(ctx, key, checkpoint)
 	if err != nil {
 		return err
@@ -130,19 +188,120 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 
 // GetCheckpointByNumber to get checkpoint by checkpoint number
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64, rootChain string) (hmTypes.Checkpoint, error) {
+	_checkpoint, found, err := k.readCheckpoint(ctx, number, rootChain)
+	if err != nil {
+		return _checkpoint, err
+	}
+	if !found {
+		return _checkpoint, errors.New("Invalid checkpoint Index")
+	}
+	return _checkpoint, nil
+}
+
+// GetCheckpointByBlock returns number and checkpoint of root chain containing block, false if no stored checkpoint contains it.
+// Stored checkpoints cover increasing block ranges in number order, so they are binary searched by number.
+func (k *Keeper) GetCheckpointByBlock(ctx sdk.Context, block uint64, rootChain string) (uint64, hmTypes.Checkpoint, bool) {
+	lo := k.GetPrunedCheckpoints(ctx, rootChain).LastNumber + 1
+	hi := k.GetExpectedAckNumber(ctx, rootChain) - 1
+
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+
+		// numbers skipped by ack tolerance have no checkpoint, use nearest lower one
+		number := mid
+		checkpoint, found, err := k.readCheckpoint(ctx, number, rootChain)
+		for (!found || err != nil) && number > lo {
+			number--
+			checkpoint, found, err = k.readCheckpoint(ctx, number, rootChain)
+		}
+		if !found || err != nil {
+			lo = mid + 1
+			continue
+		}
+
+		switch {
+		case block < checkpoint.StartBlock:
+			hi = number - 1
+		case block > checkpoint.EndBlock:
+			lo = mid + 1
+		default:
+			return number, checkpoint, true
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, false
+}
+
+// readCheckpoint reads checkpoint from store, found is false if checkpoint is not in store
+func (k *Keeper) readCheckpoint(ctx sdk.Context, number uint64, rootChain string) (checkpoint hmTypes.Checkpoint, found bool, err error) {
+	store := ctx.KVStore(k.storeKey)
+	checkpointKey := GetCheckpointKey(number, k.ck.GetRootChainID(ctx, rootChain))
+	if !store.Has(checkpointKey) {
+		return checkpoint, false, nil
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(checkpointKey), &checkpoint); err != nil {
+		return checkpoint, true, err
+	}
+	return checkpoint, true, nil
+}
+
+// GetCheckpointsByNumbers returns checkpoints for given numbers in order, nil for missing ones
+func (k *Keeper) GetCheckpointsByNumbers(ctx sdk.Context, numbers []uint64, rootChain string) []*hmTypes.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
-	var _checkpoint hmTypes.Checkpoint
-	checkpointKey := GetCheckpointKey(number, rootChain)
+	checkpoints := make([]*hmTypes.Checkpoint, len(numbers))
+
+	for i, number := range numbers {
+		bz := store.Get(GetCheckpointKey(number, k.ck.GetRootChainID(ctx, rootChain)))
+		if bz == nil {
+			continue
+		}
+
+		var checkpoint hmTypes.Checkpoint
+		if err := k.cdc.UnmarshalBinaryBare(bz, &checkpoint); err != nil {
+			k.Logger(ctx).Error("Error while unmarshalling checkpoint", "number", number, "root", rootChain, "error", err)
+			continue
+		}
+		checkpoints[i] = &checkpoint
+	}
 
-	if store.Has(checkpointKey) {
-		err := k.cdc.UnmarshalBinaryBare(store.Get(checkpointKey), &_checkpoint)
+	return checkpoints
+}
+
+// IterateCheckpoints calls handler in number order for stored checkpoints of root chain numbered from..to,
+// skipping pruned and missing numbers. Iteration stops when handler returns true.
+func (k *Keeper) IterateCheckpoints(ctx sdk.Context, rootChain string, from uint64, to uint64, handler func(number uint64, checkpoint hmTypes.Checkpoint) (stop bool)) {
+	if pruned := k.GetPrunedCheckpoints(ctx, rootChain).LastNumber; from <= pruned {
+		from = pruned + 1
+	}
+	if tip := k.GetExpectedAckNumber(ctx, rootChain) - 1; to > tip {
+		to = tip
+	}
+
+	for number := from; number <= to && number != 0; number++ {
+		checkpoint, found, err := k.readCheckpoint(ctx, number, rootChain)
 		if err != nil {
-			return _checkpoint, err
-		} else {
-			return _checkpoint, nil
+			k.Logger(ctx).Error("Error while unmarshalling checkpoint", "number", number, "root", rootChain, "error", err)
+			continue
+		}
+		if !found {
+			continue
+		}
+		if handler(number, checkpoint) {
+			return
 		}
 	}
-	return _checkpoint, errors.New("Invalid checkpoint Index")
+}
+
+// HasCheckpoint checks if checkpoint with given number exists for stake root chain
+func (k *Keeper) HasCheckpoint(ctx sdk.Context, number uint64) bool {
+	return k.HasOtherCheckpoint(ctx, hmTypes.RootChainTypeStake, number)
+}
+
+// HasOtherCheckpoint checks if checkpoint with given number exists for root chain
+func (k *Keeper) HasOtherCheckpoint(ctx sdk.Context, rootChain string, number uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetCheckpointKey(number, k.ck.GetRootChainID(ctx, rootChain)))
 }
 
 // GetCheckpointList returns all checkpoints with params like page and limit
@@ -158,13 +317,11 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64, r
 	}
 
 	// get paginated iterator
-	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, EthCheckpointKey, uint(page), uint(limit))
-	switch rootChain {
-	case hmTypes.RootChainTypeTron:
-		iterator = hmTypes.KVStorePrefixIteratorPaginated(store, TronCheckpointKey, uint(page), uint(limit))
-	case hmTypes.RootChainTypeBsc:
-		iterator = hmTypes.KVStorePrefixIteratorPaginated(store, BscCheckpointKey, uint(page), uint(limit))
+	prefix := getCheckpointPrefix(k.ck.GetRootChainID(ctx, rootChain))
+	if prefix == nil {
+		prefix = EthCheckpointKey
 	}
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, prefix, uint(page), uint(limit))
 
 	// loop through validators to get valid validators
 	for ; iterator.Valid(); iterator.Next() {
@@ -179,45 +336,190 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64, r
 
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context, rootChain string) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
 	acksCount := k.GetACKCount(ctx, rootChain)
 
 	lastCheckpointKey := acksCount
 
 	// fetch checkpoint and unmarshall
-	var _checkpoint hmTypes.Checkpoint
+	_checkpoint, found, err := k.readCheckpoint(ctx, lastCheckpointKey, rootChain)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch last checkpoint from store",
+			"root", rootChain, "key", lastCheckpointKey, "acksCount", acksCount)
+		return _checkpoint, err
+	}
 
 	// no checkpoint received
-	// header key
-	headerKey := GetCheckpointKey(lastCheckpointKey, rootChain)
-	if store.Has(headerKey) {
-		err := k.cdc.UnmarshalBinaryBare(store.Get(headerKey), &_checkpoint)
+	if !found {
+		return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
+	}
+	return _checkpoint, nil
+}
+
+// GetMaxCommittedBlock returns highest end block of last checkpoints across root chains,
+// found is false if there are no checkpoints yet
+func (k *Keeper) GetMaxCommittedBlock(ctx sdk.Context) (block uint64, rootChain string, found bool) {
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		root := registered.RootChainType
+		lastCheckpoint, err := k.GetLastCheckpoint(ctx, root)
 		if err != nil {
-			k.Logger(ctx).Error("Unable to fetch last checkpoint from store",
-				"root", rootChain, "key", lastCheckpointKey, "acksCount", acksCount)
-			return _checkpoint, err
+			continue
+		}
+
+		// break ties by root chain name to keep result deterministic
+		if !found || lastCheckpoint.EndBlock > block || (lastCheckpoint.EndBlock == block && root < rootChain) {
+			block, rootChain, found = lastCheckpoint.EndBlock, root, true
+		}
+	}
+
+	return block, rootChain, found
+}
+
+// GetRawCheckpoint returns store key and stored bytes of checkpoint, nil value if it is not stored
+func (k *Keeper) GetRawCheckpoint(ctx sdk.Context, number uint64, rootChain string) (key []byte, value []byte) {
+	store := ctx.KVStore(k.storeKey)
+	key = GetCheckpointKey(number, k.ck.GetRootChainID(ctx, rootChain))
+	return key, store.Get(key)
+}
+
+// GetFirstCheckpointStart returns start block first checkpoint of root chain must have. It is
+// configured start block if any, activation height of root chain otherwise (0 for eth).
+func (k *Keeper) GetFirstCheckpointStart(ctx sdk.Context, rootChain string) uint64 {
+	if startBlock, ok := k.GetParams(ctx).GetFirstCheckpointStartBlock(rootChain); ok {
+		return startBlock
+	}
+	return k.ck.GetChainActivationHeight(ctx, rootChain)
+}
+
+// GetExpectedCheckpointStart returns end block of last checkpoint and start block next checkpoint must have,
+// which is first checkpoint start of root chain if there is no checkpoint yet
+func (k *Keeper) GetExpectedCheckpointStart(ctx sdk.Context, rootChain string) (lastEnd uint64, expectedStart uint64) {
+	lastCheckpoint, err := k.GetLastCheckpoint(ctx, rootChain)
+	if err != nil {
+		return 0, k.GetFirstCheckpointStart(ctx, rootChain)
+	}
+	return lastCheckpoint.EndBlock, lastCheckpoint.EndBlock + 1
+}
+
+// GetNextCheckpointShape returns expected start block, epoch, account root hash and proposer
+// of next checkpoint of root chain
+func (k *Keeper) GetNextCheckpointShape(ctx sdk.Context, rootChain string) (types.NextCheckpointShape, error) {
+	_, startBlock := k.GetExpectedCheckpointStart(ctx, rootChain)
+
+	accountRoot, err := k.GetAccountRoot(ctx, rootChain)
+	if err != nil {
+		return types.NextCheckpointShape{}, err
+	}
+
+	shape := types.NextCheckpointShape{
+		RootChain:       rootChain,
+		StartBlock:      startBlock,
+		Epoch:           k.GetACKCount(ctx, hmTypes.RootChainTypeStake) + 1,
+		AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot),
+	}
+	if proposer := k.sk.GetValidatorSet(ctx).Proposer; proposer != nil {
+		shape.Proposer = proposer.Signer
+	}
+	return shape, nil
+}
+
+// GetCheckpointReadiness runs checks checkpoint handler runs on checkpoint msg of block range, without
+// changing state, and returns account root hash and proposer msg must carry along with failed checks
+func (k *Keeper) GetCheckpointReadiness(ctx sdk.Context, rootChain string, startBlock uint64, endBlock uint64) types.CheckpointReadiness {
+	params := k.GetParams(ctx)
+	upgradeActive := k.IsUpgradeActive(ctx)
+
+	readiness := types.CheckpointReadiness{
+		RootChain:  rootChain,
+		StartBlock: startBlock,
+		EndBlock:   endBlock,
+		Epoch:      k.GetACKCount(ctx, hmTypes.RootChainTypeStake) + 1,
+		BufferFree: true,
+	}
+
+	if upgradeActive && !params.IsRootChainAllowed(rootChain) {
+		readiness.Errors = append(readiness.Errors, "root chain is not enabled")
+	}
+
+	if upgradeActive && params.IsRootChainPaused(rootChain) {
+		readiness.Errors = append(readiness.Errors, "checkpoints are paused for root chain")
+	}
+
+	// buffered checkpoint out of buffer time is flushed by next checkpoint
+	if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, rootChain); err == nil {
+		timeStamp := uint64(ctx.BlockTime().Unix())
+		checkpointBufferTime := uint64(params.GetCheckpointBufferTime(rootChain).Seconds())
+
+		if checkpointBuffer.TimeStamp != 0 && (timeStamp <= checkpointBuffer.TimeStamp || timeStamp-checkpointBuffer.TimeStamp < checkpointBufferTime) {
+			readiness.BufferFree = false
+			readiness.BufferExpiry = checkpointBuffer.TimeStamp + checkpointBufferTime
+			readiness.Errors = append(readiness.Errors, "checkpoint already exists in buffer")
+		}
+	}
+
+	if endBlock < startBlock {
+		readiness.Errors = append(readiness.Errors, "end block is before start block")
+	} else if span := endBlock - startBlock + 1; upgradeActive && span > params.MaxCheckpointLength {
+		readiness.Errors = append(readiness.Errors, fmt.Sprintf("checkpoint span %v exceeds max checkpoint length %v", span, params.MaxCheckpointLength))
+	}
+
+	if lastEnd, expectedStart := k.GetExpectedCheckpointStart(ctx, rootChain); startBlock != expectedStart {
+		if lastEnd > startBlock {
+			readiness.Errors = append(readiness.Errors, fmt.Sprintf("checkpoint already exists up to block %v", lastEnd))
+		} else {
+			readiness.Errors = append(readiness.Errors, fmt.Sprintf("checkpoint must start at block %v", expectedStart))
+		}
+	}
+
+	if !upgradeActive || (rootChain != hmTypes.RootChainTypeTest && params.IsAccountRootRequired(rootChain)) {
+		accountRoot, err := k.GetAccountRoot(ctx, rootChain)
+		if err != nil {
+			readiness.Errors = append(readiness.Errors, fmt.Sprintf("account root hash unavailable: %v", err))
 		} else {
-			return _checkpoint, nil
+			readiness.AccountRootHash = hmTypes.BytesToHeimdallHash(accountRoot)
 		}
 	}
-	return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		readiness.Errors = append(readiness.Errors, "no proposer in validator set")
+	} else {
+		readiness.Proposer = validatorSet.Proposer.Signer
+
+		minPowerFraction := params.MinProposerPowerFraction
+		if upgradeActive && !minPowerFraction.IsNil() && minPowerFraction.IsPositive() &&
+			sdk.NewDec(validatorSet.Proposer.VotingPower).LT(minPowerFraction.MulInt64(validatorSet.TotalVotingPower())) {
+			readiness.Errors = append(readiness.Errors, "proposer voting power below threshold")
+		}
+	}
+
+	readiness.Ready = len(readiness.Errors) == 0
+	return readiness
 }
 
 // GetCheckpointKey appends prefix to checkpointNumber
-func GetCheckpointKey(checkpointNumber uint64, rootChain string) []byte {
-	var key []byte
-	switch rootChain {
-	case hmTypes.RootChainTypeEth:
-		key = EthCheckpointKey
-	case hmTypes.RootChainTypeTron:
-		key = TronCheckpointKey
-	case hmTypes.RootChainTypeBsc:
-		key = BscCheckpointKey
-	}
+func GetCheckpointKey(checkpointNumber uint64, rootID byte) []byte {
+	key := getCheckpointPrefix(rootID)
 	checkpointNumberBytes := []byte(strconv.FormatUint(checkpointNumber, 10))
 	return append(key, checkpointNumberBytes...)
 }
 
+// getCheckpointPrefix returns prefix key of checkpoints of root chain
+func getCheckpointPrefix(rootID byte) []byte {
+	switch rootID {
+	case 0, hmTypes.GetRootChainID(hmTypes.RootChainTypeTest):
+		return nil
+	case hmTypes.GetRootChainID(hmTypes.RootChainTypeEth):
+		return EthCheckpointKey
+	case hmTypes.GetRootChainID(hmTypes.RootChainTypeTron):
+		return TronCheckpointKey
+	case hmTypes.GetRootChainID(hmTypes.RootChainTypeBsc):
+		return BscCheckpointKey
+	}
+
+	// checkpoints of root chains registered with chain manager are keyed by their id
+	return append(append([]byte{}, OtherCheckpointKey...), rootID)
+}
+
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -227,7 +529,7 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context, rootChain string) {
 	store := ctx.KVStore(k.storeKey)
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Delete(key)
 }
 
@@ -237,7 +539,7 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context, rootChain string) (*hm
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
-	key := getCheckpointBufferKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointBufferKey(k.ck.GetRootChainID(ctx, rootChain))
 
 	if store.Has(key) {
 		// Get checkpoint and unmarshall
@@ -248,6 +550,48 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context, rootChain string) (*hm
 	return nil, errors.New("No checkpoint found in buffer")
 }
 
+// GetBufferedRootChains returns root chains which have a checkpoint in buffer, sorted by name
+func (k *Keeper) GetBufferedRootChains(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	rootChains := make([]string, 0)
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		if store.Has(getCheckpointBufferKey(byte(registered.RootChainID))) {
+			rootChains = append(rootChains, registered.RootChainType)
+		}
+	}
+
+	sort.Strings(rootChains)
+	return rootChains
+}
+
+// GetStaleBuffers returns buffered checkpoints which are older than threshold at current block time
+func (k *Keeper) GetStaleBuffers(ctx sdk.Context, threshold time.Duration) []types.StaleBuffer {
+	currentTime := ctx.BlockTime()
+
+	staleBuffers := make([]types.StaleBuffer, 0)
+	for _, rootChain := range k.GetBufferedRootChains(ctx) {
+		checkpoint, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+		if err != nil {
+			k.Logger(ctx).Error("Error while reading checkpoint buffer", "root", rootChain, "error", err)
+			continue
+		}
+
+		age := currentTime.Sub(time.Unix(int64(checkpoint.TimeStamp), 0))
+		if age <= threshold {
+			continue
+		}
+
+		staleBuffers = append(staleBuffers, types.StaleBuffer{
+			RootChain:  rootChain,
+			Checkpoint: *checkpoint,
+			Age:        uint64(age / time.Second),
+		})
+	}
+
+	return staleBuffers
+}
+
 func getCheckpointSyncKey(rootID byte) []byte {
 	return append(BufferCheckpointSyncKey, rootID)
 }
@@ -256,7 +600,7 @@ func getCheckpointSyncKey(rootID byte) []byte {
 func (k *Keeper) SetCheckpointSyncBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpoint, rootChain string) error {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointSyncKey(k.ck.GetRootChainID(ctx, rootChain))
 
 	// create Checkpoint sync and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
@@ -274,7 +618,7 @@ func (k *Keeper) SetCheckpointSyncBuffer(ctx sdk.Context, checkpoint hmTypes.Che
 func (k *Keeper) GetCheckpointSyncFromBuffer(ctx sdk.Context, rootChain string) (*hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointSyncKey(k.ck.GetRootChainID(ctx, rootChain))
 	// checkpoint block header
 	if store.Has(key) {
 		var checkpoint hmTypes.Checkpoint
@@ -290,7 +634,7 @@ func (k *Keeper) GetCheckpointSyncFromBuffer(ctx sdk.Context, rootChain string)
 func (k *Keeper) FlushCheckpointSyncBuffer(ctx sdk.Context, rootChain string) {
 	store := ctx.KVStore(k.storeKey)
 
-	key := getCheckpointSyncKey(hmTypes.GetRootChainID(rootChain))
+	key := getCheckpointSyncKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Delete(key)
 }
 
@@ -317,6 +661,113 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 	return 0
 }
 
+// SetNoAckStreak sets number of no-acks since last checkpoint ack of stake root chain
+func (k *Keeper) SetNoAckStreak(ctx sdk.Context, streak uint64) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if streak == 0 {
+		store.Delete(NoAckStreakKey)
+		return
+	}
+	store.Set(NoAckStreakKey, []byte(strconv.FormatUint(streak, 10)))
+}
+
+// GetNoAckStreak returns number of no-acks since last checkpoint ack of stake root chain
+func (k *Keeper) GetNoAckStreak(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(NoAckStreakKey) {
+		result, err := strconv.ParseUint(string(store.Get(NoAckStreakKey)), 10, 64)
+		if err == nil {
+			return result
+		}
+	}
+	return 0
+}
+
+// GetBackupProposers returns validators allowed to propose checkpoint besides current proposer. Once
+// BackupProposerNoAcks no-acks in a row rotated proposers without checkpoint ack, BackupProposerCount
+// validators next in proposer rotation may propose in place of offline proposers. There are no backup
+// proposers before checkpoint upgrade.
+func (k *Keeper) GetBackupProposers(ctx sdk.Context) []hmTypes.Validator {
+	if !k.IsUpgradeActive(ctx) {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.BackupProposersActive(k.GetNoAckStreak(ctx)) {
+		return nil
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		return nil
+	}
+
+	count := int(params.BackupProposerCount)
+	if count > len(validatorSet.Validators)-1 {
+		count = len(validatorSet.Validators) - 1
+	}
+
+	// next proposers in rotation, same order proposers query returns them in
+	rotation := validatorSet.Copy()
+	backups := make([]hmTypes.Validator, 0, count)
+	for len(backups) < count {
+		rotation.IncrementProposerPriority(1)
+		backups = append(backups, *rotation.GetProposer())
+	}
+	return backups
+}
+
+func getLastNoAckKey(rootID byte) []byte {
+	return append(LastNoACKKey, rootID)
+}
+
+// SetLastNoAckByRootChain set last no-ack for root chain
+func (k *Keeper) SetLastNoAckByRootChain(ctx sdk.Context, rootChain string, timestamp uint64) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	// convert timestamp to bytes
+	value := []byte(strconv.FormatUint(timestamp, 10))
+	// set no-ack
+	store.Set(getLastNoAckKey(k.ck.GetRootChainID(ctx, rootChain)), value)
+}
+
+// GetLastNoAckByRootChain returns last no ack for root chain,
+// stake root chain falls back to global last no ack if it has no own value
+func (k *Keeper) GetLastNoAckByRootChain(ctx sdk.Context, rootChain string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := getLastNoAckKey(k.ck.GetRootChainID(ctx, rootChain))
+	if store.Has(key) {
+		result, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
+		if err == nil {
+			return result
+		}
+	}
+
+	if rootChain == hmTypes.RootChainTypeStake {
+		return k.GetLastNoAck(ctx)
+	}
+	return 0
+}
+
+// MigrateLastNoAck seeds stake root chain last no-ack from global last no-ack,
+// which no-acks were recorded in before per root chain values
+func (k *Keeper) MigrateLastNoAck(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	key := getLastNoAckKey(hmTypes.GetRootChainID(hmTypes.RootChainTypeStake))
+	if store.Has(key) || !store.Has(LastNoACKKey) {
+		return
+	}
+
+	k.SetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake, k.GetLastNoAck(ctx))
+}
+
 // GetCheckpoints get checkpoint all checkpoints
 func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
@@ -341,11 +792,13 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 func (k *Keeper) GetOtherCheckpoints(ctx sdk.Context, rootChain string) []hmTypes.Checkpoint {
 	store := ctx.KVStore(k.storeKey)
 	// get checkpoint header iterator
-	var iterator sdk.Iterator
-	if hmTypes.RootChainTypeTron == rootChain {
-		iterator = sdk.KVStorePrefixIterator(store, TronCheckpointKey)
-		defer iterator.Close()
+	prefix := getCheckpointPrefix(k.ck.GetRootChainID(ctx, rootChain))
+	if prefix == nil {
+		return nil
 	}
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
 	// create headers
 	var headers []hmTypes.Checkpoint
 
@@ -359,6 +812,735 @@ func (k *Keeper) GetOtherCheckpoints(ctx sdk.Context, rootChain string) []hmType
 	return headers
 }
 
+//
+// Checkpoint time index
+//
+
+// GetCheckpointTimeIndexKey returns time index key: prefix | timestamp | root chain | checkpoint number
+func GetCheckpointTimeIndexKey(timestamp uint64, rootID byte, checkpointNumber uint64) []byte {
+	key := make([]byte, 0, len(CheckpointTimeIndexKey)+17)
+	key = append(key, CheckpointTimeIndexKey...)
+	key = append(key, sdk.Uint64ToBigEndian(timestamp)...)
+	key = append(key, rootID)
+	return append(key, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// GetCheckpointsByTimeRange returns checkpoints with timestamp in [from, to], optionally filtered by root chain
+func (k *Keeper) GetCheckpointsByTimeRange(ctx sdk.Context, from uint64, to uint64, rootChain string, page uint64, limit uint64) []types.RootChainCheckpoint {
+	store := ctx.KVStore(k.storeKey)
+
+	// have max limit
+	if limit > 20 {
+		limit = 20
+	}
+
+	if page == 0 || limit == 0 || from > to {
+		return nil
+	}
+
+	start := append(append([]byte{}, CheckpointTimeIndexKey...), sdk.Uint64ToBigEndian(from)...)
+	end := sdk.PrefixEndBytes(append(append([]byte{}, CheckpointTimeIndexKey...), sdk.Uint64ToBigEndian(to)...))
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	skip := (page - 1) * limit
+
+	rootChainNames := make(map[byte]string)
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		rootChainNames[byte(registered.RootChainID)] = registered.RootChainType
+	}
+
+	var checkpoints []types.RootChainCheckpoint
+	for ; iterator.Valid() && uint64(len(checkpoints)) < limit; iterator.Next() {
+		key := iterator.Key()[len(CheckpointTimeIndexKey):]
+		if len(key) != 17 {
+			continue
+		}
+
+		timestamp := binary.BigEndian.Uint64(key[:8])
+		number := binary.BigEndian.Uint64(key[9:])
+
+		chain, ok := rootChainNames[key[8]]
+		if !ok || (rootChain != "" && chain != rootChain) {
+			continue
+		}
+
+		// skip stale index entries
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number, chain)
+		if err != nil || checkpoint.TimeStamp != timestamp {
+			continue
+		}
+
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		checkpoints = append(checkpoints, types.RootChainCheckpoint{
+			Number:     number,
+			RootChain:  chain,
+			Checkpoint: checkpoint,
+		})
+	}
+
+	return checkpoints
+}
+
+// BackfillCheckpointTimeIndex indexes checkpoints stored before time index was maintained
+func (k *Keeper) BackfillCheckpointTimeIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		rootChain, rootID := registered.RootChainType, byte(registered.RootChainID)
+		prefix := getCheckpointPrefix(rootID)
+
+		// collect keys first, store is not written while iterating
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			number, err := strconv.ParseUint(string(iterator.Key()[len(prefix):]), 10, 64)
+			if err != nil {
+				continue
+			}
+
+			var checkpoint hmTypes.Checkpoint
+			if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &checkpoint); err != nil {
+				continue
+			}
+
+			keys = append(keys, GetCheckpointTimeIndexKey(checkpoint.TimeStamp, rootID, number))
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Set(key, DefaultValue)
+		}
+	}
+}
+
+//
+// Checkpoint ack status
+//
+
+// GetCheckpointAckKey appends prefix and root chain id to checkpointNumber
+func GetCheckpointAckKey(checkpointNumber uint64, rootID byte) []byte {
+	key := append(CheckpointAckKey, rootID)
+	return append(key, []byte(strconv.FormatUint(checkpointNumber, 10))...)
+}
+
+// SetCheckpointAcked records ack count at which checkpoint was acked
+func (k *Keeper) SetCheckpointAcked(ctx sdk.Context, checkpointNumber uint64, rootChain string, ackNumber uint64) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointAckKey(checkpointNumber, k.ck.GetRootChainID(ctx, rootChain)), []byte(strconv.FormatUint(ackNumber, 10)))
+}
+
+// GetCheckpointAckStatus returns whether checkpoint is acked and ack count at which it was acked.
+// Checkpoints acked before ack status was recorded are derived from stored checkpoints,
+// those were acked strictly in order so ack count equals checkpoint number.
+func (k *Keeper) GetCheckpointAckStatus(ctx sdk.Context, checkpointNumber uint64, rootChain string) (bool, uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetCheckpointAckKey(checkpointNumber, k.ck.GetRootChainID(ctx, rootChain))
+	if store.Has(key) {
+		ackNumber, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
+		if err == nil {
+			return true, ackNumber
+		}
+	}
+
+	if checkpointNumber == 0 || checkpointNumber > k.GetACKCount(ctx, rootChain) {
+		return false, 0
+	}
+	if checkpointNumber <= k.GetPrunedCheckpoints(ctx, rootChain).LastNumber {
+		return true, checkpointNumber
+	}
+	if _, err := k.GetCheckpointByNumber(ctx, checkpointNumber, rootChain); err != nil {
+		return false, 0
+	}
+	return true, checkpointNumber
+}
+
+//
+// Ack number
+//
+
+// GetLastAckNumberKey appends prefix to root chain id
+func GetLastAckNumberKey(rootID byte) []byte {
+	return append(LastAckNumberKey, rootID)
+}
+
+// SetLastAckNumber records last accepted ack number for root chain
+func (k *Keeper) SetLastAckNumber(ctx sdk.Context, rootChain string, number uint64) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetLastAckNumberKey(k.ck.GetRootChainID(ctx, rootChain)), sdk.Uint64ToBigEndian(number))
+}
+
+// GetExpectedAckNumber returns number next ack must have for root chain.
+// It follows last accepted ack number, ack count before any number is recorded.
+func (k *Keeper) GetExpectedAckNumber(ctx sdk.Context, rootChain string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(GetLastAckNumberKey(k.ck.GetRootChainID(ctx, rootChain))); bz != nil {
+		return binary.BigEndian.Uint64(bz) + 1
+	}
+	return k.GetACKCount(ctx, rootChain) + 1
+}
+
+//
+// Paused root chains
+//
+
+// GetObservedPausedRootChains returns paused root chains observed at last end block
+func (k *Keeper) GetObservedPausedRootChains(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+
+	var rootChains []string
+	if bz := store.Get(PausedRootChainsKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &rootChains)
+	}
+	return rootChains
+}
+
+// UpdatePausedRootChains compares paused root chains in params with last observed ones
+// and emits pause/unpause events for changed root chains
+func (k *Keeper) UpdatePausedRootChains(ctx sdk.Context) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	observed := k.GetObservedPausedRootChains(ctx)
+
+	observedSet := make(map[string]bool, len(observed))
+	for _, rootChain := range observed {
+		observedSet[rootChain] = true
+	}
+
+	changed := false
+	for _, rootChain := range params.PausedRootChains {
+		if !observedSet[rootChain] {
+			changed = true
+			k.Logger(ctx).Info("Checkpoints paused for root chain", "root", rootChain)
+			ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+				params.EventTypeMode, types.EventTypeRootChainPaused, rootChain,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+			))
+		}
+	}
+
+	for _, rootChain := range observed {
+		if !params.IsRootChainPaused(rootChain) {
+			changed = true
+			k.Logger(ctx).Info("Checkpoints unpaused for root chain", "root", rootChain)
+			ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+				params.EventTypeMode, types.EventTypeRootChainUnpaused, rootChain,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyRootChain, rootChain),
+			))
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(params.PausedRootChains) == 0 {
+		store.Delete(PausedRootChainsKey)
+		return
+	}
+	store.Set(PausedRootChainsKey, k.cdc.MustMarshalBinaryBare(params.PausedRootChains))
+}
+
+//
+// Account root
+//
+
+// GetAccountRootKey appends prefix to root chain id
+func GetAccountRootKey(rootID byte) []byte {
+	return append(AccountRootKey, rootID)
+}
+
+// computeAccountRoot computes dividend account root for root chain from current accounts, without account tree
+func (k *Keeper) computeAccountRoot(ctx sdk.Context, rootChain string) ([]byte, error) {
+	dividendAccounts := k.moduleCommunicator.GetAllDividendAccounts(ctx)
+
+	// leaves and about as many inner nodes
+	k.consumeAccountHashGas(ctx, 2*len(dividendAccounts))
+	return types.GetAccountRootHash(dividendAccounts, types.GetAccountHashStrategy(rootChain))
+}
+
+// MarkAccountRootDirty flags persisted account root as stale. It is called whenever dividend
+// accounts change, root is then updated once at end block or on first read. Changed accounts already
+// in account tree have their tree path rehashed only, changes without accounts rebuild the tree.
+func (k *Keeper) MarkAccountRootDirty(ctx sdk.Context, users ...hmTypes.HeimdallAddress) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(users) == 0 {
+		store.Set(AccountRootDirtyKey, accountRootRebuild)
+		return
+	}
+
+	if !store.Has(AccountRootDirtyKey) {
+		store.Set(AccountRootDirtyKey, accountRootIncremental)
+	}
+	for _, user := range users {
+		store.Set(GetAccountRootDirtyUserKey(user), DefaultValue)
+	}
+}
+
+// UpdateAccountRootIfDirty updates account root if dividend accounts changed since it was persisted
+func (k *Keeper) UpdateAccountRootIfDirty(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	dirty := store.Get(AccountRootDirtyKey)
+	if dirty == nil {
+		return
+	}
+
+	if bytes.Equal(dirty, accountRootIncremental) && k.updateAccountTree(ctx) {
+		return
+	}
+
+	k.UpdateAccountRoot(ctx)
+}
+
+// UpdateAccountRoot rebuilds dividend account tree and persists its root for every root chain.
+// Root chain without root (no dividend accounts) has nothing persisted.
+func (k *Keeper) UpdateAccountRoot(ctx sdk.Context) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	k.rebuildAccountTree(ctx)
+}
+
+// GetAccountRoot returns persisted dividend account root for root chain, computing it if not persisted yet
+func (k *Keeper) GetAccountRoot(ctx sdk.Context, rootChain string) ([]byte, error) {
+	k.UpdateAccountRootIfDirty(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	accountRoot := store.Get(GetAccountRootKey(k.ck.GetRootChainID(ctx, rootChain)))
+	if accountRoot == nil {
+		return k.computeAccountRoot(ctx, rootChain)
+	}
+
+	// catch drift between persisted and current account root. Check is enabled per node, so its
+	// store reads and hashing must not be charged to tx
+	if helper.GetConfig().AccountRootSelfCheck {
+		currentRoot, err := k.computeAccountRoot(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), rootChain)
+		if err != nil {
+			k.Logger(ctx).Error("Error while computing account root hash", "root", rootChain, "error", err)
+		} else if !bytes.Equal(accountRoot, currentRoot) {
+			k.Logger(ctx).Error("Persisted account root doesn't match current dividend accounts",
+				"root", rootChain,
+				"persisted", hmTypes.BytesToHeimdallHash(accountRoot).String(),
+				"current", hmTypes.BytesToHeimdallHash(currentRoot).String())
+		}
+	}
+
+	return accountRoot, nil
+}
+
+//
+// Checkpoint lifecycle log
+//
+
+// GetCheckpointLifecycleKey appends prefix to lifecycle sequence
+func GetCheckpointLifecycleKey(sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(CheckpointLifecycleKey, sequenceBytes...)
+}
+
+// AppendCheckpointLifecycle appends lifecycle transition to log and prunes entries out of retention window
+func (k *Keeper) AppendCheckpointLifecycle(ctx sdk.Context, transition string, rootChain string, startBlock uint64, endBlock uint64) {
+	retention := k.GetParams(ctx).CheckpointLifecycleRetention
+	if retention == 0 || !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if store.Has(CheckpointLifecycleSeqKey) {
+		sequence = binary.BigEndian.Uint64(store.Get(CheckpointLifecycleSeqKey))
+	}
+
+	entry := types.CheckpointLifecycleEntry{
+		Sequence:   sequence,
+		Transition: transition,
+		RootChain:  rootChain,
+		StartBlock: startBlock,
+		EndBlock:   endBlock,
+		TimeStamp:  uint64(ctx.BlockTime().Unix()),
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(entry)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling checkpoint lifecycle entry", "error", err)
+		return
+	}
+
+	store.Set(GetCheckpointLifecycleKey(sequence), out)
+
+	nextSequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextSequence, sequence+1)
+	store.Set(CheckpointLifecycleSeqKey, nextSequence)
+
+	// prune entries older than retention window
+	if sequence+1 > retention {
+		iterator := store.Iterator(CheckpointLifecycleKey, GetCheckpointLifecycleKey(sequence+1-retention))
+		var staleKeys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range staleKeys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetCheckpointLifecycle returns lifecycle log entries with params like page and limit
+func (k *Keeper) GetCheckpointLifecycle(ctx sdk.Context, page uint64, limit uint64) []types.CheckpointLifecycleEntry {
+	store := ctx.KVStore(k.storeKey)
+
+	// have max limit
+	if limit > 20 {
+		limit = 20
+	}
+
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, CheckpointLifecycleKey, uint(page), uint(limit))
+
+	var entries []types.CheckpointLifecycleEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.CheckpointLifecycleEntry
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+//
+// Epoch start
+//
+
+// GetEpochStartKey appends prefix to epoch
+func GetEpochStartKey(epoch uint64) []byte {
+	return append(EpochStartKey, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// SetEpochStartTime records time epoch began
+func (k *Keeper) SetEpochStartTime(ctx sdk.Context, epoch uint64, timestamp uint64) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetEpochStartKey(epoch), sdk.Uint64ToBigEndian(timestamp))
+}
+
+// GetEpochStartTime returns time epoch began, false if it was not recorded
+func (k *Keeper) GetEpochStartTime(ctx sdk.Context, epoch uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetEpochStartKey(epoch))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+//
+// Checkpoint power
+//
+
+// GetCheckpointPowerKey appends prefix to root chain id
+func GetCheckpointPowerKey(rootID byte) []byte {
+	return append(CheckpointPowerKey, rootID)
+}
+
+// SetCheckpointPower captures voting power of proposer and validator set at the time checkpoint is acked
+func (k *Keeper) SetCheckpointPower(ctx sdk.Context, number uint64, rootChain string, proposer hmTypes.HeimdallAddress) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	validatorSet := k.sk.GetValidatorSet(ctx)
+
+	power := types.CheckpointPower{
+		Number:     number,
+		Proposer:   proposer,
+		TotalPower: validatorSet.TotalVotingPower(),
+	}
+	if _, validator := validatorSet.GetByAddress(proposer.Bytes()); validator != nil {
+		power.ProposerPower = validator.VotingPower
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(power)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling checkpoint power", "root", rootChain, "error", err)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointPowerKey(k.ck.GetRootChainID(ctx, rootChain)), out)
+}
+
+// GetCheckpointPower returns voting power backing last checkpoint of root chain, false if it is not recorded
+func (k *Keeper) GetCheckpointPower(ctx sdk.Context, rootChain string) (types.CheckpointPower, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var power types.CheckpointPower
+	bz := store.Get(GetCheckpointPowerKey(k.ck.GetRootChainID(ctx, rootChain)))
+	if bz == nil {
+		return power, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &power); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling checkpoint power", "root", rootChain, "error", err)
+		return power, false
+	}
+	return power, true
+}
+
+//
+// Checkpoint pruning
+//
+
+// maxCheckpointsPrunedPerBlock bounds checkpoint numbers scanned for pruning in one block
+const maxCheckpointsPrunedPerBlock = 100
+
+// GetPrunedCheckpointsKey appends prefix to root chain id
+func GetPrunedCheckpointsKey(rootID byte) []byte {
+	return append(PrunedCheckpointsKey, rootID)
+}
+
+// SetPrunedCheckpoints stores summary of pruned checkpoints of root chain
+func (k *Keeper) SetPrunedCheckpoints(ctx sdk.Context, pruned types.PrunedCheckpoints) {
+	out, err := k.cdc.MarshalBinaryBare(pruned)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pruned checkpoints", "root", pruned.RootChain, "error", err)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetPrunedCheckpointsKey(k.ck.GetRootChainID(ctx, pruned.RootChain)), out)
+}
+
+// GetPrunedCheckpoints returns summary of pruned checkpoints of root chain, empty one if none is pruned
+func (k *Keeper) GetPrunedCheckpoints(ctx sdk.Context, rootChain string) types.PrunedCheckpoints {
+	store := ctx.KVStore(k.storeKey)
+
+	pruned := types.PrunedCheckpoints{RootChain: rootChain}
+	bz := store.Get(GetPrunedCheckpointsKey(k.ck.GetRootChainID(ctx, rootChain)))
+	if bz == nil {
+		return pruned
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &pruned); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling pruned checkpoints", "root", rootChain, "error", err)
+		return types.PrunedCheckpoints{RootChain: rootChain}
+	}
+	return pruned
+}
+
+// PruneCheckpoints deletes checkpoints older than retention epochs from store,
+// folding them into rolling commitment of pruned checkpoints
+func (k *Keeper) PruneCheckpoints(ctx sdk.Context) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	retention := k.GetParams(ctx).CheckpointRetention
+	if retention == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, registered := range k.ck.GetRootChains(ctx) {
+		rootChain, rootID := registered.RootChainType, byte(registered.RootChainID)
+		tip := k.GetExpectedAckNumber(ctx, rootChain) - 1
+		if tip <= retention {
+			continue
+		}
+
+		pruned := k.GetPrunedCheckpoints(ctx, rootChain)
+		cutoff := tip - retention
+		if pruned.LastNumber >= cutoff {
+			continue
+		}
+		if cutoff-pruned.LastNumber > maxCheckpointsPrunedPerBlock {
+			cutoff = pruned.LastNumber + maxCheckpointsPrunedPerBlock
+		}
+
+		for number := pruned.LastNumber + 1; number <= cutoff; number++ {
+			// numbers skipped by ack tolerance have no checkpoint
+			checkpoint, found, err := k.readCheckpoint(ctx, number, rootChain)
+			if err != nil || !found {
+				continue
+			}
+
+			pruned = pruned.Extend(number, checkpoint)
+			store.Delete(GetCheckpointKey(number, rootID))
+			store.Delete(GetCheckpointTimeIndexKey(checkpoint.TimeStamp, rootID, number))
+		}
+		pruned.LastNumber = cutoff
+
+		k.SetPrunedCheckpoints(ctx, pruned)
+		k.Logger(ctx).Debug("Pruned checkpoints", "root", rootChain, "upTo", cutoff, "count", pruned.Count)
+	}
+}
+
+//
+// Handler stats
+//
+
+// GetHandlerStatsKey appends prefix to message type
+func GetHandlerStatsKey(msgType string) []byte {
+	return append(HandlerStatsKey, []byte(msgType)...)
+}
+
+// RecordHandlerSuccess increments success counter of message type and records current height
+func (k *Keeper) RecordHandlerSuccess(ctx sdk.Context, msgType string) {
+	if !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	stats := types.HandlerStats{MsgType: msgType}
+	if bz := store.Get(GetHandlerStatsKey(msgType)); bz != nil {
+		if err := k.cdc.UnmarshalBinaryBare(bz, &stats); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling handler stats", "msgType", msgType, "error", err)
+		}
+	}
+
+	stats.SuccessCount++
+	stats.LastHeight = ctx.BlockHeight()
+
+	out, err := k.cdc.MarshalBinaryBare(stats)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling handler stats", "msgType", msgType, "error", err)
+		return
+	}
+	store.Set(GetHandlerStatsKey(msgType), out)
+}
+
+// GetHandlerStats returns handler stats of all message types processed successfully at least once
+func (k *Keeper) GetHandlerStats(ctx sdk.Context) []types.HandlerStats {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, HandlerStatsKey)
+	defer iterator.Close()
+
+	stats := []types.HandlerStats{}
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.HandlerStats
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &entry); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling handler stats", "error", err)
+			continue
+		}
+		stats = append(stats, entry)
+	}
+	return stats
+}
+
+//
+// Proposer rotation log
+//
+
+// GetProposerRotationKey appends prefix to rotation sequence
+func GetProposerRotationKey(sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(ProposerRotationKey, sequenceBytes...)
+}
+
+// AppendProposerRotation appends proposer rotation caused by no-ack of root chain to log and prunes
+// entries out of retention window
+func (k *Keeper) AppendProposerRotation(ctx sdk.Context, rootChain string, oldProposer hmTypes.HeimdallAddress, newProposer hmTypes.HeimdallAddress) {
+	retention := k.GetParams(ctx).ProposerRotationRetention
+	if retention == 0 || !k.IsUpgradeActive(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if store.Has(ProposerRotationSeqKey) {
+		sequence = binary.BigEndian.Uint64(store.Get(ProposerRotationSeqKey))
+	}
+
+	entry := types.ProposerRotationEntry{
+		Sequence:    sequence,
+		OldProposer: oldProposer,
+		NewProposer: newProposer,
+		TimeStamp:   uint64(ctx.BlockTime().Unix()),
+		RootChain:   rootChain,
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(entry)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling proposer rotation entry", "error", err)
+		return
+	}
+
+	store.Set(GetProposerRotationKey(sequence), out)
+
+	nextSequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextSequence, sequence+1)
+	store.Set(ProposerRotationSeqKey, nextSequence)
+
+	// prune entries older than retention window
+	if sequence+1 > retention {
+		iterator := store.Iterator(ProposerRotationKey, GetProposerRotationKey(sequence+1-retention))
+		var staleKeys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range staleKeys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetProposerRotations returns proposer rotation log entries with params like page and limit
+func (k *Keeper) GetProposerRotations(ctx sdk.Context, page uint64, limit uint64) []types.ProposerRotationEntry {
+	store := ctx.KVStore(k.storeKey)
+
+	// have max limit
+	if limit > 20 {
+		limit = 20
+	}
+
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, ProposerRotationKey, uint(page), uint(limit))
+
+	var entries []types.ProposerRotationEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ProposerRotationEntry
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
 //
 // Ack count
 //
@@ -370,7 +1552,7 @@ func GetAckCountKey(rootID byte) []byte {
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context, rootChain string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	// checkpoint block header
 	if store.Has(key) {
 		// check if ack count is there
@@ -393,7 +1575,7 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64, rootChain
 	ackCount := []byte(strconv.FormatUint(value, 10))
 
 	// update
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Set(key, ackCount)
 }
 
@@ -407,7 +1589,7 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context, rootChain string) {
 	// increment by 1
 	ACKs := []byte(strconv.FormatUint(ACKCount+1, 10))
 	// update
-	key := GetAckCountKey(hmTypes.GetRootChainID(rootChain))
+	key := GetAckCountKey(k.ck.GetRootChainID(ctx, rootChain))
 	store.Set(key, ACKs)
 
 }
@@ -420,8 +1602,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. Params added after chain start
+// may be missing in store until upgrade migration, their defaults are used then.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
 	return
 }
@@ -1,13 +1,20 @@
 package checkpoint_test
 
 import (
+	"crypto/sha256"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	cmTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/params/subspace"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +68,62 @@ func (suite *KeeperTestSuite) TestAddCheckpoint() {
 	require.Equal(t, timestamp, result.TimeStamp)
 }
 
+func (suite *KeeperTestSuite) TestHasCheckpoint() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	headerBlockNumber := uint64(2000)
+	checkpoint := hmTypes.CreateBlock(
+		uint64(0),
+		uint64(256),
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+
+	require.False(t, keeper.HasCheckpoint(ctx, headerBlockNumber))
+
+	err := keeper.AddCheckpoint(ctx, headerBlockNumber, checkpoint, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	require.True(t, keeper.HasCheckpoint(ctx, headerBlockNumber))
+	require.True(t, keeper.HasOtherCheckpoint(ctx, hmTypes.RootChainTypeStake, headerBlockNumber))
+	require.False(t, keeper.HasOtherCheckpoint(ctx, hmTypes.RootChainTypeBsc, headerBlockNumber))
+}
+
+func (suite *KeeperTestSuite) TestRegisteredRootChainCheckpoint() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	rootChain := "keeper-test"
+	rootChainID := uint64(0x20)
+	params := cmTypes.DefaultParamsWithMultiChains()
+	params.ChainParameterMap[rootChain] = cmTypes.ChainData{RootChainID: &rootChainID}
+	app.ChainKeeper.SetParamsWithMultiChain(ctx, params)
+
+	checkpoint := hmTypes.CreateBlock(
+		uint64(0),
+		uint64(256),
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, rootChain))
+
+	// checkpoints of registered root chain don't collide with built in ones
+	require.True(t, ctx.KVStore(app.GetKey(types.StoreKey)).Has(append([]byte{0x26, byte(rootChainID)}, []byte("1")...)))
+	require.True(t, keeper.HasOtherCheckpoint(ctx, rootChain, 1))
+	require.False(t, keeper.HasOtherCheckpoint(ctx, hmTypes.RootChainTypeEth, 1))
+	require.Len(t, keeper.GetOtherCheckpoints(ctx, rootChain), 1)
+
+	keeper.UpdateACKCountWithValue(ctx, 1, rootChain)
+	block, committedRoot, found := keeper.GetMaxCommittedBlock(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(256), block)
+	require.Equal(t, rootChain, committedRoot)
+}
+
 func (suite *KeeperTestSuite) TestGetCheckpointList() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.CheckpointKeeper
@@ -114,3 +177,463 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestCheckpointLifecycle() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.CheckpointLifecycleRetention = 3
+	keeper.SetParams(ctx, params)
+
+	for i := uint64(0); i < 5; i++ {
+		keeper.AppendCheckpointLifecycle(ctx, types.LifecycleBuffered, hmTypes.RootChainTypeStake, i*256, (i+1)*256-1)
+	}
+
+	entries := keeper.GetCheckpointLifecycle(ctx, 1, 10)
+	require.Len(t, entries, 3, "entries out of retention window should be pruned")
+	require.Equal(t, uint64(2), entries[0].Sequence)
+	require.Equal(t, uint64(4), entries[2].Sequence)
+	require.Equal(t, types.LifecycleBuffered, entries[2].Transition)
+	require.Equal(t, hmTypes.RootChainTypeStake, entries[2].RootChain)
+	require.Equal(t, uint64(4*256), entries[2].StartBlock)
+
+	entries = keeper.GetCheckpointLifecycle(ctx, 2, 2)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(4), entries[0].Sequence)
+
+	// zero retention disables log
+	params.CheckpointLifecycleRetention = 0
+	keeper.SetParams(ctx, params)
+	keeper.AppendCheckpointLifecycle(ctx, types.LifecycleAcked, hmTypes.RootChainTypeStake, 0, 255)
+	require.Len(t, keeper.GetCheckpointLifecycle(ctx, 1, 10), 3)
+}
+
+func (suite *KeeperTestSuite) TestProposerRotations() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.ProposerRotationRetention = 2
+	keeper.SetParams(ctx, params)
+
+	proposers := []hmTypes.HeimdallAddress{
+		hmTypes.HexToHeimdallAddress("1"),
+		hmTypes.HexToHeimdallAddress("2"),
+		hmTypes.HexToHeimdallAddress("3"),
+		hmTypes.HexToHeimdallAddress("4"),
+	}
+	for i := 1; i < len(proposers); i++ {
+		keeper.AppendProposerRotation(ctx, hmTypes.RootChainTypeEth, proposers[i-1], proposers[i])
+	}
+
+	rotations := keeper.GetProposerRotations(ctx, 1, 10)
+	require.Len(t, rotations, 2, "rotations out of retention window should be pruned")
+	require.Equal(t, uint64(1), rotations[0].Sequence)
+	require.Equal(t, proposers[2], rotations[1].OldProposer)
+	require.Equal(t, proposers[3], rotations[1].NewProposer)
+	require.Equal(t, hmTypes.RootChainTypeEth, rotations[1].RootChain)
+}
+
+func (suite *KeeperTestSuite) TestLastNoAckByRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	require.Equal(t, uint64(0), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake))
+
+	// stake root chain falls back to global value, other chains don't
+	keeper.SetLastNoAck(ctx, 100)
+	require.Equal(t, uint64(100), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake))
+	require.Equal(t, uint64(0), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeEth))
+	require.Equal(t, uint64(0), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeBsc))
+
+	keeper.SetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeBsc, 200)
+	require.Equal(t, uint64(200), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeBsc))
+
+	// migration seeds stake root chain key from global value
+	keeper.MigrateLastNoAck(ctx)
+	keeper.SetLastNoAck(ctx, 300)
+	require.Equal(t, uint64(100), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake))
+
+	// migration doesn't overwrite existing stake root chain value
+	keeper.MigrateLastNoAck(ctx)
+	require.Equal(t, uint64(100), keeper.GetLastNoAckByRootChain(ctx, hmTypes.RootChainTypeStake))
+}
+
+func (suite *KeeperTestSuite) TestUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	keeper.SetUpgradeHeight(ctx, 10)
+
+	// chain started before upgrade has no params added by it
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(subspace.StoreKey)), []byte(types.DefaultParamspace+"/"))
+	paramStore.Delete(types.KeyCheckpointLifecycleRetention)
+	paramStore.Delete(types.KeyEventTypeMode)
+
+	ctx = ctx.WithBlockHeight(9)
+	require.False(t, keeper.IsUpgradeActive(ctx))
+
+	params := keeper.GetParams(ctx)
+	require.Equal(t, types.DefaultCheckpointLifecycleRetention, params.CheckpointLifecycleRetention)
+
+	// new state is not written before upgrade
+	checkpointBlock := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 100)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpointBlock, hmTypes.RootChainTypeStake))
+	keeper.AppendCheckpointLifecycle(ctx, types.LifecycleAcked, hmTypes.RootChainTypeStake, 0, 255)
+	keeper.RecordHandlerSuccess(ctx, "checkpoint")
+	require.Empty(t, keeper.GetCheckpointsByTimeRange(ctx, 0, 200, hmTypes.RootChainTypeStake, 1, 10))
+	require.Empty(t, keeper.GetCheckpointLifecycle(ctx, 1, 10))
+	require.Empty(t, keeper.GetHandlerStats(ctx))
+
+	// migration persists missing params
+	ctx = ctx.WithBlockHeight(10)
+	require.True(t, keeper.IsUpgradeActive(ctx))
+	checkpoint.NewAppModule(keeper, app.StakingKeeper, app.TopupKeeper, nil).BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.True(t, paramStore.Has(types.KeyCheckpointLifecycleRetention))
+	require.True(t, paramStore.Has(types.KeyEventTypeMode))
+	require.Equal(t, params, keeper.GetParams(ctx))
+
+	// and indexes checkpoints added before upgrade
+	checkpoints := keeper.GetCheckpointsByTimeRange(ctx, 0, 200, hmTypes.RootChainTypeStake, 1, 10)
+	require.Len(t, checkpoints, 1)
+	require.Equal(t, uint64(1), checkpoints[0].Number)
+	require.Equal(t, checkpointBlock, checkpoints[0].Checkpoint)
+
+	keeper.RecordHandlerSuccess(ctx, "checkpoint")
+	require.Len(t, keeper.GetHandlerStats(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointsByTimeRange() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	addCheckpoint := func(number uint64, timestamp uint64, rootChain string) {
+		checkpoint := hmTypes.CreateBlock(
+			number*256,
+			(number+1)*256-1,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			"1234",
+			timestamp,
+		)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint, rootChain))
+	}
+
+	addCheckpoint(1, 100, hmTypes.RootChainTypeTron)
+	addCheckpoint(2, 200, hmTypes.RootChainTypeTron)
+	addCheckpoint(1, 150, hmTypes.RootChainTypeEth)
+	addCheckpoint(3, 300, hmTypes.RootChainTypeTron)
+
+	res := keeper.GetCheckpointsByTimeRange(ctx, 100, 200, "", 1, 10)
+	require.Len(t, res, 3)
+	require.Equal(t, uint64(100), res[0].Checkpoint.TimeStamp)
+	require.Equal(t, hmTypes.RootChainTypeEth, res[1].RootChain)
+	require.Equal(t, uint64(2), res[2].Number)
+
+	// filter by root chain
+	res = keeper.GetCheckpointsByTimeRange(ctx, 0, 1000, hmTypes.RootChainTypeTron, 1, 10)
+	require.Len(t, res, 3)
+
+	// pagination
+	res = keeper.GetCheckpointsByTimeRange(ctx, 0, 1000, "", 2, 3)
+	require.Len(t, res, 1)
+	require.Equal(t, uint64(300), res[0].Checkpoint.TimeStamp)
+
+	// empty range
+	require.Empty(t, keeper.GetCheckpointsByTimeRange(ctx, 301, 1000, "", 1, 10))
+}
+
+func (suite *KeeperTestSuite) TestPruneCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	var checkpoints []hmTypes.Checkpoint
+	for number := uint64(1); number <= 10; number++ {
+		checkpoint := hmTypes.CreateBlock((number-1)*256, number*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 100*number)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint, rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	// pruning is disabled by default
+	keeper.PruneCheckpoints(ctx)
+	require.Equal(t, uint64(0), keeper.GetPrunedCheckpoints(ctx, rootChain).Count)
+
+	params := keeper.GetParams(ctx)
+	params.CheckpointRetention = 3
+	keeper.SetParams(ctx, params)
+
+	keeper.PruneCheckpoints(ctx)
+
+	expected := types.PrunedCheckpoints{RootChain: rootChain}
+	for number := uint64(1); number <= 7; number++ {
+		expected = expected.Extend(number, checkpoints[number-1])
+		_, err := keeper.GetCheckpointByNumber(ctx, number, rootChain)
+		require.Error(t, err, "checkpoint %d should be pruned", number)
+	}
+	expected.LastNumber = 7
+
+	pruned := keeper.GetPrunedCheckpoints(ctx, rootChain)
+	require.Equal(t, expected, pruned)
+	require.Equal(t, uint64(7), pruned.Count)
+
+	for number := uint64(8); number <= 10; number++ {
+		_, err := keeper.GetCheckpointByNumber(ctx, number, rootChain)
+		require.NoError(t, err)
+	}
+	require.Len(t, keeper.GetCheckpointsByTimeRange(ctx, 0, 1000, rootChain, 1, 10), 3)
+
+	// pruned checkpoints stay acked and invariants hold
+	acked, ackNumber := keeper.GetCheckpointAckStatus(ctx, 1, rootChain)
+	require.True(t, acked)
+	require.Equal(t, uint64(1), ackNumber)
+	_, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// pruning again is no-op, other root chains are untouched
+	keeper.PruneCheckpoints(ctx)
+	require.Equal(t, pruned, keeper.GetPrunedCheckpoints(ctx, rootChain))
+	require.Equal(t, uint64(0), keeper.GetPrunedCheckpoints(ctx, hmTypes.RootChainTypeEth).Count)
+}
+
+func (suite *KeeperTestSuite) TestIterateCheckpoints() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	// checkpoint 4 skipped by ack number tolerance
+	for number := uint64(1); number <= 6; number++ {
+		if number == 4 {
+			continue
+		}
+		checkpoint := hmTypes.CreateBlock((number-1)*256, number*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", number)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint, rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+		keeper.SetLastAckNumber(ctx, rootChain, number)
+	}
+	keeper.SetPrunedCheckpoints(ctx, types.PrunedCheckpoints{RootChain: rootChain, Count: 1, LastNumber: 1})
+
+	collect := func(from, to uint64, limit int) []uint64 {
+		var numbers []uint64
+		keeper.IterateCheckpoints(ctx, rootChain, from, to, func(number uint64, checkpoint hmTypes.Checkpoint) bool {
+			require.Equal(t, (number-1)*256, checkpoint.StartBlock)
+			numbers = append(numbers, number)
+			return len(numbers) == limit
+		})
+		return numbers
+	}
+
+	require.Equal(t, []uint64{2, 3, 5, 6}, collect(0, 100, 0))
+	require.Equal(t, []uint64{3, 5}, collect(3, 5, 0))
+	require.Equal(t, []uint64{2, 3}, collect(1, 6, 2))
+	require.Empty(t, collect(7, 10, 0))
+
+	keeper.IterateCheckpoints(ctx, hmTypes.RootChainTypeStake, 1, 10, func(uint64, hmTypes.Checkpoint) bool {
+		t.Fatal("stake root chain has no checkpoints")
+		return true
+	})
+}
+
+func (suite *KeeperTestSuite) TestAccountRoot() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	topupKeeper := app.TopupKeeper
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	require.NoError(t, topupKeeper.AddDividendAccount(ctx, dividendAccount))
+
+	// dividend account change only marks root stale
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.True(t, store.Has(checkpoint.AccountRootDirtyKey))
+	require.False(t, store.Has(checkpoint.GetAccountRootKey(hmTypes.GetRootChainID(hmTypes.RootChainTypeStake))))
+
+	// root is persisted once at end block
+	keeper.UpdateAccountRootIfDirty(ctx)
+	require.False(t, store.Has(checkpoint.AccountRootDirtyKey))
+	require.True(t, store.Has(checkpoint.GetAccountRootKey(hmTypes.GetRootChainID(hmTypes.RootChainTypeStake))))
+
+	expected, err := types.GetAccountRootHash(topupKeeper.GetAllDividendAccounts(ctx), types.DefaultAccountHashStrategy)
+	require.NoError(t, err)
+
+	accountRoot, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	require.Equal(t, expected, accountRoot)
+
+	// fee update changes persisted root
+	require.Nil(t, topupKeeper.AddFeeToDividendAccount(ctx, dividendAccount.User, big.NewInt(100)))
+
+	updatedRoot, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	require.NotEqual(t, accountRoot, updatedRoot)
+	require.False(t, store.Has(checkpoint.AccountRootDirtyKey), "read must persist stale root")
+}
+
+func (suite *KeeperTestSuite) TestAccountRootHashStrategies() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	topupKeeper := app.TopupKeeper
+
+	user := hmTypes.HexToHeimdallAddress("123")
+	require.NoError(t, topupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user, big.NewInt(0).String())))
+	require.NoError(t, topupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("456"), big.NewInt(10).String())))
+	// third account puts inner node hashed by strategy into proof
+	require.NoError(t, topupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("789"), big.NewInt(20).String())))
+	dividendAccounts := topupKeeper.GetAllDividendAccounts(ctx)
+
+	// all root chains keep default strategy
+	expected, err := types.GetAccountRootHash(dividendAccounts, types.DefaultAccountHashStrategy)
+	require.NoError(t, err)
+	for _, rootChain := range []string{hmTypes.RootChainTypeStake, hmTypes.RootChainTypeEth, hmTypes.RootChainTypeBsc} {
+		accountRoot, err := keeper.GetAccountRoot(ctx, rootChain)
+		require.NoError(t, err)
+		require.Equal(t, expected, accountRoot, "root %s", rootChain)
+	}
+
+	// other strategy builds other root and proofs
+	sha256Root, err := types.GetAccountRootHash(dividendAccounts, sha256.New)
+	require.NoError(t, err)
+	require.NotEqual(t, expected, sha256Root)
+
+	ethProof, _, err := types.GetAccountProof(dividendAccounts, user, types.GetAccountHashStrategy(hmTypes.RootChainTypeEth))
+	require.NoError(t, err)
+	sha256Proof, _, err := types.GetAccountProof(dividendAccounts, user, sha256.New)
+	require.NoError(t, err)
+	require.NotEqual(t, ethProof, sha256Proof)
+
+	valid, err := types.VerifyAccountProof(dividendAccounts, user, hmTypes.BytesToHexBytes(sha256Proof).String(), sha256.New)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = types.VerifyAccountProof(dividendAccounts, user, hmTypes.BytesToHexBytes(sha256Proof).String(), types.GetAccountHashStrategy(hmTypes.RootChainTypeEth))
+	require.NoError(t, err)
+	require.False(t, valid)
+}
+
+func (suite *KeeperTestSuite) TestAccountTreeIncrementalUpdate() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	topupKeeper := app.TopupKeeper
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	requireRoot := func() {
+		for _, rootChain := range []string{hmTypes.RootChainTypeStake, hmTypes.RootChainTypeBsc} {
+			expected, err := types.GetAccountRootHash(topupKeeper.GetAllDividendAccounts(ctx), types.GetAccountHashStrategy(rootChain))
+			require.NoError(t, err)
+
+			accountRoot, err := keeper.GetAccountRoot(ctx, rootChain)
+			require.NoError(t, err)
+			require.Equal(t, expected, accountRoot, "root %s", rootChain)
+		}
+	}
+
+	// odd and even number of leaves, including odd inner levels
+	for i := 1; i <= 7; i++ {
+		user := hmTypes.BytesToHeimdallAddress([]byte{byte(i)})
+		require.NoError(t, topupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user, big.NewInt(0).String())))
+		keeper.UpdateAccountRootIfDirty(ctx)
+		requireRoot()
+
+		size := store.Get(checkpoint.AccountTreeSizeKey)
+
+		// fee updates of first, middle and last account rehash their paths only
+		for _, j := range []int{1, (i + 1) / 2, i} {
+			user := hmTypes.BytesToHeimdallAddress([]byte{byte(j)})
+			require.Nil(t, topupKeeper.AddFeeToDividendAccount(ctx, user, big.NewInt(int64(10*j))))
+		}
+		require.True(t, store.Has(checkpoint.GetAccountRootDirtyUserKey(hmTypes.BytesToHeimdallAddress([]byte{1}))))
+
+		keeper.UpdateAccountRootIfDirty(ctx)
+		require.False(t, store.Has(checkpoint.AccountRootDirtyKey))
+		require.False(t, store.Has(checkpoint.GetAccountRootDirtyUserKey(hmTypes.BytesToHeimdallAddress([]byte{1}))))
+		require.Equal(t, size, store.Get(checkpoint.AccountTreeSizeKey))
+		requireRoot()
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccountRootGas() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	topupKeeper := app.TopupKeeper
+	rootChains := len(app.ChainKeeper.GetRootChains(ctx))
+
+	for i := 1; i <= 4; i++ {
+		user := hmTypes.BytesToHeimdallAddress([]byte{byte(i)})
+		require.NoError(t, topupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(user, big.NewInt(0).String())))
+	}
+
+	// gas of account root update with hash gas param, store access costs same in both runs
+	measure := func(accountHashGas uint64) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		params := keeper.GetParams(cacheCtx)
+		params.AccountHashGas = accountHashGas
+		keeper.SetParams(cacheCtx, params)
+
+		meter := sdk.NewInfiniteGasMeter()
+		keeper.UpdateAccountRootIfDirty(cacheCtx.WithGasMeter(meter))
+		return meter.GasConsumed()
+	}
+
+	// rebuild hashes 4 leaves once and 3 inner nodes per root chain
+	require.Equal(t, uint64(4+3*rootChains)*1000000, measure(2000000)-measure(1000000))
+
+	// fee update rehashes leaf once and its 2 inner nodes per root chain
+	keeper.UpdateAccountRootIfDirty(ctx)
+	require.Nil(t, topupKeeper.AddFeeToDividendAccount(ctx, hmTypes.BytesToHeimdallAddress([]byte{1}), big.NewInt(10)))
+	require.Equal(t, uint64(1+2*rootChains)*1000000, measure(2000000)-measure(1000000))
+}
+
+func (suite *KeeperTestSuite) TestAccountRootNoAccounts() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// root can't be computed without accounts, nothing is persisted
+	keeper.MarkAccountRootDirty(ctx)
+	keeper.UpdateAccountRootIfDirty(ctx)
+	require.False(t, store.Has(checkpoint.AccountRootDirtyKey))
+	for rootChain, rootID := range hmTypes.GetRootChainIDMap() {
+		require.False(t, store.Has(checkpoint.GetAccountRootKey(rootID)), "root %s", rootChain)
+	}
+
+	_, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeBsc
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	newCheckpoint := func(start, end uint64) hmTypes.Checkpoint {
+		return hmTypes.CreateBlock(start, end, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	}
+
+	// more than 9 checkpoints, so store order differs from number order
+	for number := uint64(1); number <= 12; number++ {
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, newCheckpoint((number-1)*256, number*256-1), rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+	}
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, newCheckpoint(12*256, 13*256-1), rootChain))
+
+	_, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// buffer not extending last checkpoint
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, newCheckpoint(13*256, 14*256-1), rootChain))
+	_, broken = checkpoint.BufferContinuityInvariant(keeper)(ctx)
+	require.True(t, broken)
+
+	// checkpoint stored without ack and going back
+	require.NoError(t, keeper.AddCheckpoint(ctx, 13, newCheckpoint(0, 255), rootChain))
+	_, broken = checkpoint.AckCountInvariant(keeper)(ctx)
+	require.True(t, broken)
+	_, broken = checkpoint.TipMonotonicityInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
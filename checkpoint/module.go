@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"bytes"
+	gocontext "context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -123,8 +124,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the checkpoint module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -162,12 +165,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	return types.ModuleCdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the auth module. It migrates store
+// at checkpoint upgrade height.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	if height, ok := am.keeper.GetUpgradeHeight(ctx); ok && height > 0 && ctx.BlockHeight() == height {
+		am.keeper.MigrateStore(ctx)
+	}
+}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the auth module. It emits pause/unpause
+// events for root chains, persists account root if dividend accounts changed
+// and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.UpdatePausedRootChains(ctx)
+	am.keeper.UpdateAccountRootIfDirty(ctx)
+	am.keeper.PruneCheckpoints(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -197,7 +209,7 @@ func (AppModule) ProposalContents(simState hmModule.SimulationState) []simTypes.
 
 // RandomizedParams creates randomized param changes for the simulator.
 func (AppModule) RandomizedParams(r *rand.Rand) []simTypes.ParamChange {
-	return nil
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder for chainmanager module's types
@@ -205,14 +217,18 @@ func (AppModule) RegisterStoreDecoder(sdr hmModule.StoreDecoderRegistry) {
 	return
 }
 
-// WeightedOperations doesn't return any chainmanager module operation.
-func (AppModule) WeightedOperations(_ hmModule.SimulationState) []simTypes.WeightedOperation {
-	return nil
+// WeightedOperations returns checkpoint module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState hmModule.SimulationState) []simTypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams,
+		simState.Cdc,
+		&am.keeper,
+		NewHandler(am.keeper, am.contractCaller),
+		NewPostTxHandler(am.keeper, am.contractCaller),
+	)
 }
 
-//
 // Internal methods
-//
 func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes.GenesisState) error {
 	contractCaller, err := helper.NewContractCaller()
 	if err != nil {
@@ -223,7 +239,7 @@ func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes
 	rootChainInstance, _ := contractCaller.GetRootChainInstance(rootChainAddress, hmTypes.RootChainTypeEth)
 
 	// check header count
-	currentCheckpointNumber, err := contractCaller.CurrentHeaderBlock(rootChainInstance, childBlockInterval)
+	currentCheckpointNumber, err := contractCaller.CurrentHeaderBlock(gocontext.Background(), rootChainInstance, childBlockInterval)
 	if err != nil {
 		return nil
 	}
@@ -242,7 +258,7 @@ func verifyGenesis(state types.GenesisState, chainManagerState chainmanagerTypes
 	// check all headers
 	for i, header := range state.Checkpoints {
 		ackCount := uint64(i + 1)
-		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount, rootChainInstance, childBlockInterval)
+		root, start, end, _, _, err := contractCaller.GetHeaderInfo(gocontext.Background(), ackCount, rootChainInstance, childBlockInterval)
 		if err != nil {
 			return err
 		}
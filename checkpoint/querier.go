@@ -1,8 +1,11 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
@@ -26,16 +29,61 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryEpoch(ctx, req, keeper)
 		case types.QueryCheckpoint:
 			return handleQueryCheckpoint(ctx, req, keeper)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
+		case types.QueryCheckpointProof:
+			return handleQueryCheckpointProof(ctx, req, keeper, contractCaller)
 		case types.QueryCheckpointBuffer:
 			return handleQueryCheckpointBuffer(ctx, req, keeper)
 		case types.QueryCheckpointSyncBuffer:
 			return handleQueryCheckpointSyncBuffer(ctx, req, keeper)
 		case types.QueryLastNoAck:
 			return handleQueryLastNoAck(ctx, req, keeper)
+		case types.QueryNoAckCountdown:
+			return handleQueryNoAckCountdown(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryCheckpointCostEstimate:
+			return handleQueryCheckpointCostEstimate(ctx, req, keeper, stakingKeeper, contractCaller)
+		case types.QueryMaxCommittedBlock:
+			return handleQueryMaxCommittedBlock(ctx, req, keeper)
+		case types.QueryCurrentAccountRoot:
+			return handleQueryCurrentAccountRoot(ctx, req, keeper)
+		case types.QueryCheckpointBatch:
+			return handleQueryCheckpointBatch(ctx, req, keeper)
+		case types.QueryCheckpointRange:
+			return handleQueryCheckpointRange(ctx, req, keeper)
+		case types.QueryCheckpointContinuity:
+			return handleQueryCheckpointContinuity(ctx, req, keeper)
+		case types.QueryCheckpointsByTime:
+			return handleQueryCheckpointsByTimeRange(ctx, req, keeper)
+		case types.QueryCheckpointAckStatus:
+			return handleQueryCheckpointAckStatus(ctx, req, keeper)
+		case types.QueryCheckpointLifecycle:
+			return handleQueryCheckpointLifecycle(ctx, req, keeper)
+		case types.QueryProposerRotations:
+			return handleQueryProposerRotations(ctx, req, keeper)
+		case types.QueryStaleBuffers:
+			return handleQueryStaleBuffers(ctx, req, keeper)
+		case types.QueryCheckpointPower:
+			return handleQueryCheckpointPower(ctx, req, keeper)
+		case types.QueryPrunedCheckpoints:
+			return handleQueryPrunedCheckpoints(ctx, req, keeper)
+		case types.QueryHandlerStats:
+			return handleQueryHandlerStats(ctx, req, keeper)
+		case types.QueryNextCheckpointShape:
+			return handleQueryNextCheckpointShape(ctx, req, keeper)
+		case types.QueryCheckpointReadiness:
+			return handleQueryCheckpointReadiness(ctx, req, keeper)
+		case types.QueryBackupProposers:
+			return handleQueryBackupProposers(ctx, req, keeper)
+		case types.QueryCheckpointRaw:
+			if !helper.GetConfig().EnableDebugQueries {
+				return nil, sdk.ErrUnknownRequest("debug queries are disabled")
+			}
+			return handleQueryCheckpointRaw(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
-			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
+			return handleQueryNextCheckpoint(ctx, req, keeper, contractCaller)
 		case types.QueryCheckpointActivation:
 			return handleQueryCheckpointActivation(ctx, req, keeper)
 		default:
@@ -98,6 +146,11 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	if params.RootChain == "" {
 		params.RootChain = hmTypes.RootChainTypeStake
 	}
+
+	if !keeper.HasOtherCheckpoint(ctx, params.RootChain, params.Number) {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
 	res, err = keeper.GetCheckpointByNumber(ctx, params.Number, params.RootChain)
 
 	if err != nil {
@@ -112,12 +165,283 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointPower(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	res, found := keeper.GetCheckpointPower(ctx, params.RootChain)
+	if !found {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPrunedCheckpoints(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	bz, err := json.Marshal(keeper.GetPrunedCheckpoints(ctx, params.RootChain))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryNextCheckpointShape(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	res, err := keeper.GetNextCheckpointShape(ctx, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch next checkpoint shape", err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointReadiness(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointReadinessParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	bz, err := json.Marshal(keeper.GetCheckpointReadiness(ctx, params.RootChain, params.StartBlock, params.EndBlock))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryHandlerStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetHandlerStats(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryBackupProposers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(types.BackupProposers{
+		NoAckStreak: keeper.GetNoAckStreak(ctx),
+		Proposers:   keeper.GetBackupProposers(ctx),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointRaw(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	key, value := keeper.GetRawCheckpoint(ctx, params.Number, params.RootChain)
+	if value == nil {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	// decode failure is reported next to raw bytes, it's what is being debugged
+	res := types.CheckpointRaw{Key: key, Raw: value}
+	var checkpoint hmTypes.Checkpoint
+	if err := keeper.cdc.UnmarshalBinaryBare(value, &checkpoint); err != nil {
+		res.DecodeError = err.Error()
+	} else {
+		res.Decoded = &checkpoint
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointCostEstimate(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointCostEstimateParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// only root chains with eth gas model can be estimated
+	if !keeper.ck.IsEthForkRootChain(ctx, params.RootChain) {
+		return nil, common.ErrCostEstimateUnsupported(keeper.Codespace(), params.RootChain)
+	}
+
+	maxCheckpointLength := keeper.GetParams(ctx).MaxCheckpointLength
+	if params.EndBlock < params.StartBlock {
+		return nil, common.ErrBadBlockDetails(keeper.Codespace())
+	}
+	if span := params.EndBlock - params.StartBlock + 1; span > maxCheckpointLength {
+		return nil, common.ErrCheckpointTooLarge(keeper.Codespace(), span, maxCheckpointLength)
+	}
+
+	gasPrice, err := contractCaller.GetGasPrice(ctx.Context(), params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch gas price", err.Error()))
+	}
+
+	// checkpoint carries signatures of more than 2/3 of validators
+	validatorSet := sk.GetValidatorSet(ctx)
+	signatures := uint64(validatorSet.Size()*2/3 + 1)
+
+	bz, err := json.Marshal(types.NewCheckpointCostEstimate(params.RootChain, params.StartBlock, params.EndBlock, signatures, gasPrice))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryMaxCommittedBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	block, rootChain, found := keeper.GetMaxCommittedBlock(ctx)
+
+	bz, err := json.Marshal(types.MaxCommittedBlock{
+		Block:     block,
+		RootChain: rootChain,
+		Found:     found,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCurrentAccountRoot(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	accountRoot, err := keeper.GetAccountRoot(ctx, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch account root hash", err.Error()))
+	}
+	return accountRoot, nil
+}
+
+func handleQueryCheckpointBatch(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointBatchParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	maxBatchSize := keeper.GetParams(ctx).MaxCheckpointBatchSize
+	if maxBatchSize == 0 {
+		maxBatchSize = types.DefaultMaxCheckpointBatchSize
+	}
+
+	if uint64(len(params.Numbers)) > maxBatchSize {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("too many checkpoints requested: %d, max %d", len(params.Numbers), maxBatchSize))
+	}
+
+	res := keeper.GetCheckpointsByNumbers(ctx, params.Numbers, params.RootChain)
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointRangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	if params.From == 0 || params.To < params.From {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid checkpoint range: %d..%d", params.From, params.To))
+	}
+
+	maxBatchSize := keeper.GetParams(ctx).MaxCheckpointBatchSize
+	if maxBatchSize == 0 {
+		maxBatchSize = types.DefaultMaxCheckpointBatchSize
+	}
+
+	if params.To-params.From >= maxBatchSize {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("too many checkpoints requested: %d, max %d", params.To-params.From+1, maxBatchSize))
+	}
+
+	res := make([]types.RootChainCheckpoint, 0)
+	keeper.IterateCheckpoints(ctx, params.RootChain, params.From, params.To, func(number uint64, checkpoint hmTypes.Checkpoint) bool {
+		res = append(res, types.RootChainCheckpoint{
+			Number:     number,
+			RootChain:  params.RootChain,
+			Checkpoint: checkpoint,
+		})
+		return false
+	})
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryCheckpointParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	// unknown root chain would silently read buffer of root chain id 0
+	if keeper.ck.GetRootChainID(ctx, params.RootChain) == 0 {
+		return nil, common.ErrWrongRootChain(keeper.Codespace())
+	}
+
 	res, err := keeper.GetCheckpointFromBuffer(ctx, params.RootChain)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
@@ -140,8 +464,15 @@ func handleQueryCheckpointSyncBuffer(ctx sdk.Context, req abci.RequestQuery, kee
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointSyncFromBuffer(ctx, params.RootChain)
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	if keeper.ck.GetRootChainID(ctx, params.RootChain) == 0 {
+		return nil, common.ErrWrongRootChain(keeper.Codespace())
+	}
 
+	res, err := keeper.GetCheckpointSyncFromBuffer(ctx, params.RootChain)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
 	}
@@ -158,8 +489,16 @@ func handleQueryCheckpointSyncBuffer(ctx sdk.Context, req abci.RequestQuery, kee
 }
 
 func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	// get last no ack
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get last no ack, per root chain if requested
 	res := keeper.GetLastNoAck(ctx)
+	if params.RootChain != "" {
+		res = keeper.GetLastNoAckByRootChain(ctx, params.RootChain)
+	}
 	// sed result
 	bz, err := json.Marshal(res)
 	if err != nil {
@@ -168,6 +507,36 @@ func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func handleQueryNoAckCountdown(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil && len(req.Data) != 0 {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	// no-ack is accepted from same time as handler allows it
+	schedule, err := getNoAckSchedule(ctx, keeper, params.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch last checkpoint", err.Error()))
+	}
+
+	var countdown time.Duration
+	if remaining := schedule.AllowedAt().Sub(ctx.BlockTime()); remaining > 0 {
+		countdown = remaining
+	}
+
+	// round up so that zero is only returned once no-ack is allowed
+	res := uint64(math.Ceil(countdown.Seconds()))
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params hmTypes.QueryPaginationParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -186,53 +555,228 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
-func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper, tk topup.Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+func handleQueryCheckpointContinuity(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	checkpointBuffer, err := keeper.GetCheckpointFromBuffer(ctx, params.RootChain)
+	if err != nil {
+		return nil, common.ErrNoCheckpointBufferFound(keeper.Codespace())
+	}
+
+	lastEnd, expectedStart := keeper.GetExpectedCheckpointStart(ctx, params.RootChain)
+
+	bz, err := json.Marshal(types.CheckpointContinuity{
+		LastEnd:     lastEnd,
+		BufferStart: checkpointBuffer.StartBlock,
+		Continuous:  checkpointBuffer.StartBlock == expectedStart,
+		Gap:         int64(checkpointBuffer.StartBlock) - int64(expectedStart),
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointsByTimeRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointsByTimeRangeParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	res := keeper.GetCheckpointsByTimeRange(ctx, params.From, params.To, params.RootChain, params.Page, params.Limit)
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointByBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	number, checkpoint, found := keeper.GetCheckpointByBlock(ctx, params.BlockNumber, params.RootChain)
+	if !found {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(types.RootChainCheckpoint{
+		Number:     number,
+		RootChain:  params.RootChain,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	number, checkpoint, found := keeper.GetCheckpointByBlock(ctx, params.BlockNumber, params.RootChain)
+	if !found {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	// tx has to be in block proven
+	receipt, err := contractCaller.GetMaticTxReceipt(ctx.Context(), params.TxHash)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch tx receipt", err.Error()))
+	}
+	if receipt.BlockNumber == nil || receipt.BlockNumber.Uint64() != params.BlockNumber {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("tx %s is not in block %d", params.TxHash.Hex(), params.BlockNumber))
+	}
+
+	headers, err := contractCaller.GetMaticChainHeaders(ctx.Context(), checkpoint.StartBlock, checkpoint.EndBlock)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint headers", err.Error()))
+	}
+
+	index := params.BlockNumber - checkpoint.StartBlock
+	leaf, proof, root, err := helper.HeaderProof(headers, index)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not build header proof", err.Error()))
+	}
+
+	// headers served by bor node have to add up to checkpointed root
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("root hash of checkpoint headers %x does not match checkpoint root hash %s", root, checkpoint.RootHash.String()))
+	}
+
+	bz, err := json.Marshal(types.CheckpointProof{
+		Number:      number,
+		RootChain:   params.RootChain,
+		Checkpoint:  checkpoint,
+		BlockNumber: params.BlockNumber,
+		TxHash:      params.TxHash,
+		TxIndex:     receipt.TransactionIndex,
+		Header:      headers[index],
+		Leaf:        leaf,
+		LeafIndex:   index,
+		Proof:       proof,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointAckStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeStake
+	}
+
+	acked, ackNumber := keeper.GetCheckpointAckStatus(ctx, params.Number, params.RootChain)
+
+	bz, err := json.Marshal(types.CheckpointAckStatus{Acked: acked, AckNumber: ackNumber})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointLifecycle(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params hmTypes.QueryPaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetCheckpointLifecycle(ctx, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerRotations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params hmTypes.QueryPaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetProposerRotations(ctx, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryStaleBuffers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStaleBuffersParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	threshold := time.Duration(params.Threshold) * time.Second
+	bz, err := json.Marshal(keeper.GetStaleBuffers(ctx, threshold))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
 	var queryParams types.QueryBorChainID
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse query params: %s", err))
 	}
 
-	// get validator set
-	validatorSet := sk.GetValidatorSet(ctx)
-	proposer := validatorSet.GetProposer()
-	ackCount := keeper.GetACKCount(ctx, hmTypes.RootChainTypeStake)
-	params := keeper.GetParams(ctx)
+	if queryParams.RootChain == "" {
+		queryParams.RootChain = hmTypes.RootChainTypeStake
+	}
 
-	var start uint64
+	params := keeper.GetParams(ctx)
 
-	if ackCount != 0 {
-		checkpointNumber := ackCount
-		lastCheckpoint, err := keeper.GetCheckpointByNumber(ctx, checkpointNumber, hmTypes.RootChainTypeStake)
-		if err != nil {
-			return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", checkpointNumber), err.Error()))
-		}
-		start = lastCheckpoint.EndBlock + 1
+	// start, epoch, account root and proposer follow consensus state of root chain
+	shape, err := keeper.GetNextCheckpointShape(ctx, queryParams.RootChain)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch next checkpoint shape", err.Error()))
 	}
 
+	start := shape.StartBlock
 	end := start + params.AvgCheckpointLength
 
-	rootHash, err := contractCaller.GetRootHash(start, end, params.MaxCheckpointLength)
+	rootHash, err := contractCaller.GetRootHash(ctx.Context(), start, end, params.MaxCheckpointLength)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch roothash for start:%v end:%v error:%v", start, end, err), err.Error()))
 	}
 
-	accs := tk.GetAllDividendAccounts(ctx)
-	accRootHash, err := types.GetAccountRootHash(accs)
-	if err != nil {
-		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not get generate account root hash. Error:%v", err), err.Error()))
-	}
-
-	epoch := keeper.GetACKCount(ctx, hmTypes.RootChainTypeStake) + 1
-
 	checkpointMsg := types.NewMsgCheckpointBlock(
-		proposer.Signer,
+		shape.Proposer,
 		start,
-		start+params.AvgCheckpointLength,
+		end,
 		hmTypes.BytesToHeimdallHash(rootHash),
-		hmTypes.BytesToHeimdallHash(accRootHash),
+		shape.AccountRootHash,
 		queryParams.BorChainID,
-		epoch,
-		hmTypes.RootChainTypeStake,
+		shape.Epoch,
+		queryParams.RootChain,
 	)
 	bz, err := json.Marshal(checkpointMsg)
 	if err != nil {
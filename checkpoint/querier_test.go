@@ -10,12 +10,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -145,6 +149,453 @@ func (suite *QuerierTestSuite) TestQueryCheckpoint() {
 	json.Unmarshal(res, &checkpoint)
 
 	require.Equal(t, checkpoint, checkpointBlock)
+
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointRaw() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	checkpointBlock := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, app.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpointBlock, hmTypes.RootChainTypeEth))
+
+	path := []string{types.QueryCheckpointRaw}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRaw)
+	newReq := func(number uint64) abci.RequestQuery {
+		return abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(number, hmTypes.RootChainTypeEth)),
+		}
+	}
+
+	// disabled by default
+	_, err := querier(ctx, path, newReq(1))
+	require.Error(t, err)
+
+	conf := helper.GetConfig()
+	conf.EnableDebugQueries = true
+	helper.SetTestConfig(conf)
+	defer func() {
+		conf.EnableDebugQueries = false
+		helper.SetTestConfig(conf)
+	}()
+
+	res, err := querier(ctx, path, newReq(1))
+	require.NoError(t, err)
+
+	var raw types.CheckpointRaw
+	require.NoError(t, json.Unmarshal(res, &raw))
+	require.Equal(t, hmTypes.HexBytes(checkpoint.GetCheckpointKey(1, hmTypes.GetRootChainID(hmTypes.RootChainTypeEth))), raw.Key)
+	require.Equal(t, hmTypes.HexBytes(app.Codec().MustMarshalBinaryBare(checkpointBlock)), raw.Raw)
+	require.Equal(t, checkpointBlock, *raw.Decoded)
+	require.Empty(t, raw.DecodeError)
+
+	// corrupted bytes are returned along with decode error
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(checkpoint.GetCheckpointKey(2, hmTypes.GetRootChainID(hmTypes.RootChainTypeEth)), []byte{0xff, 0x01})
+	res, err = querier(ctx, path, newReq(2))
+	require.NoError(t, err)
+
+	raw = types.CheckpointRaw{}
+	require.NoError(t, json.Unmarshal(res, &raw))
+	require.Equal(t, hmTypes.HexBytes{0xff, 0x01}, raw.Raw)
+	require.Nil(t, raw.Decoded)
+	require.NotEmpty(t, raw.DecodeError)
+
+	// missing checkpoint
+	_, err = querier(ctx, path, newReq(3))
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointNotFound() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryCheckpoint}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(1000), "")),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Equal(t, common.CodeNoCheckpoint, err.Code())
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointByBlock() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	// checkpoint 7 skipped by ack number tolerance
+	for number := uint64(1); number <= 10; number++ {
+		if number == 7 {
+			continue
+		}
+		checkpoint := hmTypes.CreateBlock((number-1)*256, number*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", number)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint, rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+		keeper.SetLastAckNumber(ctx, rootChain, number)
+	}
+
+	path := []string{types.QueryCheckpointByBlock}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock)
+
+	query := func(block uint64, rootChain string) (types.RootChainCheckpoint, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointByBlockParams(block, rootChain)),
+		}
+
+		var res types.RootChainCheckpoint
+		bz, err := querier(ctx, path, req)
+		if err == nil {
+			require.NoError(t, json.Unmarshal(bz, &res))
+		}
+		return res, err
+	}
+
+	for block, number := range map[uint64]uint64{0: 1, 255: 1, 256: 2, 1535: 6, 1800: 8, 2559: 10} {
+		res, err := query(block, rootChain)
+		require.NoError(t, err, "block %d", block)
+		require.Equal(t, number, res.Number, "block %d", block)
+		require.Equal(t, rootChain, res.RootChain)
+		require.True(t, res.Checkpoint.StartBlock <= block && block <= res.Checkpoint.EndBlock)
+	}
+
+	// root chain defaults to stake chain
+	res, err := query(300, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Number)
+
+	// blocks in skipped checkpoint, past last checkpoint or of other root chain are not found
+	for _, block := range []uint64{1536, 1791, 2560} {
+		_, err := query(block, rootChain)
+		require.Error(t, err, "block %d", block)
+		require.Equal(t, common.CodeNoCheckpoint, err.Code())
+	}
+	_, err = query(0, hmTypes.RootChainTypeEth)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	headers := make([]*ethTypes.Header, 4)
+	for i := range headers {
+		headers[i] = &ethTypes.Header{
+			Difficulty:  big.NewInt(1),
+			Number:      big.NewInt(int64(i)),
+			Time:        uint64(1000 + i),
+			TxHash:      hmTypes.HexToHeimdallHash(strconv.Itoa(i + 1)).EthHash(),
+			ReceiptHash: hmTypes.HexToHeimdallHash(strconv.Itoa(i + 2)).EthHash(),
+		}
+	}
+	_, _, root, err := helper.HeaderProof(headers, 0)
+	require.NoError(t, err)
+
+	checkpoint := hmTypes.CreateBlock(0, 3, hmTypes.BytesToHeimdallHash(root), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, rootChain))
+	keeper.UpdateACKCount(ctx, rootChain)
+	keeper.SetLastAckNumber(ctx, rootChain, 1)
+
+	txHash := hmTypes.HexToHeimdallHash("abc").EthHash()
+	suite.contractCaller.On("GetMaticTxReceipt", mock.Anything, txHash).Return(&ethTypes.Receipt{BlockNumber: big.NewInt(2), TransactionIndex: 1}, nil)
+	suite.contractCaller.On("GetMaticChainHeaders", mock.Anything, uint64(0), uint64(3)).Return(headers, nil)
+
+	path := []string{types.QueryCheckpointProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointProof)
+	query := func(block uint64) ([]byte, sdk.Error) {
+		return querier(ctx, path, abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointProofParams(block, txHash, rootChain)),
+		})
+	}
+
+	bz, sdkErr := query(2)
+	require.Nil(t, sdkErr)
+
+	var proof types.CheckpointProof
+	require.NoError(t, json.Unmarshal(bz, &proof))
+	require.Equal(t, uint64(1), proof.Number)
+	require.Equal(t, uint64(2), proof.LeafIndex)
+	require.Equal(t, uint(1), proof.TxIndex)
+	require.Equal(t, headers[2].Hash(), proof.Header.Hash())
+	require.Len(t, proof.Proof, 2*32)
+
+	expectedLeaf, expectedProof, _, err := helper.HeaderProof(headers, 2)
+	require.NoError(t, err)
+	require.Equal(t, hmTypes.HexBytes(expectedLeaf), proof.Leaf)
+	require.Equal(t, hmTypes.HexBytes(expectedProof), proof.Proof)
+
+	// tx is not in other block of checkpoint
+	_, sdkErr = query(1)
+	require.NotNil(t, sdkErr)
+
+	// block past last checkpoint is not proven
+	_, sdkErr = query(4)
+	require.NotNil(t, sdkErr)
+	require.Equal(t, common.CodeNoCheckpoint, sdkErr.Code())
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointRange() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeStake
+
+	for number := uint64(1); number <= 5; number++ {
+		checkpoint := hmTypes.CreateBlock((number-1)*256, number*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", number)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint, rootChain))
+		keeper.UpdateACKCount(ctx, rootChain)
+	}
+
+	path := []string{types.QueryCheckpointRange}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointRange)
+
+	query := func(from, to uint64, rootChain string) ([]types.RootChainCheckpoint, sdk.Error) {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointRangeParams(from, to, rootChain)),
+		}
+
+		var res []types.RootChainCheckpoint
+		bz, err := querier(ctx, path, req)
+		if err == nil {
+			require.NoError(t, json.Unmarshal(bz, &res))
+		}
+		return res, err
+	}
+
+	res, err := query(2, 10, "")
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+	for i, checkpoint := range res {
+		require.Equal(t, uint64(i+2), checkpoint.Number)
+		require.Equal(t, rootChain, checkpoint.RootChain)
+		require.Equal(t, uint64(i+1)*256, checkpoint.Checkpoint.StartBlock)
+	}
+
+	// other root chain has no checkpoints
+	res, err = query(1, 5, hmTypes.RootChainTypeEth)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// invalid and oversized ranges are rejected
+	_, err = query(0, 5, rootChain)
+	require.Error(t, err)
+	_, err = query(5, 4, rootChain)
+	require.Error(t, err)
+	_, err = query(1, types.DefaultMaxCheckpointBatchSize+1, rootChain)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointAckStatus() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+
+	path := []string{types.QueryCheckpointAckStatus}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointAckStatus)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(1), hmTypes.RootChainTypeStake)),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var status types.CheckpointAckStatus
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.False(t, status.Acked)
+
+	keeper.SetCheckpointAcked(ctx, uint64(1), hmTypes.RootChainTypeStake, uint64(1))
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.True(t, status.Acked)
+	require.Equal(t, uint64(1), status.AckNumber)
+
+	// root chain defaults to stake chain
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(1), ""))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.True(t, status.Acked)
+
+	// ack is tracked per root chain
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(1), hmTypes.RootChainTypeEth))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.False(t, status.Acked)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointCostEstimate() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+
+	path := []string{types.QueryCheckpointCostEstimate}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointCostEstimate)
+	newReq := func(rootChain string, start, end uint64) abci.RequestQuery {
+		return abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointCostEstimateParams(rootChain, start, end)),
+		}
+	}
+
+	gasPrice := big.NewInt(1000000000)
+	suite.contractCaller.On("GetGasPrice", mock.Anything, hmTypes.RootChainTypeEth).Return(gasPrice, nil)
+
+	res, err := querier(ctx, path, newReq(hmTypes.RootChainTypeEth, 0, 255))
+	require.NoError(t, err)
+
+	var estimate types.CheckpointCostEstimate
+	require.NoError(t, json.Unmarshal(res, &estimate))
+
+	// checkpoint is signed by more than 2/3 of validators
+	validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+	signatures := uint64(validatorSet.Size()*2/3 + 1)
+	expected := types.NewCheckpointCostEstimate(hmTypes.RootChainTypeEth, 0, 255, signatures, gasPrice)
+	require.Equal(t, expected, estimate)
+	require.Equal(t, types.EstimateCheckpointGas(signatures), estimate.Gas)
+
+	// tron has no eth gas model
+	res, err = querier(ctx, path, newReq(hmTypes.RootChainTypeTron, 0, 255))
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Equal(t, common.CodeCostEstimateUnsupported, err.Code())
+
+	// range over max checkpoint length
+	res, err = querier(ctx, path, newReq(hmTypes.RootChainTypeEth, 0, 4096))
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Equal(t, common.CodeCheckpointTooLarge, err.Code())
+}
+
+func (suite *QuerierTestSuite) TestQueryMaxCommittedBlock() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+
+	path := []string{types.QueryMaxCommittedBlock}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMaxCommittedBlock)
+	req := abci.RequestQuery{Path: route}
+
+	// no checkpoints yet
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var maxCommitted types.MaxCommittedBlock
+	require.NoError(t, json.Unmarshal(res, &maxCommitted))
+	require.Equal(t, types.MaxCommittedBlock{}, maxCommitted)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	tronCheckpoint := hmTypes.CreateBlock(0, 255, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, tronCheckpoint, hmTypes.RootChainTypeTron))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeTron)
+
+	ethCheckpoint := hmTypes.CreateBlock(0, 511, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, ethCheckpoint, hmTypes.RootChainTypeEth))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeEth)
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &maxCommitted))
+	require.Equal(t, types.MaxCommittedBlock{Block: 511, RootChain: hmTypes.RootChainTypeEth, Found: true}, maxCommitted)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointBatch() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	first := hmTypes.CreateBlock(0, 255, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	second := hmTypes.CreateBlock(256, 511, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, first, hmTypes.RootChainTypeStake))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 2, second, hmTypes.RootChainTypeStake))
+
+	path := []string{types.QueryCheckpointBatch}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBatch)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointBatchParams([]uint64{2, 5, 1}, hmTypes.RootChainTypeStake)),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	// missing checkpoint is null, order is preserved
+	var checkpoints []*hmTypes.Checkpoint
+	require.NoError(t, json.Unmarshal(res, &checkpoints))
+	require.Len(t, checkpoints, 3)
+	require.Equal(t, second.StartBlock, checkpoints[0].StartBlock)
+	require.Nil(t, checkpoints[1])
+	require.Equal(t, first.StartBlock, checkpoints[2].StartBlock)
+
+	// request over max batch size
+	params := keeper.GetParams(ctx)
+	params.MaxCheckpointBatchSize = 2
+	keeper.SetParams(ctx, params)
+
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointContinuity() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+
+	path := []string{types.QueryCheckpointContinuity}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointContinuity)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(0, hmTypes.RootChainTypeStake)),
+	}
+
+	// no buffer
+	res, err := querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Equal(t, common.CodeNoCheckpointBuffer, err.Code())
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	lastCheckpoint := hmTypes.CreateBlock(0, 255, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, lastCheckpoint, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeStake)
+
+	// gap between last checkpoint and buffer
+	buffered := hmTypes.CreateBlock(300, 555, rootHash, proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeStake))
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var continuity types.CheckpointContinuity
+	require.NoError(t, json.Unmarshal(res, &continuity))
+	require.Equal(t, types.CheckpointContinuity{LastEnd: 255, BufferStart: 300, Continuous: false, Gap: 44}, continuity)
+
+	// continuous buffer
+	buffered.StartBlock = 256
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, buffered, hmTypes.RootChainTypeStake))
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &continuity))
+	require.True(t, continuity.Continuous)
+	require.Equal(t, int64(0), continuity.Gap)
+
+	// empty root chain defaults to stake root chain
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(0, ""))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &continuity))
+	require.Equal(t, types.CheckpointContinuity{LastEnd: 255, BufferStart: 256, Continuous: true, Gap: 0}, continuity)
 }
 
 func (suite *QuerierTestSuite) TestQueryCheckpointBuffer() {
@@ -182,6 +633,104 @@ func (suite *QuerierTestSuite) TestQueryCheckpointBuffer() {
 	json.Unmarshal(res, &checkpoint)
 
 	require.Equal(t, checkpoint, checkpointBlock)
+
+	// each root chain has its own buffer, stake root chain is default
+	tronCheckpoint := hmTypes.CreateBlock(256, 511, rootHash, proposerAddress, borChainId, timestamp)
+	require.NoError(t, app.CheckpointKeeper.SetCheckpointBuffer(ctx, tronCheckpoint, hmTypes.RootChainTypeTron))
+
+	res, err = querier(ctx, path, abci.RequestQuery{Path: route})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &checkpoint))
+	require.Equal(t, tronCheckpoint, checkpoint)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(0), hmTypes.RootChainTypeBsc))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(0), "unknown"))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Equal(t, common.CodeWrongRootChain, err.Code())
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointSyncBuffer() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryCheckpointSyncBuffer}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointSyncBuffer)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(0), hmTypes.RootChainTypeBsc)),
+	}
+
+	// nothing pending
+	_, err := querier(ctx, path, req)
+	require.Error(t, err)
+
+	sync := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix()))
+	require.NoError(t, app.CheckpointKeeper.SetCheckpointSyncBuffer(ctx, sync, hmTypes.RootChainTypeBsc))
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var checkpoint hmTypes.Checkpoint
+	require.NoError(t, json.Unmarshal(res, &checkpoint))
+	require.Equal(t, sync, checkpoint)
+
+	// other root chains have no pending sync
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(0), hmTypes.RootChainTypeEth))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(uint64(0), "unknown"))
+	_, err = querier(ctx, path, req)
+	require.Equal(t, common.CodeWrongRootChain, err.Code())
+}
+
+func (suite *QuerierTestSuite) TestQueryStaleBuffers() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryStaleBuffers}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStaleBuffers)
+
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now)
+
+	query := func(threshold uint64) []types.StaleBuffer {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryStaleBuffersParams(threshold)),
+		}
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+
+		var staleBuffers []types.StaleBuffer
+		require.NoError(t, json.Unmarshal(res, &staleBuffers))
+		return staleBuffers
+	}
+
+	// nothing buffered
+	require.Empty(t, query(0))
+
+	ethCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(now.Add(-10*time.Minute).Unix()))
+	app.CheckpointKeeper.SetCheckpointBuffer(ctx, ethCheckpoint, hmTypes.RootChainTypeEth)
+
+	tronCheckpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(now.Add(-time.Minute).Unix()))
+	app.CheckpointKeeper.SetCheckpointBuffer(ctx, tronCheckpoint, hmTypes.RootChainTypeTron)
+
+	// only eth buffer is older than 5 minutes
+	staleBuffers := query(300)
+	require.Len(t, staleBuffers, 1)
+	require.Equal(t, hmTypes.RootChainTypeEth, staleBuffers[0].RootChain)
+	require.Equal(t, ethCheckpoint, staleBuffers[0].Checkpoint)
+	require.Equal(t, uint64(600), staleBuffers[0].Age)
+
+	// both buffers are older than 30 seconds
+	require.Len(t, query(30), 2)
+
+	// nothing is older than an hour
+	require.Empty(t, query(3600))
 }
 
 func (suite *QuerierTestSuite) TestQueryLastNoAck() {
@@ -207,6 +756,94 @@ func (suite *QuerierTestSuite) TestQueryLastNoAck() {
 
 }
 
+func (suite *QuerierTestSuite) TestQueryNoAckCountdown() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryNoAckCountdown}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNoAckCountdown)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: []byte{},
+	}
+
+	bufferTime := app.CheckpointKeeper.GetParams(ctx).CheckpointBufferTime
+	noAck := uint64(time.Now().Unix())
+	app.CheckpointKeeper.SetLastNoAck(ctx, noAck)
+
+	// no-ack just happened, full buffer time is left
+	ctx = ctx.WithBlockTime(time.Unix(int64(noAck), 0))
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var countdown uint64
+	require.NoError(t, json.Unmarshal(res, &countdown))
+	require.Equal(t, uint64(bufferTime.Seconds()), countdown)
+
+	// buffer time elapsed, no-ack is allowed immediately
+	ctx = ctx.WithBlockTime(time.Unix(int64(noAck), 0).Add(bufferTime))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &countdown))
+	require.Equal(t, uint64(0), countdown)
+}
+
+func (suite *QuerierTestSuite) TestQueryNoAckCountdownEpochDeadline() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	handler := checkpoint.NewHandler(keeper, &suite.contractCaller)
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	deadline := 100 * time.Second
+	params := keeper.GetParams(ctx)
+	params.CheckpointBufferTime = types.DefaultCheckpointBufferTime
+	params.EpochDeadline = deadline
+	keeper.SetParams(ctx, params)
+
+	// epoch 2 began right after first checkpoint was acked
+	epochStart := time.Unix(1600000000, 0)
+	checkpointBlock := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(epochStart.Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpointBlock, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCountWithValue(ctx, 1, hmTypes.RootChainTypeStake)
+	keeper.SetEpochStartTime(ctx, 2, uint64(epochStart.Unix()))
+
+	path := []string{types.QueryNoAckCountdown}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNoAckCountdown),
+		Data: []byte{},
+	}
+
+	// countdown ends at epoch deadline rather than after buffer time
+	res, err := querier(ctx.WithBlockTime(epochStart), path, req)
+	require.NoError(t, err)
+
+	var countdown uint64
+	require.NoError(t, json.Unmarshal(res, &countdown))
+	require.Equal(t, uint64(deadline.Seconds()), countdown)
+
+	// handler rejects no-ack one second before countdown ends and accepts it when it ends
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), hmTypes.RootChainTypeStake)
+	result := handler(ctx.WithBlockTime(epochStart.Add(deadline-time.Second)), msgNoAck)
+	require.False(t, result.IsOK())
+
+	deadlineCtx := ctx.WithBlockTime(epochStart.Add(deadline))
+	res, err = querier(deadlineCtx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &countdown))
+	require.Equal(t, uint64(0), countdown)
+
+	result = handler(deadlineCtx, msgNoAck)
+	require.True(t, result.IsOK(), "expected expedited no-ack to be ok, got %v", result)
+
+	// next expedited no-ack is spaced by epoch deadline
+	res, err = querier(deadlineCtx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &countdown))
+	require.Equal(t, uint64(deadline.Seconds()), countdown)
+}
+
 func (suite *QuerierTestSuite) TestQueryCheckpointList() {
 	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
 
@@ -285,7 +922,7 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 		timestamp,
 	)
 
-	suite.contractCaller.On("GetRootHash", checkpointBlock.StartBlock, checkpointBlock.EndBlock, uint64(1024)).Return(checkpointBlock.RootHash.Bytes(), nil)
+	suite.contractCaller.On("GetRootHash", mock.Anything, checkpointBlock.StartBlock, checkpointBlock.EndBlock, uint64(1024)).Return(checkpointBlock.RootHash.Bytes(), nil)
 	app.CheckpointKeeper.AddCheckpoint(ctx, headerNumber, checkpointBlock, hmTypes.RootChainTypeStake)
 
 	path := []string{types.QueryNextCheckpoint}
@@ -306,4 +943,144 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.EndBlock, actualRes.EndBlock)
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
+	require.Equal(t, hmTypes.RootChainTypeStake, actualRes.RootChainType)
+
+	// next checkpoint of other root chain follows its last acked checkpoint
+	require.NoError(t, app.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpointBlock, hmTypes.RootChainTypeEth))
+	app.CheckpointKeeper.UpdateACKCount(ctx, hmTypes.RootChainTypeEth)
+
+	nextRootHash := hmTypes.HexToHeimdallHash("456")
+	suite.contractCaller.On("GetRootHash", mock.Anything, endBlock+1, endBlock+1+256, uint64(1024)).Return(nextRootHash.Bytes(), nil)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryNextCheckpointParams(borChainId, hmTypes.RootChainTypeEth))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &actualRes))
+
+	accountRoot, rootErr := app.CheckpointKeeper.GetAccountRoot(ctx, hmTypes.RootChainTypeEth)
+	require.NoError(t, rootErr)
+	require.Equal(t, endBlock+1, actualRes.StartBlock)
+	require.Equal(t, endBlock+1+256, actualRes.EndBlock)
+	require.Equal(t, nextRootHash, actualRes.RootHash)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(accountRoot), actualRes.AccountRootHash)
+	require.Equal(t, hmTypes.RootChainTypeEth, actualRes.RootChainType)
+}
+
+func (suite *QuerierTestSuite) TestQueryNextCheckpointShape() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	app.TopupKeeper.AddDividendAccount(ctx, dividendAccount)
+
+	path := []string{types.QueryNextCheckpointShape}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextCheckpointShape)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(0, hmTypes.RootChainTypeStake)),
+	}
+
+	accountRoot, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	proposer := app.StakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+
+	// no checkpoint yet
+	res, sdkErr := querier(ctx, path, req)
+	require.NoError(t, sdkErr)
+
+	var shape types.NextCheckpointShape
+	require.NoError(t, json.Unmarshal(res, &shape))
+	require.Equal(t, types.NextCheckpointShape{
+		RootChain:       hmTypes.RootChainTypeStake,
+		StartBlock:      keeper.GetFirstCheckpointStart(ctx, hmTypes.RootChainTypeStake),
+		Epoch:           1,
+		AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot),
+		Proposer:        proposer,
+	}, shape)
+
+	// next checkpoint extends last one
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), proposer, "1234", uint64(time.Now().Unix()))
+	require.NoError(t, keeper.AddCheckpoint(ctx, 1, checkpoint, hmTypes.RootChainTypeStake))
+	keeper.UpdateACKCount(ctx, hmTypes.RootChainTypeStake)
+
+	res, sdkErr = querier(ctx, path, req)
+	require.NoError(t, sdkErr)
+	require.NoError(t, json.Unmarshal(res, &shape))
+	require.Equal(t, uint64(256), shape.StartBlock)
+	require.Equal(t, uint64(2), shape.Epoch)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointReadiness() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.CheckpointKeeper
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	}
+	app.TopupKeeper.AddDividendAccount(ctx, dividendAccount)
+
+	accountRoot, err := keeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	proposer := app.StakingKeeper.GetValidatorSet(ctx).Proposer.Signer
+	start := keeper.GetFirstCheckpointStart(ctx, hmTypes.RootChainTypeStake)
+
+	path := []string{types.QueryCheckpointReadiness}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointReadiness)
+	queryRootChain := func(rootChain string, startBlock, endBlock uint64) types.CheckpointReadiness {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointReadinessParams(rootChain, startBlock, endBlock)),
+		}
+		res, sdkErr := querier(ctx, path, req)
+		require.NoError(t, sdkErr)
+
+		var readiness types.CheckpointReadiness
+		require.NoError(t, json.Unmarshal(res, &readiness))
+		return readiness
+	}
+	query := func(startBlock, endBlock uint64) types.CheckpointReadiness {
+		return queryRootChain(hmTypes.RootChainTypeStake, startBlock, endBlock)
+	}
+
+	// empty root chain defaults to stake chain, like other queries
+	require.Equal(t, query(start, start+255), queryRootChain("", start, start+255))
+
+	// checkpoint extending tip with free buffer is ready
+	require.Equal(t, types.CheckpointReadiness{
+		RootChain:       hmTypes.RootChainTypeStake,
+		StartBlock:      start,
+		EndBlock:        start + 255,
+		Epoch:           1,
+		AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot),
+		Proposer:        proposer,
+		BufferFree:      true,
+		Ready:           true,
+	}, query(start, start+255))
+
+	// checkpoint out of continuity is not
+	readiness := query(start+1, start+255)
+	require.False(t, readiness.Ready)
+	require.Len(t, readiness.Errors, 1)
+
+	// buffered checkpoint blocks next one until buffer time passes
+	timestamp := uint64(ctx.BlockTime().Unix())
+	checkpoint := hmTypes.CreateBlock(start, start+255, hmTypes.HexToHeimdallHash("123"), proposer, "1234", timestamp)
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint, hmTypes.RootChainTypeStake))
+
+	readiness = query(start, start+255)
+	require.False(t, readiness.Ready)
+	require.False(t, readiness.BufferFree)
+	require.Equal(t, timestamp+uint64(keeper.GetParams(ctx).GetCheckpointBufferTime(hmTypes.RootChainTypeStake).Seconds()), readiness.BufferExpiry)
+
+	// readiness check doesn't flush buffer
+	_, err = keeper.GetCheckpointFromBuffer(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
 }
@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,8 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 			return SideHandleMsgCheckpointSync(ctx, k, msg, contractCaller)
 		case types.MsgCheckpointSyncAck:
 			return SideHandleMsgCheckpointSyncAck(ctx, k, msg, contractCaller)
+		case types.MsgCheckpointCancel:
+			return SideHandleMsgCheckpointCancel(ctx, k, msg, contractCaller)
 		default:
 			return abci.ResponseDeliverSideTx{
 				Code: uint32(sdk.CodeUnknownRequest),
@@ -47,7 +50,7 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	logger := k.Logger(ctx)
 
 	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller, maticTxConfirmations)
+	validCheckpoint, err := types.ValidateCheckpoint(ctx.Context(), msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller, maticTxConfirmations)
 	if err != nil {
 		logger.Error("Error validating checkpoint",
 			"error", err,
@@ -72,6 +75,18 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 
 // SideHandleMsgCheckpointAck handles MsgCheckpointAck message for external call
 func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	// with verification enabled ack not matching root chain contract is reported with dedicated code
+	if k.IsUpgradeActive(ctx) && k.GetParams(ctx).VerifyAckOnRootChain && msg.RootChainType != hmTypes.RootChainTypeTest {
+		if err := verifyAckOnRootChain(ctx, k, msg, contractCaller); err != nil {
+			k.Logger(ctx).Error("Ack doesn't match root chain contract", "root", msg.RootChainType, "number", msg.Number, "error", err)
+			return common.ErrorSideTx(k.Codespace(), common.CodeAckNotOnRootChain)
+		}
+
+		// say `yes`
+		result.Result = abci.SideTxResultType_Yes
+		return
+	}
+
 	if msg.RootChainType == hmTypes.RootChainTypeTron {
 		return SideHandleMsgTronCheckpointAck(ctx, k, msg, contractCaller)
 	}
@@ -87,6 +102,11 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	params := k.GetParams(ctx)
 	chainParams := k.ck.GetParams(ctx).ChainParams
 
+	// test root chain has no contract, ack is accepted as is
+	if msg.RootChainType == hmTypes.RootChainTypeTest {
+		return sideHandleTestRootChain(ctx, k, msg.RootChainType)
+	}
+
 	//
 	// Validate data from root chain
 	//
@@ -94,13 +114,14 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	switch msg.RootChainType {
 	case hmTypes.RootChainTypeEth:
 		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
+	default:
+		// registered eth fork root chains keep their contracts in chain manager
+		otherChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
 		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not match bsc")
+			k.Logger(ctx).Error("No chain params for root chain", "root", msg.RootChainType, "error", err)
 			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
+		rootChainAddress = otherChain.RootChainAddress.EthAddress()
 	}
 	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
 	if err != nil {
@@ -108,7 +129,7 @@ func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
 
-	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(ctx.Context(), msg.Number, rootChainInstance, params.ChildBlockInterval)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -141,7 +162,7 @@ func SideHandleMsgTronCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	// Validate data from root chain
 	//
 	if msg.RootChainType == hmTypes.RootChainTypeTron {
-		root, start, end, _, proposer, err := contractCaller.GetTronHeaderInfo(msg.Number, chainParams.TronChainAddress, params.ChildBlockInterval)
+		root, start, end, _, proposer, err := contractCaller.GetTronHeaderInfo(ctx.Context(), msg.Number, chainParams.TronChainAddress, params.ChildBlockInterval)
 		if err != nil {
 			logger.Error("Unable to fetch checkpoint from tron", "error", err, "checkpointNumber", msg.Number)
 			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -176,6 +197,11 @@ func SideHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 	params := k.GetParams(ctx)
 	chainParams := k.ck.GetParams(ctx).ChainParams
 
+	// test root chain has no contract, sync is accepted as is
+	if msg.RootChainType == hmTypes.RootChainTypeTest {
+		return sideHandleTestRootChain(ctx, k, msg.RootChainType)
+	}
+
 	//
 	// Validate data from root chain
 	//
@@ -189,20 +215,21 @@ func SideHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 	switch msg.RootChainType {
 	case hmTypes.RootChainTypeEth:
 		rootChainAddress = chainParams.RootChainAddress.EthAddress()
-	case hmTypes.RootChainTypeBsc:
-		bscChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
+	default:
+		// registered eth fork root chains keep their contracts in chain manager
+		otherChain, err := k.ck.GetChainParams(ctx, msg.RootChainType)
 		if err != nil {
-			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, "does not  match bsc")
+			k.Logger(ctx).Error("No chain params for root chain", "root", msg.RootChainType, "error", err)
 			return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 		}
-		rootChainAddress = bscChain.RootChainAddress.EthAddress()
+		rootChainAddress = otherChain.RootChainAddress.EthAddress()
 	}
 	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, msg.RootChainType)
 	if err != nil {
 		logger.Error("Unable to fetch rootchain contract instance", "root", msg.RootChainType, "error", err)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
 	}
-	_, start, end, _, proposer, err = contractCaller.GetHeaderInfo(msg.Number, rootChainInstance, params.ChildBlockInterval)
+	_, start, end, _, proposer, err = contractCaller.GetHeaderInfo(ctx.Context(), msg.Number, rootChainInstance, params.ChildBlockInterval)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain",
 			"root", msg.RootChainType, "error", err, "checkpointNumber", msg.Number)
@@ -235,10 +262,15 @@ func SideHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 
 	chainParams := k.ck.GetParams(ctx).ChainParams
 
+	// test root chain has no contract, sync ack is accepted as is
+	if msg.RootChainType == hmTypes.RootChainTypeTest {
+		return sideHandleTestRootChain(ctx, k, msg.RootChainType)
+	}
+
 	//
 	// Validate data from root chain
 	//
-	currentNumber, err := contractCaller.GetSyncedCheckpointId(chainParams.TronStakingManagerAddress, msg.RootChainType)
+	currentNumber, err := contractCaller.GetSyncedCheckpointId(ctx.Context(), chainParams.TronStakingManagerAddress, msg.RootChainType)
 	if err != nil {
 		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "checkpointNumber", msg.Number)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -254,6 +286,101 @@ func SideHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	return
 }
 
+// SideHandleMsgCheckpointCancel handles MsgCheckpointCancel message for external call. Checkpoint
+// already submitted to root chain must be acked, not cancelled.
+func SideHandleMsgCheckpointCancel(ctx sdk.Context, k Keeper, msg types.MsgCheckpointCancel, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+	logger.Debug("✅ Validating External call for checkpoint cancel msg",
+		"root", msg.RootChainType,
+		"start", msg.StartBlock,
+		"end", msg.EndBlock,
+		"ackCount", msg.AckCount,
+	)
+
+	// test root chain has no contract, cancel is accepted as is
+	if msg.RootChainType == hmTypes.RootChainTypeTest {
+		return sideHandleTestRootChain(ctx, k, msg.RootChainType)
+	}
+
+	//
+	// Validate data from root chain
+	//
+	number := msg.AckCount + 1
+	_, _, _, createdAt, _, err := getRootChainHeader(ctx, k, msg.RootChainType, number, contractCaller)
+	if err != nil {
+		logger.Error("Unable to fetch checkpoint from rootchain", "error", err, "root", msg.RootChainType, "checkpointNumber", number)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidCheckpointCancel)
+	}
+
+	if createdAt != 0 {
+		logger.Error("Checkpoint is already submitted to root chain", "root", msg.RootChainType, "checkpointNumber", number)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidCheckpointCancel)
+	}
+
+	// say `yes`
+	result.Result = abci.SideTxResultType_Yes
+
+	return
+}
+
+// verifyAckOnRootChain reads checkpoint msg.Number from root chain contract and returns error
+// if its root hash, block range or proposer doesn't match the ack
+func verifyAckOnRootChain(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) error {
+	root, start, end, _, proposer, err := getRootChainHeader(ctx, k, msg.RootChainType, msg.Number, contractCaller)
+	if err != nil {
+		return err
+	}
+
+	if msg.StartBlock != start ||
+		msg.EndBlock != end ||
+		!msg.Proposer.Equals(proposer) ||
+		!bytes.Equal(msg.RootHash.Bytes(), root.Bytes()) {
+		return fmt.Errorf("root chain has checkpoint %d-%d with root %s proposed by %s", start, end, root.Hex(), proposer.String())
+	}
+	return nil
+}
+
+// getRootChainHeader reads checkpoint number from root chain contract, createdAt is zero if it is not submitted yet
+func getRootChainHeader(ctx sdk.Context, k Keeper, rootChain string, number uint64, contractCaller helper.IContractCaller) (
+	root ethCommon.Hash,
+	start, end, createdAt uint64,
+	proposer hmTypes.HeimdallAddress,
+	err error,
+) {
+	childBlockInterval := k.GetParams(ctx).ChildBlockInterval
+	chainParams := k.ck.GetParams(ctx).ChainParams
+
+	if rootChain == hmTypes.RootChainTypeTron {
+		return contractCaller.GetTronHeaderInfo(ctx.Context(), number, chainParams.TronChainAddress, childBlockInterval)
+	}
+
+	rootChainAddress := chainParams.RootChainAddress.EthAddress()
+	if rootChain != hmTypes.RootChainTypeEth {
+		chainInfo, chainErr := k.ck.GetChainParams(ctx, rootChain)
+		if chainErr != nil {
+			return root, start, end, createdAt, proposer, chainErr
+		}
+		rootChainAddress = chainInfo.RootChainAddress.EthAddress()
+	}
+
+	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress, rootChain)
+	if err != nil {
+		return root, start, end, createdAt, proposer, err
+	}
+	return contractCaller.GetHeaderInfo(ctx.Context(), number, rootChainInstance, childBlockInterval)
+}
+
+// sideHandleTestRootChain votes for msg on test root chain which has no contract to validate against
+func sideHandleTestRootChain(ctx sdk.Context, k Keeper, rootChain string) (result abci.ResponseDeliverSideTx) {
+	if !k.GetParams(ctx).IsRootChainAllowed(rootChain) {
+		k.Logger(ctx).Error("Root chain is not enabled", "root", rootChain)
+		return common.ErrorSideTx(k.Codespace(), common.CodeWrongRootChain)
+	}
+
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
 //
 // Tx handler
 //
@@ -272,6 +399,8 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 			return PostHandleMsgCheckpointSync(ctx, k, msg, sideTxResult)
 		case types.MsgCheckpointSyncAck:
 			return PostHandleMsgCheckpointSyncAck(ctx, k, msg, sideTxResult)
+		case types.MsgCheckpointCancel:
+			return PostHandleMsgCheckpointCancel(ctx, k, msg, sideTxResult)
 		default:
 			return sdk.ErrUnknownRequest("Unrecognized checkpoint Msg type").Result()
 		}
@@ -313,11 +442,13 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
 		}
 	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() {
-		activation := k.ck.GetChainActivationHeight(ctx, msg.RootChainType)
-		if activation != msg.StartBlock {
-			logger.Error("First checkpoint to start from block active height",
-				"activation", activation, "start", msg.StartBlock, "root", msg.RootChainType)
-			return common.ErrBadBlockDetails(k.Codespace()).Result()
+		firstStart := k.GetFirstCheckpointStart(ctx, msg.RootChainType)
+		if firstStart != msg.StartBlock {
+			logger.Error("First checkpoint to start from first checkpoint start block",
+				"firstStart", firstStart, "start", msg.StartBlock, "root", msg.RootChainType)
+			return typedError(k.IsUpgradeActive(ctx),
+				common.ErrInvalidCheckpointStart(k.Codespace(), msg.RootChainType, firstStart, msg.StartBlock),
+				common.ErrBadBlockDetails(k.Codespace())).Result()
 		}
 	}
 
@@ -330,7 +461,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 
 		// get checkpoint buffer time from params
 		params := k.GetParams(ctx)
-		expiryTime := checkpointBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+		expiryTime := checkpointBuffer.TimeStamp + uint64(params.GetCheckpointBufferTime(msg.RootChainType).Seconds())
 
 		// return with error (ack is required)
 		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
@@ -348,6 +479,8 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 		TimeStamp:  timeStamp,
 	}, msg.RootChainType)
 
+	k.AppendCheckpointLifecycle(ctx, types.LifecycleBuffered, msg.RootChainType, msg.StartBlock, msg.EndBlock)
+
 	logger.Debug("New checkpoint into buffer stored",
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
@@ -360,21 +493,19 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	hash := tmTypes.Tx(txBytes).Hash()
 
 	// Emit event for checkpoints
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpoint,
-			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
-			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
-			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
-			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpoint, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+		sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+		sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -396,7 +527,7 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	checkpointObj, err := k.GetCheckpointFromBuffer(ctx, msg.RootChainType)
 	if err != nil {
 		logger.Error("Unable to get checkpoint buffer", "error", err, "root", msg.RootChainType)
-		return common.ErrBadAck(k.Codespace()).Result()
+		return ackBufferNotFoundError(ctx, k, msg).Result()
 	}
 
 	// invalid start block
@@ -445,7 +576,11 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 
 	// Flush buffer
 	k.UpdateACKCount(ctx, msg.RootChainType)
+	k.SetCheckpointAcked(ctx, msg.Number, msg.RootChainType, k.GetACKCount(ctx, msg.RootChainType))
+	k.SetLastAckNumber(ctx, msg.RootChainType, msg.Number)
+	k.SetCheckpointPower(ctx, msg.Number, msg.RootChainType, checkpointObj.Proposer)
 	k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+	k.AppendCheckpointLifecycle(ctx, types.LifecycleAcked, msg.RootChainType, checkpointObj.StartBlock, checkpointObj.EndBlock)
 
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack", "root", msg.RootChainType)
 
@@ -458,24 +593,31 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	if msg.RootChainType == hmTypes.RootChainTypeStake {
 		// Increment accum (selects new proposer)
 		k.sk.IncrementAccum(ctx, 1)
+
+		// proposers are live again, backup proposers step down
+		k.SetNoAckStreak(ctx, 0)
+
+		// ack starts next epoch
+		k.SetEpochStartTime(ctx, k.GetACKCount(ctx, hmTypes.RootChainTypeStake)+1, uint64(ctx.BlockTime().Unix()))
 	}
 
+	// notify other modules
+	k.AfterCheckpointAck(ctx, msg.RootChainType, checkpointObj.StartBlock, checkpointObj.EndBlock)
+
 	// TX bytes
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
 
 	// Emit event for checkpoints
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointAck,
-			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
-			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
-			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointAck, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+		sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+		sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -509,7 +651,7 @@ func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 
 		// get checkpoint buffer time from params
 		params := k.GetParams(ctx)
-		expiryTime := checkpointSyncBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
+		expiryTime := checkpointSyncBuffer.TimeStamp + uint64(params.GetCheckpointBufferTime(msg.RootChainType).Seconds())
 
 		// return with error (ack is required)
 		return common.ErrNoACK(k.Codespace(), expiryTime).Result()
@@ -537,20 +679,18 @@ func PostHandleMsgCheckpointSync(ctx sdk.Context, k Keeper, msg types.MsgCheckpo
 	hash := tmTypes.Tx(txBytes).Hash()
 
 	// Emit event for checkpoints
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointSync,
-			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
-			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
-			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointSync, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+		sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+		sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -579,17 +719,58 @@ func PostHandleMsgCheckpointSyncAck(ctx sdk.Context, k Keeper, msg types.MsgChec
 	hash := tmTypes.Tx(txBytes).Hash()
 
 	// Emit event for checkpoints
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointSyncAck,
-			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
-			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
-			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
-			sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
-		),
-	})
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointSyncAck, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+		sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+		sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.Number, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgCheckpointCancel handles msg checkpoint cancel
+func PostHandleMsgCheckpointCancel(ctx sdk.Context, k Keeper, msg types.MsgCheckpointCancel, sideTxResult abci.SideTxResultType) sdk.Result {
+	logger := k.Logger(ctx)
+
+	// Skip handler if checkpoint-cancel is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		logger.Debug("Skipping checkpoint-cancel since side-tx didn't get yes votes",
+			"startBlock", msg.StartBlock, "endBlock", msg.EndBlock, "root", msg.RootChainType)
+		return common.ErrInvalidCheckpointCancel(k.Codespace(), "rejected by validators").Result()
+	}
+
+	// buffer may have been acked or replaced since cancel was checked
+	checkpointBuffer, sdkErr := validateCheckpointCancel(ctx, k, msg)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	k.FlushCheckpointBuffer(ctx, msg.RootChainType)
+	k.AppendCheckpointLifecycle(ctx, types.LifecycleCancelled, msg.RootChainType, checkpointBuffer.StartBlock, checkpointBuffer.EndBlock)
+	logger.Info("Checkpoint buffer cancelled", "root", msg.RootChainType, "startBlock", checkpointBuffer.StartBlock, "endBlock", checkpointBuffer.EndBlock, "from", msg.From.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// Emit event for checkpoint cancel
+	ctx.EventManager().EmitEvents(types.NewRootChainEvents(
+		k.GetParams(ctx).EventTypeMode, types.EventTypeCheckpointCancel, msg.RootChainType,
+		sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+		sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+		sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+		sdk.NewAttribute(types.AttributeKeyProposer, msg.From.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootChain, msg.RootChainType),
+	))
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
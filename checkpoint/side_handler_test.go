@@ -1,6 +1,8 @@
 package checkpoint_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -91,8 +93,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpoint() {
 			hmTypes.RootChainTypeEth,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
-		suite.contractCaller.On("GetRootHash", header.StartBlock, header.EndBlock, uint64(1024)).Return(header.RootHash.Bytes(), nil)
+		suite.contractCaller.On("CheckIfBlocksExist", mock.Anything, header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
+		suite.contractCaller.On("GetRootHash", mock.Anything, header.StartBlock, header.EndBlock, uint64(1024)).Return(header.RootHash.Bytes(), nil)
 
 		result := suite.sideHandler(ctx, msgCheckpoint)
 		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
@@ -116,8 +118,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpoint() {
 			hmTypes.RootChainTypeEth,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
-		suite.contractCaller.On("GetRootHash", header.StartBlock, header.EndBlock, uint64(1024)).Return(nil, nil)
+		suite.contractCaller.On("CheckIfBlocksExist", mock.Anything, header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
+		suite.contractCaller.On("GetRootHash", mock.Anything, header.StartBlock, header.EndBlock, uint64(1024)).Return(nil, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpoint)
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should Fail")
@@ -144,8 +146,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpoint() {
 			hmTypes.RootChainTypeEth,
 		)
 
-		suite.contractCaller.On("CheckIfBlocksExist", header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
-		suite.contractCaller.On("GetRootHash", header.StartBlock, header.EndBlock, uint64(1024)).Return([]byte{1}, nil)
+		suite.contractCaller.On("CheckIfBlocksExist", mock.Anything, header.EndBlock+cmTypes.DefaultMaticchainTxConfirmations).Return(true)
+		suite.contractCaller.On("GetRootHash", mock.Anything, header.StartBlock, header.EndBlock, uint64(1024)).Return([]byte{1}, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpoint)
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
@@ -180,7 +182,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 		rootchainInstance := &rootchain.Rootchain{}
 
 		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
@@ -205,7 +207,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 		rootchainInstance := &rootchain.Rootchain{}
 
 		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
-		suite.contractCaller.On("GetHeaderInfo", headerId, rootchainInstance, params.ChildBlockInterval).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, headerId, rootchainInstance, params.ChildBlockInterval).Return(nil, header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
 
 		result := suite.sideHandler(ctx, msgCheckpointAck)
 		require.NotEqual(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should fail")
@@ -213,6 +215,146 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 	})
 }
 
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAckVerifyOnRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	proposer := hmTypes.HexToHeimdallAddress("123")
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	msgCheckpointAck := types.NewMsgCheckpointAck(proposer, 1, proposer, 0, 255, rootHash, hmTypes.HexToHeimdallHash("123123"), uint64(1), hmTypes.RootChainTypeEth)
+
+	params.VerifyAckOnRootChain = true
+	keeper.SetParams(ctx, params)
+
+	suite.Run("Mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		// root chain recorded different root hash
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, uint64(1), rootchainInstance, params.ChildBlockInterval).Return(hmTypes.HexToHeimdallHash("456").EthHash(), uint64(0), uint64(255), uint64(0), proposer, nil)
+
+		result := suite.sideHandler(ctx, msgCheckpointAck)
+		require.Equal(t, uint32(errs.CodeAckNotOnRootChain), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Match", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, uint64(1), rootchainInstance, params.ChildBlockInterval).Return(rootHash.EthHash(), uint64(0), uint64(255), uint64(0), proposer, nil)
+
+		result := suite.sideHandler(ctx, msgCheckpointAck)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointSync() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	header, _ := chSim.GenRandCheckpoint(0, uint64(256), params.MaxCheckpointLength)
+	headerId := uint64(1)
+	newSync := func(end uint64, rootChain string) types.MsgCheckpointSync {
+		return types.NewMsgCheckpointSync(hmTypes.HexToHeimdallAddress("123"), header.Proposer, headerId, header.StartBlock, end, rootChain)
+	}
+
+	suite.Run("Success", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+
+		result := suite.sideHandler(ctx, newSync(header.EndBlock, hmTypes.RootChainTypeEth))
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Mismatch", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, headerId, rootchainInstance, params.ChildBlockInterval).Return(header.RootHash.EthHash(), header.StartBlock, header.EndBlock, header.TimeStamp, header.Proposer, nil)
+
+		result := suite.sideHandler(ctx, newSync(header.EndBlock+1, hmTypes.RootChainTypeEth))
+		require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+
+	suite.Run("Test root chain", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		result := suite.sideHandler(ctx, newSync(header.EndBlock, hmTypes.RootChainTypeTest))
+		require.Equal(t, uint32(common.CodeWrongRootChain), result.Code)
+
+		params.EnableTestRootChain = true
+		keeper.SetParams(ctx, params)
+
+		result = suite.sideHandler(ctx, newSync(header.EndBlock, hmTypes.RootChainTypeTest))
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+		suite.contractCaller.AssertNotCalled(t, "GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointSyncAck() {
+	t, ctx := suite.T(), suite.ctx
+
+	newSyncAck := func(number uint64) types.MsgCheckpointSyncAck {
+		return types.NewMsgCheckpointSyncAck(hmTypes.HexToHeimdallAddress("123"), number, 0, 255, hmTypes.RootChainTypeEth)
+	}
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.contractCaller.On("GetSyncedCheckpointId", mock.Anything, mock.Anything, hmTypes.RootChainTypeEth).Return(uint64(2), nil)
+
+	result := suite.sideHandler(ctx, newSyncAck(2))
+	require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+	// number not yet synced on root chain
+	result = suite.sideHandler(ctx, newSyncAck(3))
+	require.Equal(t, uint32(common.CodeInvalidACK), result.Code)
+	require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointCancel() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+
+	proposer := hmTypes.HexToHeimdallAddress("456")
+	msgCancel := types.NewMsgCheckpointCancel(proposer, 0, 0, 255, hmTypes.RootChainTypeEth)
+	rootHash := hmTypes.HexToHeimdallHash("123").EthHash()
+
+	suite.Run("Not on root chain", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, uint64(1), rootchainInstance, params.ChildBlockInterval).Return(rootHash, uint64(0), uint64(0), uint64(0), hmTypes.HeimdallAddress{}, nil)
+
+		result := suite.sideHandler(ctx, msgCancel)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+	})
+
+	suite.Run("Submitted on root chain", func() {
+		suite.contractCaller = mocks.IContractCaller{}
+
+		// checkpoint landed on root chain, its ack must not be orphaned by cancel
+		rootchainInstance := &rootchain.Rootchain{}
+		suite.contractCaller.On("GetRootChainInstance", mock.Anything, mock.Anything).Return(rootchainInstance, nil)
+		suite.contractCaller.On("GetHeaderInfo", mock.Anything, uint64(1), rootchainInstance, params.ChildBlockInterval).Return(rootHash, uint64(0), uint64(255), uint64(1600000000), proposer, nil)
+
+		result := suite.sideHandler(ctx, msgCancel)
+		require.Equal(t, uint32(errs.CodeInvalidCheckpointCancel), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result, "Result should skip")
+	})
+}
+
 func (suite *SideHandlerTestSuite) TestPostHandler() {
 	t, ctx := suite.T(), suite.ctx
 
@@ -444,3 +586,190 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAckStatus() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	params := keeper.GetParams(ctx)
+	rootChain := hmTypes.RootChainTypeEth
+
+	keeper.SetUpgradeHeight(ctx, 10)
+
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	ackCheckpoint := func(ctx sdk.Context, number uint64, start uint64) uint64 {
+		header, err := chSim.GenRandCheckpoint(start, uint64(256), params.MaxCheckpointLength)
+		require.NoError(t, err)
+
+		msgCheckpoint := types.NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RootHash, "1234", 1, rootChain)
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		msgCheckpointAck := types.NewMsgCheckpointAck(hmTypes.HexToHeimdallAddress("123"), number, header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, hmTypes.HexToHeimdallHash("123123"), uint64(1), rootChain)
+		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+		return header.EndBlock
+	}
+
+	// ack status is not recorded before upgrade
+	end := ackCheckpoint(ctx.WithBlockHeight(9), 1, 0)
+	require.False(t, ctx.KVStore(app.GetKey(types.StoreKey)).Has(checkpoint.GetCheckpointAckKey(1, hmTypes.GetRootChainID(rootChain))))
+
+	upgradedCtx := ctx.WithBlockHeight(10)
+	ackCheckpoint(upgradedCtx, 2, end+1)
+
+	// checkpoint acked before upgrade is derived from stored checkpoint
+	acked, ackNumber := keeper.GetCheckpointAckStatus(upgradedCtx, 1, rootChain)
+	require.True(t, acked)
+	require.Equal(t, uint64(1), ackNumber)
+
+	acked, ackNumber = keeper.GetCheckpointAckStatus(upgradedCtx, 2, rootChain)
+	require.True(t, acked)
+	require.Equal(t, uint64(2), ackNumber)
+
+	acked, _ = keeper.GetCheckpointAckStatus(upgradedCtx, 3, rootChain)
+	require.False(t, acked)
+}
+
+// recordedAck is a checkpoint ack passed to hooks
+type recordedAck struct {
+	rootChain  string
+	startBlock uint64
+	endBlock   uint64
+}
+
+// ackHooksRecorder records checkpoint acks passed to hooks
+type ackHooksRecorder struct {
+	acks []recordedAck
+}
+
+func (h *ackHooksRecorder) AfterCheckpointAck(ctx sdk.Context, rootChain string, startBlock uint64, endBlock uint64) {
+	h.acks = append(h.acks, recordedAck{rootChain: rootChain, startBlock: startBlock, endBlock: endBlock})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAckHooks() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	hooks := &ackHooksRecorder{}
+	keeper := app.CheckpointKeeper
+	keeper.SetHooks(types.NewMultiCheckpointHooks(hooks))
+	postHandler := checkpoint.NewPostTxHandler(keeper, &suite.contractCaller)
+
+	params := keeper.GetParams(ctx)
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		header.RootHash,
+		"1234",
+		1,
+		hmTypes.RootChainTypeEth,
+	)
+	result := postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	msgCheckpointAck := types.NewMsgCheckpointAck(
+		hmTypes.HexToHeimdallAddress("123"),
+		uint64(1),
+		header.Proposer,
+		header.StartBlock,
+		header.EndBlock,
+		header.RootHash,
+		hmTypes.HexToHeimdallHash("123123"),
+		uint64(1),
+		hmTypes.RootChainTypeEth,
+	)
+
+	// rejected ack doesn't call hooks
+	result = postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_No)
+	require.False(t, result.IsOK())
+	require.Empty(t, hooks.acks)
+
+	result = postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+	require.Len(t, hooks.acks, 1)
+	require.Equal(t, recordedAck{rootChain: hmTypes.RootChainTypeEth, startBlock: header.StartBlock, endBlock: header.EndBlock}, hooks.acks[0])
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAckPower() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chSim.LoadValidatorSet(4, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+	validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+	proposer := validatorSet.Proposer.Signer
+
+	_, found := keeper.GetCheckpointPower(ctx, hmTypes.RootChainTypeEth)
+	require.False(t, found)
+
+	params := keeper.GetParams(ctx)
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+
+	msgCheckpoint := types.NewMsgCheckpointBlock(proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RootHash, "1234", 1, hmTypes.RootChainTypeEth)
+	result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	msgCheckpointAck := types.NewMsgCheckpointAck(hmTypes.HexToHeimdallAddress("123"), 1, proposer, header.StartBlock, header.EndBlock, header.RootHash, hmTypes.HexToHeimdallHash("123123"), uint64(1), hmTypes.RootChainTypeEth)
+	result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+	// power is captured at ack time
+	power, found := keeper.GetCheckpointPower(ctx, hmTypes.RootChainTypeEth)
+	require.True(t, found)
+	require.Equal(t, types.CheckpointPower{
+		Number:        1,
+		Proposer:      proposer,
+		ProposerPower: validatorSet.Proposer.VotingPower,
+		TotalPower:    validatorSet.TotalVotingPower(),
+	}, power)
+
+	// and served by querier
+	querier := checkpoint.NewQuerier(keeper, app.StakingKeeper, app.TopupKeeper, &suite.contractCaller)
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointPower),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(0, hmTypes.RootChainTypeEth)),
+	}
+	res, err := querier(ctx, []string{types.QueryCheckpointPower}, req)
+	require.NoError(t, err)
+
+	var queried types.CheckpointPower
+	require.NoError(t, json.Unmarshal(res, &queried))
+	require.Equal(t, power, queried)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointCancel() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+	rootChain := hmTypes.RootChainTypeEth
+
+	proposer := hmTypes.HexToHeimdallAddress("456")
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), proposer, "1234", uint64(ctx.BlockTime().Unix()))
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, checkpoint, rootChain))
+
+	msgCancel := types.NewMsgCheckpointCancel(proposer, 0, 0, 255, rootChain)
+
+	// cancel rejected by validators keeps buffer
+	result := suite.postHandler(ctx, msgCancel, abci.SideTxResultType_No)
+	require.Equal(t, errs.CodeInvalidCheckpointCancel, result.Code)
+
+	_, err := keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.NoError(t, err, "rejected cancel must keep buffer")
+
+	// approved cancel flushes buffer
+	result = suite.postHandler(ctx, msgCancel, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected cancel to be ok, got %v", result)
+
+	_, err = keeper.GetCheckpointFromBuffer(ctx, rootChain)
+	require.Error(t, err)
+
+	// buffer is gone, replayed cancel has nothing to flush
+	result = suite.postHandler(ctx, msgCancel, abci.SideTxResultType_Yes)
+	require.Equal(t, errs.CodeNoCheckpointBuffer, result.Code)
+}
@@ -1,50 +1,123 @@
 package simulation
 
 import (
+	"fmt"
+	"math/rand"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/types/simulation"
 )
 
-// RandomizedGenState return dummy genesis
-func RandomizedGenState(simState *module.SimulationState) {
-	lastNoACK := 0
-	ackCount := 1
-	startBlock := uint64(0)
-	endBlock := uint64(256)
-	rootHash := hmTypes.HexToHeimdallHash("123")
-
-	proposerAddress := hmTypes.HexToHeimdallAddress("123")
-	timestamp := uint64(time.Now().Unix())
-	borChainID := "1234"
-
-	bufferedCheckpoint := hmTypes.CreateBlock(
-		startBlock,
-		endBlock,
-		rootHash,
-		proposerAddress,
-		borChainID,
-		timestamp,
-	)
+// Simulation parameter constants
+const (
+	CheckpointBufferTime = "checkpoint_buffer_time"
+	AvgCheckpointLength  = "avg_checkpoint_length"
+	MaxCheckpointLength  = "max_checkpoint_length"
+	CheckpointRetention  = "checkpoint_retention"
+	CheckpointCount      = "checkpoint_count"
+)
 
-	Checkpoints := make([]hmTypes.Checkpoint, ackCount)
+// GenCheckpointBufferTime randomized CheckpointBufferTime
+func GenCheckpointBufferTime(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60)) * time.Second
+}
+
+// GenAvgCheckpointLength randomized AvgCheckpointLength
+func GenAvgCheckpointLength(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 16, 512))
+}
 
-	for i := range Checkpoints {
-		Checkpoints[i] = bufferedCheckpoint
+// GenMaxCheckpointLength randomized MaxCheckpointLength, never below any AvgCheckpointLength
+func GenMaxCheckpointLength(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 512, 2048))
+}
+
+// GenCheckpointRetention randomized CheckpointRetention, pruning is disabled half of the time
+func GenCheckpointRetention(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
 	}
+	return uint64(simulation.RandIntBetween(r, 1, 100))
+}
+
+// GenCheckpointCount randomized number of genesis checkpoints per root chain
+func GenCheckpointCount(r *rand.Rand) int {
+	return r.Intn(10)
+}
 
+// RandomizedGenState generates a random GenesisState for checkpoint
+func RandomizedGenState(simState *module.SimulationState) {
 	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointBufferTime, &params.CheckpointBufferTime, simState.Rand,
+		func(r *rand.Rand) { params.CheckpointBufferTime = GenCheckpointBufferTime(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AvgCheckpointLength, &params.AvgCheckpointLength, simState.Rand,
+		func(r *rand.Rand) { params.AvgCheckpointLength = GenAvgCheckpointLength(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCheckpointLength, &params.MaxCheckpointLength, simState.Rand,
+		func(r *rand.Rand) { params.MaxCheckpointLength = GenMaxCheckpointLength(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointRetention, &params.CheckpointRetention, simState.Rand,
+		func(r *rand.Rand) { params.CheckpointRetention = GenCheckpointRetention(r) },
+	)
+
+	var checkpointCount int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointCount, &checkpointCount, simState.Rand,
+		func(r *rand.Rand) { checkpointCount = GenCheckpointCount(r) },
+	)
+
+	checkpoints := genCheckpoints(simState, checkpointCount, params.AvgCheckpointLength)
+	tronCheckpoints := genCheckpoints(simState, checkpointCount, params.AvgCheckpointLength)
+
 	genesisState := types.NewGenesisState(
 		params,
-		&bufferedCheckpoint,
-		uint64(lastNoACK),
-		uint64(ackCount),
-		Checkpoints,
-		uint64(ackCount),
-		Checkpoints,
+		nil,
+		0,
+		uint64(len(checkpoints)),
+		checkpoints,
+		uint64(len(tronCheckpoints)),
+		tronCheckpoints,
 	)
+
+	fmt.Printf("Selected randomly generated checkpoint parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, genesisState.Params))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
+}
 
+// genCheckpoints generates count contiguous checkpoints starting at block 0
+func genCheckpoints(simState *module.SimulationState, count int, avgLength uint64) []hmTypes.Checkpoint {
+	var proposer hmTypes.HeimdallAddress
+	if len(simState.Accounts) != 0 {
+		account, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
+		proposer = account.Address
+	}
+
+	checkpoints := make([]hmTypes.Checkpoint, 0, count)
+	start := uint64(0)
+	for i := 0; i < count; i++ {
+		end := start + uint64(simState.Rand.Int63n(int64(avgLength)))
+		checkpoints = append(checkpoints, hmTypes.CreateBlock(
+			start,
+			end,
+			randomHash(simState.Rand),
+			proposer,
+			simBorChainID,
+			uint64(simState.GenTimestamp.Unix()),
+		))
+		start = end + 1
+	}
+	return checkpoints
 }
@@ -0,0 +1,227 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	hmSimulation "github.com/maticnetwork/heimdall/simulation"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCheckpoint      = "op_weight_msg_checkpoint"
+	OpWeightMsgCheckpointAck   = "op_weight_msg_checkpoint_ack"
+	OpWeightMsgCheckpointNoAck = "op_weight_msg_checkpoint_no_ack"
+	OpWeightMsgCheckpointSync  = "op_weight_msg_checkpoint_sync"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgCheckpoint      = 100
+	DefaultWeightMsgCheckpointAck   = 80
+	DefaultWeightMsgCheckpointNoAck = 20
+	DefaultWeightMsgCheckpointSync  = 40
+)
+
+// simBorChainID is bor chain id of simulated checkpoints
+const simBorChainID = "1234"
+
+// Keeper is checkpoint keeper state simulated messages are built from
+type Keeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetACKCount(ctx sdk.Context, rootChain string) uint64
+	GetExpectedAckNumber(ctx sdk.Context, rootChain string) uint64
+	GetCheckpointByNumber(ctx sdk.Context, number uint64, rootChain string) (hmTypes.Checkpoint, error)
+	GetCheckpointFromBuffer(ctx sdk.Context, rootChain string) (*hmTypes.Checkpoint, error)
+	GetNextCheckpointShape(ctx sdk.Context, rootChain string) (types.NextCheckpointShape, error)
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simTypes.AppParams,
+	cdc *codec.Codec,
+	k Keeper,
+	handler sdk.Handler,
+	postHandler hmTypes.PostTxHandler,
+) []simTypes.WeightedOperation {
+	var weightMsgCheckpoint int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpoint, &weightMsgCheckpoint, nil,
+		func(_ *rand.Rand) { weightMsgCheckpoint = DefaultWeightMsgCheckpoint },
+	)
+
+	var weightMsgCheckpointAck int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpointAck, &weightMsgCheckpointAck, nil,
+		func(_ *rand.Rand) { weightMsgCheckpointAck = DefaultWeightMsgCheckpointAck },
+	)
+
+	var weightMsgCheckpointNoAck int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpointNoAck, &weightMsgCheckpointNoAck, nil,
+		func(_ *rand.Rand) { weightMsgCheckpointNoAck = DefaultWeightMsgCheckpointNoAck },
+	)
+
+	var weightMsgCheckpointSync int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCheckpointSync, &weightMsgCheckpointSync, nil,
+		func(_ *rand.Rand) { weightMsgCheckpointSync = DefaultWeightMsgCheckpointSync },
+	)
+
+	return []simTypes.WeightedOperation{
+		hmSimulation.NewWeightedOperation(weightMsgCheckpoint, SimulateMsgCheckpoint(k, handler, postHandler)),
+		hmSimulation.NewWeightedOperation(weightMsgCheckpointAck, SimulateMsgCheckpointAck(k, handler, postHandler)),
+		hmSimulation.NewWeightedOperation(weightMsgCheckpointNoAck, SimulateMsgCheckpointNoAck(handler)),
+		hmSimulation.NewWeightedOperation(weightMsgCheckpointSync, SimulateMsgCheckpointSync(k, handler, postHandler)),
+	}
+}
+
+// SimulateMsgCheckpoint generates a MsgCheckpoint extending last checkpoint of a random root chain
+func SimulateMsgCheckpoint(k Keeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		rootChain := randomRootChain(r)
+
+		shape, err := k.GetNextCheckpointShape(ctx, rootChain)
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// span up to max checkpoint length, occasionally past it
+		maxLength := k.GetParams(ctx).MaxCheckpointLength
+		end := shape.StartBlock + uint64(r.Int63n(int64(maxLength)+1))
+
+		msg := types.NewMsgCheckpointBlock(
+			shape.Proposer,
+			shape.StartBlock,
+			end,
+			randomHash(r),
+			shape.AccountRootHash,
+			simBorChainID,
+			shape.Epoch,
+			rootChain,
+		)
+
+		ok := deliverSideMsg(ctx, handler, postHandler, msg)
+		return simTypes.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+// SimulateMsgCheckpointAck generates a MsgCheckpointAck for buffered checkpoint of a random root chain
+func SimulateMsgCheckpointAck(k Keeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		rootChain := randomRootChain(r)
+
+		buffer, err := k.GetCheckpointFromBuffer(ctx, rootChain)
+		if err != nil || buffer == nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgCheckpointAck(
+			from.Address,
+			k.GetExpectedAckNumber(ctx, rootChain),
+			buffer.Proposer,
+			buffer.StartBlock,
+			buffer.EndBlock,
+			buffer.RootHash,
+			randomHash(r),
+			uint64(r.Intn(10)),
+			rootChain,
+		)
+
+		ok := deliverSideMsg(ctx, handler, postHandler, msg)
+		return simTypes.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+// SimulateMsgCheckpointNoAck generates a MsgCheckpointNoAck from a random account
+func SimulateMsgCheckpointNoAck(handler sdk.Handler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgCheckpointNoAck(from.Address, hmTypes.RootChainTypeStake)
+
+		ok := deliverMsg(ctx, handler, msg)
+		return simTypes.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+// SimulateMsgCheckpointSync generates a MsgCheckpointSync of a random stake chain checkpoint to other root chain
+func SimulateMsgCheckpointSync(k Keeper, handler sdk.Handler, postHandler hmTypes.PostTxHandler) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (
+		simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		ackCount := k.GetACKCount(ctx, hmTypes.RootChainTypeStake)
+		if ackCount == 0 {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		number := uint64(r.Int63n(int64(ackCount))) + 1
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number, hmTypes.RootChainTypeStake)
+		if err != nil {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		rootChain := hmTypes.RootChainTypeEth
+		if r.Intn(2) == 0 {
+			rootChain = hmTypes.RootChainTypeBsc
+		}
+
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgCheckpointSync(from.Address, checkpoint.Proposer, number, checkpoint.StartBlock, checkpoint.EndBlock, rootChain)
+
+		ok := deliverSideMsg(ctx, handler, postHandler, msg)
+		return simTypes.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+// deliverMsg runs msg through handler, state is only written if it succeeds
+func deliverMsg(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) bool {
+	if err := msg.ValidateBasic(); err != nil {
+		return false
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if !handler(cacheCtx, msg).IsOK() {
+		return false
+	}
+
+	write()
+	return true
+}
+
+// deliverSideMsg runs side msg through handler and post handler as if validators voted yes on it,
+// state is only written if both succeed
+func deliverSideMsg(ctx sdk.Context, handler sdk.Handler, postHandler hmTypes.PostTxHandler, msg sdk.Msg) bool {
+	if err := msg.ValidateBasic(); err != nil {
+		return false
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if !handler(cacheCtx, msg).IsOK() {
+		return false
+	}
+	if !postHandler(cacheCtx, msg, abci.SideTxResultType_Yes).IsOK() {
+		return false
+	}
+
+	write()
+	return true
+}
+
+// randomRootChain returns one of root chains checkpoints are submitted to
+func randomRootChain(r *rand.Rand) string {
+	rootChains := []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron, hmTypes.RootChainTypeBsc}
+	return rootChains[r.Intn(len(rootChains))]
+}
+
+// randomHash returns random 32 bytes hash
+func randomHash(r *rand.Rand) hmTypes.HeimdallHash {
+	bz := make([]byte, 32)
+	r.Read(bz)
+	return hmTypes.BytesToHeimdallHash(bz)
+}
@@ -0,0 +1,66 @@
+package simulation_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+func TestWeightedOperations(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+	keeper := happ.CheckpointKeeper
+
+	chSim.LoadValidatorSet(2, t, happ.StakingKeeper, ctx, false, 10)
+	happ.StakingKeeper.IncrementAccum(ctx, 1)
+	happ.TopupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+
+	r := rand.New(rand.NewSource(1))
+	accs := simTypes.RandomAccounts(r, 3)
+
+	operations := chSim.WeightedOperations(
+		make(simTypes.AppParams),
+		happ.Codec(),
+		&keeper,
+		checkpoint.NewHandler(keeper, nil),
+		checkpoint.NewPostTxHandler(keeper, nil),
+	)
+	require.Len(t, operations, 4)
+
+	// random operations drive buffer and ack state machine without breaking invariants
+	var delivered int
+	for i := 0; i < 500; i++ {
+		op := operations[r.Intn(len(operations))].Op()
+		opMsg, _, err := op(r, happ.BaseApp, ctx, accs, "")
+		require.NoError(t, err)
+		if opMsg.OK {
+			delivered++
+		}
+	}
+	require.NotZero(t, delivered)
+
+	var acked uint64
+	for _, rootChain := range []string{hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron, hmTypes.RootChainTypeBsc} {
+		acked += keeper.GetACKCount(ctx, rootChain)
+	}
+	require.NotZero(t, acked, "some checkpoints should be acked")
+
+	msg, broken := checkpoint.AllInvariants(keeper)(ctx)
+	require.False(t, broken, msg)
+}
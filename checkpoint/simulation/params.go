@@ -0,0 +1,46 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/simulation"
+	simtypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+const (
+	keyCheckpointBufferTime = "CheckpointBufferTime"
+	keyAvgCheckpointLength  = "AvgCheckpointLength"
+	keyMaxCheckpointLength  = "MaxCheckpointLength"
+	keyCheckpointRetention  = "CheckpointRetention"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyCheckpointBufferTime,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCheckpointBufferTime(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyAvgCheckpointLength,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAvgCheckpointLength(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMaxCheckpointLength,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxCheckpointLength(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyCheckpointRetention,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCheckpointRetention(r))
+			},
+		),
+	}
+}
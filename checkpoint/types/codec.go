@@ -10,6 +10,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCheckpointNoAck{}, "checkpoint/MsgCheckpointNoACK", nil)
 	cdc.RegisterConcrete(MsgCheckpointSync{}, "checkpoint/MsgCheckpointSync", nil)
 	cdc.RegisterConcrete(MsgCheckpointSyncAck{}, "checkpoint/MsgCheckpointSyncAck", nil)
+	cdc.RegisterConcrete(MsgCheckpointCancel{}, "checkpoint/MsgCheckpointCancel", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"math/big"
+)
+
+// Checkpoint submission gas model. Submission calldata is
+// submitCheckpoint(bytes data, uint256[3][] sigs), data holding
+// proposer, start, end, root hash, account root hash and bor chain id.
+const (
+	checkpointSelectorSize   uint64 = 4
+	checkpointHeaderWords    uint64 = 2 + 1 + 6 + 1 // arg offsets, data length, data, sigs length
+	checkpointSignatureWords uint64 = 3
+
+	checkpointSubmitBaseGas    uint64 = 150000 // tx base cost and contract bookkeeping
+	checkpointGasPerSignature  uint64 = 6000   // ecrecover and signer checks per signature
+	checkpointCalldataByteGas  uint64 = 16     // non-zero calldata byte
+	checkpointCalldataWordSize uint64 = 32
+)
+
+// CheckpointCostEstimate is the estimated cost of submitting checkpoint to root chain
+type CheckpointCostEstimate struct {
+	RootChain    string `json:"root_chain"`
+	StartBlock   uint64 `json:"start_block"`
+	EndBlock     uint64 `json:"end_block"`
+	Signatures   uint64 `json:"signatures"`
+	CalldataSize uint64 `json:"calldata_size"`
+	Gas          uint64 `json:"gas"`
+	GasPrice     string `json:"gas_price"`
+	Fee          string `json:"fee"`
+}
+
+// EstimateCheckpointCalldataSize returns submitCheckpoint calldata size in bytes for number of signatures
+func EstimateCheckpointCalldataSize(signatures uint64) uint64 {
+	return checkpointSelectorSize + (checkpointHeaderWords+signatures*checkpointSignatureWords)*checkpointCalldataWordSize
+}
+
+// EstimateCheckpointGas returns gas needed to submit checkpoint with number of signatures
+func EstimateCheckpointGas(signatures uint64) uint64 {
+	return checkpointSubmitBaseGas +
+		EstimateCheckpointCalldataSize(signatures)*checkpointCalldataByteGas +
+		signatures*checkpointGasPerSignature
+}
+
+// NewCheckpointCostEstimate estimates checkpoint submission cost at given gas price
+func NewCheckpointCostEstimate(rootChain string, startBlock uint64, endBlock uint64, signatures uint64, gasPrice *big.Int) CheckpointCostEstimate {
+	gas := EstimateCheckpointGas(signatures)
+	return CheckpointCostEstimate{
+		RootChain:    rootChain,
+		StartBlock:   startBlock,
+		EndBlock:     endBlock,
+		Signatures:   signatures,
+		CalldataSize: EstimateCheckpointCalldataSize(signatures),
+		Gas:          gas,
+		GasPrice:     gasPrice.String(),
+		Fee:          new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas)).String(),
+	}
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Checkpoint tags
 var (
 	EventTypeCheckpoint        = "checkpoint"
@@ -7,6 +11,10 @@ var (
 	EventTypeCheckpointNoAck   = "checkpoint-noack"
 	EventTypeCheckpointSync    = "checkpoint-sync"
 	EventTypeCheckpointSyncAck = "checkpoint-sync-ack"
+	EventTypeRootChainPaused   = "checkpoint-paused"
+	EventTypeRootChainUnpaused = "checkpoint-unpaused"
+	EventTypeNoAckExpedited    = "checkpoint-noack-expedited"
+	EventTypeCheckpointCancel  = "checkpoint-cancel"
 
 	AttributeKeyProposer    = "proposer"
 	AttributeKeyStartBlock  = "start-block"
@@ -16,6 +24,35 @@ var (
 	AttributeKeyRootHash    = "root-hash"
 	AttributeKeyAccountHash = "account-hash"
 	AttributeKeyRootChain   = "root-chain"
+	AttributeKeyEpoch       = "epoch"
+	AttributeKeyEpochStart  = "epoch-start"
 
 	AttributeValueCategory = ModuleName
 )
+
+// Event type modes
+const (
+	EventTypeModeGeneric    = "generic"    // emit generic event type only, e.g. checkpoint
+	EventTypeModeNamespaced = "namespaced" // emit root chain namespaced event type only, e.g. checkpoint.eth
+	EventTypeModeBoth       = "both"       // emit both generic and namespaced event types
+)
+
+// RootChainEventType returns event type namespaced with root chain, e.g. checkpoint.eth
+func RootChainEventType(eventType string, rootChain string) string {
+	return eventType + "." + rootChain
+}
+
+// NewRootChainEvents creates events with given attributes for root chain, typed according to event type mode
+func NewRootChainEvents(mode string, eventType string, rootChain string, attrs ...sdk.Attribute) sdk.Events {
+	switch mode {
+	case EventTypeModeNamespaced:
+		return sdk.Events{sdk.NewEvent(RootChainEventType(eventType, rootChain), attrs...)}
+	case EventTypeModeBoth:
+		return sdk.Events{
+			sdk.NewEvent(eventType, attrs...),
+			sdk.NewEvent(RootChainEventType(eventType, rootChain), attrs...),
+		}
+	default:
+		return sdk.Events{sdk.NewEvent(eventType, attrs...)}
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -18,6 +19,37 @@ type GenesisState struct {
 	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
 	TronAckCount       uint64               `json:"tron_ack_count" yaml:"tron_ack_count"`
 	TronCheckpoints    []hmTypes.Checkpoint `json:"tron_checkpoints" yaml:"tron_checkpoints"`
+
+	RootChainBuffers []RootChainBufferedCheckpoint `json:"root_chain_buffers" yaml:"root_chain_buffers"` // checkpoint buffers of root chains other than eth
+	SyncBuffers      []RootChainBufferedCheckpoint `json:"sync_buffers" yaml:"sync_buffers"`             // checkpoint sync buffers of all root chains
+
+	RootChainCheckpoints []RootChainCheckpoints `json:"root_chain_checkpoints" yaml:"root_chain_checkpoints"` // ack counts and checkpoints of root chains other than eth and tron
+	LastNoAcks           []RootChainLastNoAck   `json:"last_no_acks" yaml:"last_no_acks"`                     // last no-ack per root chain
+
+	PrunedCheckpoints []PrunedCheckpoints `json:"pruned_checkpoints" yaml:"pruned_checkpoints"` // summaries of checkpoints pruned per root chain
+
+	UpgradeHeight int64 `json:"upgrade_height" yaml:"upgrade_height"` // height checkpoint upgrade activates at, 0 activates from genesis
+
+	NoAckStreak uint64 `json:"no_ack_streak" yaml:"no_ack_streak"` // no-acks since last checkpoint ack of stake root chain
+}
+
+// RootChainBufferedCheckpoint is checkpoint waiting in root chain buffer
+type RootChainBufferedCheckpoint struct {
+	RootChain  string             `json:"root_chain" yaml:"root_chain"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint" yaml:"checkpoint"`
+}
+
+// RootChainCheckpoints is ack count and acked checkpoints of root chain
+type RootChainCheckpoints struct {
+	RootChain   string               `json:"root_chain" yaml:"root_chain"`
+	AckCount    uint64               `json:"ack_count" yaml:"ack_count"`
+	Checkpoints []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+}
+
+// RootChainLastNoAck is time of last no-ack of root chain
+type RootChainLastNoAck struct {
+	RootChain string `json:"root_chain" yaml:"root_chain"`
+	LastNoAck uint64 `json:"last_no_ack" yaml:"last_no_ack"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -55,12 +87,91 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if data.UpgradeHeight < 0 {
+		return errors.New("UpgradeHeight should not be negative")
+	}
+
 	if len(data.Checkpoints) != 0 {
-		if int(data.AckCount) != len(data.Checkpoints) {
+		if data.AckCount != uint64(len(data.Checkpoints))+data.GetPrunedCheckpoints(hmTypes.RootChainTypeEth).Count {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
 
+	seen := make(map[string]bool)
+	for _, pruned := range data.PrunedCheckpoints {
+		if pruned.RootChain == "" {
+			return errors.New("PrunedCheckpoints has empty root chain")
+		}
+		if seen[pruned.RootChain] {
+			return fmt.Errorf("PrunedCheckpoints has duplicate root chain %s", pruned.RootChain)
+		}
+		seen[pruned.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, checkpoints := range data.RootChainCheckpoints {
+		switch checkpoints.RootChain {
+		case "":
+			return errors.New("RootChainCheckpoints has empty root chain")
+		case hmTypes.RootChainTypeEth, hmTypes.RootChainTypeTron:
+			return fmt.Errorf("RootChainCheckpoints should not have %s checkpoints, they have own fields", checkpoints.RootChain)
+		}
+		if seen[checkpoints.RootChain] {
+			return fmt.Errorf("RootChainCheckpoints has duplicate root chain %s", checkpoints.RootChain)
+		}
+		seen[checkpoints.RootChain] = true
+
+		if len(checkpoints.Checkpoints) != 0 && checkpoints.AckCount != uint64(len(checkpoints.Checkpoints))+data.GetPrunedCheckpoints(checkpoints.RootChain).Count {
+			return fmt.Errorf("RootChainCheckpoints of root chain %s doesn't match its ack count", checkpoints.RootChain)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, lastNoAck := range data.LastNoAcks {
+		if lastNoAck.RootChain == "" {
+			return errors.New("LastNoAcks has empty root chain")
+		}
+		if seen[lastNoAck.RootChain] {
+			return fmt.Errorf("LastNoAcks has duplicate root chain %s", lastNoAck.RootChain)
+		}
+		seen[lastNoAck.RootChain] = true
+	}
+
+	if err := validateBuffers("RootChainBuffers", data.RootChainBuffers); err != nil {
+		return err
+	}
+	for _, buffer := range data.RootChainBuffers {
+		if buffer.RootChain == hmTypes.RootChainTypeEth {
+			return errors.New("RootChainBuffers should not have eth buffer, it is BufferedCheckpoint")
+		}
+	}
+
+	return validateBuffers("SyncBuffers", data.SyncBuffers)
+}
+
+// GetPrunedCheckpoints returns summary of pruned checkpoints of root chain, empty one if none is pruned
+func (data GenesisState) GetPrunedCheckpoints(rootChain string) PrunedCheckpoints {
+	for _, pruned := range data.PrunedCheckpoints {
+		if pruned.RootChain == rootChain {
+			return pruned
+		}
+	}
+	return PrunedCheckpoints{RootChain: rootChain}
+}
+
+// validateBuffers checks buffers name root chain, at most one per root chain. Root chain
+// being registered depends on chain manager state, it is checked at init genesis.
+func validateBuffers(name string, buffers []RootChainBufferedCheckpoint) error {
+	seen := make(map[string]bool)
+	for _, buffer := range buffers {
+		if buffer.RootChain == "" {
+			return fmt.Errorf("%s has empty root chain", name)
+		}
+		if seen[buffer.RootChain] {
+			return fmt.Errorf("%s has duplicate root chain %s", name, buffer.RootChain)
+		}
+		seen[buffer.RootChain] = true
+	}
 	return nil
 }
 
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+)
+
+// HandlerStats is a heartbeat of checkpoint module handler for one message type
+type HandlerStats struct {
+	MsgType      string `json:"msg_type"`
+	SuccessCount uint64 `json:"success_count"`
+	LastHeight   int64  `json:"last_height"` // height of last successful processing
+}
+
+// String returns the string representation of handler stats
+func (s HandlerStats) String() string {
+	return fmt.Sprintf(
+		"HandlerStats {%v %v %v}",
+		s.MsgType,
+		s.SuccessCount,
+		s.LastHeight,
+	)
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckpointHooks event hooks for checkpoint module
+type CheckpointHooks interface {
+	AfterCheckpointAck(ctx sdk.Context, rootChain string, startBlock uint64, endBlock uint64) // called after checkpoint ack is applied
+}
+
+// MultiCheckpointHooks combines multiple checkpoint hooks, all hook functions are run in array sequence
+type MultiCheckpointHooks []CheckpointHooks
+
+// NewMultiCheckpointHooks creates new MultiCheckpointHooks
+func NewMultiCheckpointHooks(hooks ...CheckpointHooks) MultiCheckpointHooks {
+	return hooks
+}
+
+// AfterCheckpointAck runs AfterCheckpointAck of all hooks
+func (h MultiCheckpointHooks) AfterCheckpointAck(ctx sdk.Context, rootChain string, startBlock uint64, endBlock uint64) {
+	for i := range h {
+		h[i].AfterCheckpointAck(ctx, rootChain, startBlock, endBlock)
+	}
+}
@@ -15,4 +15,7 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// UpgradeName is the name of upgrade plan activating checkpoint upgrade on chains started before it
+	UpgradeName = ModuleName
 )
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Checkpoint lifecycle transitions
+const (
+	LifecycleProposed  = "proposed"
+	LifecycleBuffered  = "buffered"
+	LifecycleAcked     = "acked"
+	LifecycleNoAcked   = "no-acked"
+	LifecycleSynced    = "synced"
+	LifecycleFlushed   = "flushed"
+	LifecycleCancelled = "cancelled"
+)
+
+// CheckpointLifecycleEntry is one transition in checkpoint lifecycle log
+type CheckpointLifecycleEntry struct {
+	Sequence   uint64 `json:"sequence"`
+	Transition string `json:"transition"`
+	RootChain  string `json:"root_chain"`
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+	TimeStamp  uint64 `json:"timestamp"`
+}
+
+// String returns the string representation of lifecycle entry
+func (e CheckpointLifecycleEntry) String() string {
+	return fmt.Sprintf(
+		"CheckpointLifecycleEntry {%v %v %v %v %v %v}",
+		e.Sequence,
+		e.Transition,
+		e.RootChain,
+		e.StartBlock,
+		e.EndBlock,
+		e.TimeStamp,
+	)
+}
+
+// CheckpointEvent is checkpoint lifecycle transition observed in events of block or tx
+type CheckpointEvent struct {
+	Transition string            `json:"transition"`
+	RootChain  string            `json:"root_chain"`
+	Height     int64             `json:"height"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// CheckpointEventsFromABCI returns checkpoint lifecycle transitions found in events emitted at height.
+// Side tx events count only once approved, events emitted under both generic and namespaced types are
+// returned once.
+func CheckpointEventsFromABCI(height int64, events []abci.Event) []CheckpointEvent {
+	var result []CheckpointEvent
+
+	var prevType string
+	var prevAttributes map[string]string
+	for _, event := range events {
+		// namespaced event type, e.g. checkpoint.eth
+		eventType := event.Type
+		if i := strings.Index(eventType, "."); i >= 0 {
+			eventType = eventType[:i]
+		}
+
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		duplicate := eventType == prevType && equalAttributes(attributes, prevAttributes)
+		prevType, prevAttributes = eventType, attributes
+		if duplicate {
+			continue
+		}
+
+		sideTxResult, isSideTx := attributes[hmTypes.AttributeKeySideTxResult]
+		if isSideTx && sideTxResult != abci.SideTxResultType_Yes.String() {
+			continue
+		}
+
+		var transition string
+		switch eventType {
+		case EventTypeCheckpoint:
+			transition = LifecycleProposed
+			if isSideTx {
+				transition = LifecycleBuffered
+			}
+		case EventTypeCheckpointAck:
+			if isSideTx {
+				transition = LifecycleAcked
+			}
+		case EventTypeCheckpointNoAck:
+			transition = LifecycleNoAcked
+		case EventTypeCheckpointSyncAck:
+			if isSideTx {
+				transition = LifecycleSynced
+			}
+		case EventTypeCheckpointCancel:
+			if isSideTx {
+				transition = LifecycleCancelled
+			}
+		}
+
+		if transition == "" {
+			continue
+		}
+
+		result = append(result, CheckpointEvent{
+			Transition: transition,
+			RootChain:  attributes[AttributeKeyRootChain],
+			Height:     height,
+			Attributes: attributes,
+		})
+	}
+
+	return result
+}
+
+func equalAttributes(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestCheckpointEventsFromABCI(t *testing.T) {
+	rootChain := sdk.NewAttribute(AttributeKeyRootChain, hmTypes.RootChainTypeEth)
+	approved := sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_Yes.String())
+	rejected := sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, abci.SideTxResultType_No.String())
+
+	var events sdk.Events
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeBoth, EventTypeCheckpoint, hmTypes.RootChainTypeEth, rootChain))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeNamespaced, EventTypeCheckpoint, hmTypes.RootChainTypeEth, rootChain, approved))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeGeneric, EventTypeCheckpointAck, hmTypes.RootChainTypeEth, rootChain))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeGeneric, EventTypeCheckpointAck, hmTypes.RootChainTypeEth, rootChain, rejected))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeGeneric, EventTypeCheckpointAck, hmTypes.RootChainTypeEth, rootChain, approved))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeGeneric, EventTypeCheckpointNoAck, hmTypes.RootChainTypeStake,
+		sdk.NewAttribute(AttributeKeyRootChain, hmTypes.RootChainTypeStake)))
+	events = events.AppendEvents(NewRootChainEvents(EventTypeModeGeneric, EventTypeCheckpointSyncAck, hmTypes.RootChainTypeEth, rootChain, approved))
+	events = events.AppendEvents(sdk.Events{sdk.NewEvent("transfer", rootChain)})
+
+	checkpointEvents := CheckpointEventsFromABCI(10, events.ToABCIEvents())
+
+	var transitions []string
+	for _, event := range checkpointEvents {
+		require.Equal(t, int64(10), event.Height)
+		transitions = append(transitions, event.Transition)
+	}
+
+	// generic and namespaced event of both mode is one transition, rejected side tx is none
+	require.Equal(t, []string{LifecycleProposed, LifecycleBuffered, LifecycleAcked, LifecycleNoAcked, LifecycleSynced}, transitions)
+	require.Equal(t, hmTypes.RootChainTypeEth, checkpointEvents[0].RootChain)
+	require.Equal(t, hmTypes.RootChainTypeStake, checkpointEvents[3].RootChain)
+}
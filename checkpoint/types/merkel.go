@@ -2,7 +2,9 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"hash"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/ethereum/go-ethereum/common"
@@ -15,14 +17,14 @@ import (
 )
 
 // ValidateCheckpoint - Validates if checkpoint rootHash matches or not
-func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash, checkpointLength uint64, contractCaller helper.IContractCaller, confirmations uint64) (bool, error) {
+func ValidateCheckpoint(ctx context.Context, start uint64, end uint64, rootHash hmTypes.HeimdallHash, checkpointLength uint64, contractCaller helper.IContractCaller, confirmations uint64) (bool, error) {
 	// Check if blocks exist locally
-	if !contractCaller.CheckIfBlocksExist(end + confirmations) {
+	if !contractCaller.CheckIfBlocksExist(ctx, end+confirmations) {
 		return false, errors.New("blocks not found locally")
 	}
 
 	// Compare RootHash
-	root, err := contractCaller.GetRootHash(start, end, checkpointLength)
+	root, err := contractCaller.GetRootHash(ctx, start, end, checkpointLength)
 	if err != nil {
 		return false, err
 	}
@@ -34,18 +36,94 @@ func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash,
 	return false, nil
 }
 
-// GetAccountRootHash returns roothash of Validator Account State Tree
-func GetAccountRootHash(dividendAccounts []hmTypes.DividendAccount) ([]byte, error) {
-	tree, err := GetAccountTree(dividendAccounts)
+// AccountHashStrategy returns hash function used to build Validator Account State Tree
+type AccountHashStrategy func() hash.Hash
+
+// DefaultAccountHashStrategy is used for eth and all root chains without own strategy
+func DefaultAccountHashStrategy() hash.Hash {
+	return sha3.NewLegacyKeccak256()
+}
+
+// GetAccountHashStrategy returns account tree hash strategy for root chain. All root chains build
+// account tree with default strategy, root chain with own tree construction selects its strategy here.
+func GetAccountHashStrategy(rootChain string) AccountHashStrategy {
+	return DefaultAccountHashStrategy
+}
+
+// GetAccountRootHash returns roothash of Validator Account State Tree, same as root of GetAccountTree,
+// with leaves and tree levels hashed in parallel
+func GetAccountRootHash(dividendAccounts []hmTypes.DividendAccount, hashStrategy AccountHashStrategy) ([]byte, error) {
+	if len(dividendAccounts) == 0 {
+		return nil, errors.New("cannot build account tree without dividend accounts")
+	}
+
+	// Sort the dividendAccounts by ID
+	dividendAccounts = hmTypes.SortDividendAccountByAddress(dividendAccounts)
+
+	leaves := make([][]byte, len(dividendAccounts))
+	err := helper.ParallelFor(len(dividendAccounts), helper.MerkleWorkers, func(start, end int) (err error) {
+		for i := start; i < end; i++ {
+			if leaves[i], err = dividendAccounts[i].CalculateHash(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := AccountTreeLevels(leaves, hashStrategy)
 	if err != nil {
 		return nil, err
 	}
 
-	return tree.Root.Hash, nil
+	return levels[len(levels)-1][0], nil
+}
+
+// AccountTreeLevels returns levels of Validator Account State Tree from leaves of sorted dividend accounts
+// up to root. Last node of odd level, including single leaf, is hashed with itself.
+func AccountTreeLevels(leaves [][]byte, hashStrategy AccountHashStrategy) ([][][]byte, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("cannot build account tree without dividend accounts")
+	}
+
+	// account tree duplicates last leaf of odd number of leaves, so single account is hashed with itself
+	padded := leaves
+	if len(leaves)%2 == 1 {
+		padded = append(leaves[:len(leaves):len(leaves)], leaves[len(leaves)-1])
+	}
+
+	levels, err := helper.ParallelMerkleLevels(padded, hashStrategy, helper.MerkleWorkers)
+	if err != nil {
+		return nil, err
+	}
+
+	// duplicated leaf is not a node of its own
+	levels[0] = leaves
+	return levels, nil
+}
+
+// AccountTreeLevelSizes returns number of nodes in each level of account tree with given number of leaves
+func AccountTreeLevelSizes(leaves uint64) []uint64 {
+	sizes := []uint64{leaves}
+	for size := leaves; size > 1 || len(sizes) == 1; {
+		size = (size + 1) / 2
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
+
+// AccountTreeParent returns hash of account tree node from its children
+func AccountTreeParent(left []byte, right []byte, hashStrategy AccountHashStrategy) []byte {
+	h := hashStrategy()
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
 }
 
 // GetAccountTree returns roothash of Validator Account State Tree
-func GetAccountTree(dividendAccounts []hmTypes.DividendAccount) (*merkletree.MerkleTree, error) {
+func GetAccountTree(dividendAccounts []hmTypes.DividendAccount, hashStrategy AccountHashStrategy) (*merkletree.MerkleTree, error) {
 	// Sort the dividendAccounts by ID
 	dividendAccounts = hmTypes.SortDividendAccountByAddress(dividendAccounts)
 	var list []merkletree.Content
@@ -54,7 +132,7 @@ func GetAccountTree(dividendAccounts []hmTypes.DividendAccount) (*merkletree.Mer
 		list = append(list, dividendAccounts[i])
 	}
 
-	tree, err := merkletree.NewTreeWithHashStrategy(list, sha3.NewLegacyKeccak256)
+	tree, err := merkletree.NewTreeWithHashStrategy(list, hashStrategy)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +141,7 @@ func GetAccountTree(dividendAccounts []hmTypes.DividendAccount) (*merkletree.Mer
 }
 
 // GetAccountProof returns proof of dividend Account
-func GetAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmTypes.HeimdallAddress) ([]byte, uint64, error) {
+func GetAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmTypes.HeimdallAddress, hashStrategy AccountHashStrategy) ([]byte, uint64, error) {
 	// Sort the dividendAccounts by user address
 	dividendAccounts = hmTypes.SortDividendAccountByAddress(dividendAccounts)
 	var list []merkletree.Content
@@ -77,7 +155,7 @@ func GetAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmType
 		}
 	}
 
-	tree, err := merkletree.NewTreeWithHashStrategy(list, sha3.NewLegacyKeccak256)
+	tree, err := merkletree.NewTreeWithHashStrategy(list, hashStrategy)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -90,8 +168,8 @@ func GetAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmType
 }
 
 // VerifyAccountProof returns proof of dividend Account
-func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmTypes.HeimdallAddress, proofToVerify string) (bool, error) {
-	proof, _, err := GetAccountProof(dividendAccounts, userAddr)
+func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmTypes.HeimdallAddress, proofToVerify string, hashStrategy AccountHashStrategy) (bool, error) {
+	proof, _, err := GetAccountProof(dividendAccounts, userAddr, hashStrategy)
 	if err != nil {
 		return false, nil
 	}
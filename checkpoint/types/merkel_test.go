@@ -0,0 +1,69 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func testDividendAccounts(n int) []hmTypes.DividendAccount {
+	accounts := make([]hmTypes.DividendAccount, n)
+	for i := range accounts {
+		accounts[i] = hmTypes.NewDividendAccount(
+			hmTypes.BytesToHeimdallAddress(big.NewInt(int64(i+1)).Bytes()),
+			big.NewInt(int64(1000*i)).String(),
+		)
+	}
+	return accounts
+}
+
+func TestGetAccountRootHash(t *testing.T) {
+	_, err := GetAccountRootHash(nil, DefaultAccountHashStrategy)
+	require.Error(t, err)
+
+	// parallel root matches root of account tree proofs are taken from, for odd and even levels
+	// and for levels hashed by workers
+	for _, n := range []int{1, 2, 3, 5, 8, 13, 1500} {
+		for _, strategy := range []AccountHashStrategy{DefaultAccountHashStrategy, sha256.New} {
+			accounts := testDividendAccounts(n)
+
+			tree, err := GetAccountTree(accounts, strategy)
+			require.NoError(t, err)
+
+			root, err := GetAccountRootHash(accounts, strategy)
+			require.NoError(t, err, fmt.Sprintf("%d accounts", n))
+			require.Equal(t, tree.Root.Hash, root, fmt.Sprintf("%d accounts", n))
+		}
+	}
+}
+
+func BenchmarkGetAccountRootHash(b *testing.B) {
+	accounts := testDividendAccounts(50000)
+
+	b.Run("tree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GetAccountTree(accounts, DefaultAccountHashStrategy); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	for _, workers := range []int{1, helper.MerkleWorkers} {
+		b.Run(fmt.Sprintf("workers-%d", workers), func(b *testing.B) {
+			defer func(previous int) { helper.MerkleWorkers = previous }(helper.MerkleWorkers)
+			helper.MerkleWorkers = workers
+
+			for i := 0; i < b.N; i++ {
+				if _, err := GetAccountRootHash(accounts, DefaultAccountHashStrategy); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -211,15 +211,25 @@ func (msg MsgCheckpointAck) GetSideSignBytes() []byte {
 var _ sdk.Msg = &MsgCheckpointNoAck{}
 
 type MsgCheckpointNoAck struct {
-	From types.HeimdallAddress `json:"from"`
+	From          types.HeimdallAddress `json:"from"`
+	RootChainType string                `json:"root_chain_type,omitempty"`
 }
 
-func NewMsgCheckpointNoAck(from types.HeimdallAddress) MsgCheckpointNoAck {
+func NewMsgCheckpointNoAck(from types.HeimdallAddress, rootChain string) MsgCheckpointNoAck {
 	return MsgCheckpointNoAck{
-		From: from,
+		From:          from,
+		RootChainType: rootChain,
 	}
 }
 
+// GetRootChainType returns root chain which checkpoint timed out, no-acks without one are for stake root chain
+func (msg MsgCheckpointNoAck) GetRootChainType() string {
+	if msg.RootChainType == "" {
+		return types.RootChainTypeStake
+	}
+	return msg.RootChainType
+}
+
 func (msg MsgCheckpointNoAck) Type() string {
 	return "checkpoint-no-ack"
 }
@@ -245,6 +255,10 @@ func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
 	}
 
+	if msg.RootChainType != "" && types.GetRootChainID(msg.RootChainType) == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid root chain type %v", msg.RootChainType)
+	}
+
 	return nil
 }
 
@@ -261,6 +275,8 @@ type MsgCheckpointSync struct {
 	StartBlock    uint64                `json:"start_block"`
 	EndBlock      uint64                `json:"end_block"`
 	RootChainType string                `json:"root_chain_type"`
+
+	ProposerSignature []byte `json:"proposer_signature,omitempty"` // proposer signature over GetProposerSignBytes, optional
 }
 
 func NewMsgCheckpointSync(from, proposer types.HeimdallAddress, number, start, end uint64, rootChain string) MsgCheckpointSync {
@@ -301,6 +317,11 @@ func (msg MsgCheckpointSync) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// GetProposerSignBytes returns bytes proposer signs to bind sync to itself
+func (msg MsgCheckpointSync) GetProposerSignBytes() []byte {
+	return proposerSignBytes(msg.Type(), msg)
+}
+
 // GetSideSignBytes returns side sign bytes
 func (msg MsgCheckpointSync) GetSideSignBytes() []byte {
 	// data: (address proposer, uint256 start, uint256 end, uint256 headerBlockId, uint256 chainID)
@@ -358,7 +379,90 @@ func (msg MsgCheckpointSyncAck) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// GetProposerSignBytes returns bytes proposer signs to bind sync ack to itself
+func (msg MsgCheckpointSyncAck) GetProposerSignBytes() []byte {
+	return proposerSignBytes(msg.Type(), MsgCheckpointSync(msg))
+}
+
 // GetSideSignBytes returns side sign bytes
 func (msg MsgCheckpointSyncAck) GetSideSignBytes() []byte {
 	return nil
 }
+
+// proposerSignBytes returns msg type prefixed sync content, so sync signature can't be replayed as sync ack
+func proposerSignBytes(msgType string, msg MsgCheckpointSync) []byte {
+	return append([]byte(msgType), appendBytes32(
+		msg.Proposer.Bytes(),
+		new(big.Int).SetUint64(msg.StartBlock).Bytes(),
+		new(big.Int).SetUint64(msg.EndBlock).Bytes(),
+		new(big.Int).SetUint64(msg.Number).Bytes(),
+		new(big.Int).SetUint64(uint64(types.GetRootChainID(msg.RootChainType))).Bytes(),
+	)...)
+}
+
+//
+// Msg Checkpoint Cancel
+//
+
+var _ sdk.Msg = &MsgCheckpointCancel{}
+
+// MsgCheckpointCancel flushes checkpoint buffer of root chain without waiting for buffer time.
+// AckCount and block range pin the buffered checkpoint cancel was created for.
+type MsgCheckpointCancel struct {
+	From          types.HeimdallAddress `json:"from"`
+	AckCount      uint64                `json:"ack_count"`
+	StartBlock    uint64                `json:"start_block"`
+	EndBlock      uint64                `json:"end_block"`
+	RootChainType string                `json:"root_chain_type"`
+}
+
+func NewMsgCheckpointCancel(from types.HeimdallAddress, ackCount, start, end uint64, rootChain string) MsgCheckpointCancel {
+	return MsgCheckpointCancel{
+		From:          from,
+		AckCount:      ackCount,
+		StartBlock:    start,
+		EndBlock:      end,
+		RootChainType: rootChain,
+	}
+}
+
+func (msg MsgCheckpointCancel) Type() string {
+	return "checkpoint-cancel"
+}
+
+func (msg MsgCheckpointCancel) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCheckpointCancel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgCheckpointCancel) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgCheckpointCancel) GetSideSignBytes() []byte {
+	return nil
+}
+
+func (msg MsgCheckpointCancel) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.RootChainType == "" {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Root chain type is required")
+	}
+
+	if msg.StartBlock > msg.EndBlock {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid block range %d-%d", msg.StartBlock, msg.EndBlock)
+	}
+
+	return nil
+}
@@ -3,10 +3,14 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/maticnetwork/heimdall/params/subspace"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // Default parameter values
@@ -15,27 +19,119 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+
+	DefaultCheckpointLifecycleRetention uint64 = 10000
+	DefaultMaxCheckpointBatchSize       uint64 = 100
+	DefaultProposerRotationRetention    uint64 = 10000
 )
 
+// DefaultMinProposerPowerFraction disables proposer power check by default
+var DefaultMinProposerPowerFraction = sdk.ZeroDec()
+
 // Parameter keys
 var (
 	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+
+	KeyMinProposerPowerFraction     = []byte("MinProposerPowerFraction")
+	KeyCheckpointLifecycleRetention = []byte("CheckpointLifecycleRetention")
+	KeySyncGenesisBlocks            = []byte("SyncGenesisBlocks")
+	KeyMaxCheckpointBatchSize       = []byte("MaxCheckpointBatchSize")
+	KeyEnableTestRootChain          = []byte("EnableTestRootChain")
+	KeyEventTypeMode                = []byte("EventTypeMode")
+	KeyAckNumberTolerances          = []byte("AckNumberTolerances")
+	KeyPausedRootChains             = []byte("PausedRootChains")
+	KeyVerifySyncProposerSignature  = []byte("VerifySyncProposerSignature")
+	KeyProposerRotationRetention    = []byte("ProposerRotationRetention")
+	KeyEpochDeadline                = []byte("EpochDeadline")
+	KeyFirstCheckpointStartBlocks   = []byte("FirstCheckpointStartBlocks")
+	KeyRequireAccountRoot           = []byte("RequireAccountRoot")
+	KeyVerifyAckOnRootChain         = []byte("VerifyAckOnRootChain")
+	KeyCheckpointBufferTimes        = []byte("CheckpointBufferTimes")
+	KeyCheckpointRetention          = []byte("CheckpointRetention")
+	KeyNoAckTimePerBlock            = []byte("NoAckTimePerBlock")
+	KeyMaxNoAckBufferTime           = []byte("MaxNoAckBufferTime")
+	KeyBackupProposerNoAcks         = []byte("BackupProposerNoAcks")
+	KeyBackupProposerCount          = []byte("BackupProposerCount")
+	KeyAccountHashGas               = []byte("AccountHashGas")
+	KeyValidatorGas                 = []byte("ValidatorGas")
 )
 
 var _ subspace.ParamSet = &Params{}
 
+// RootChainBlock holds block number configured for root chain
+type RootChainBlock struct {
+	RootChain string `json:"root_chain" yaml:"root_chain"`
+	Block     uint64 `json:"block" yaml:"block"`
+}
+
+// RootChainTolerance holds tolerance configured for root chain
+type RootChainTolerance struct {
+	RootChain string `json:"root_chain" yaml:"root_chain"`
+	Tolerance uint64 `json:"tolerance" yaml:"tolerance"`
+}
+
+// RootChainDuration holds duration configured for root chain
+type RootChainDuration struct {
+	RootChain string        `json:"root_chain" yaml:"root_chain"`
+	Duration  time.Duration `json:"duration" yaml:"duration"`
+}
+
+// RootChainRequirement holds whether a requirement applies to root chain
+type RootChainRequirement struct {
+	RootChain string `json:"root_chain" yaml:"root_chain"`
+	Required  bool   `json:"required" yaml:"required"`
+}
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+
+	MinProposerPowerFraction     sdk.Dec `json:"min_proposer_power_fraction" yaml:"min_proposer_power_fraction"`       // min share of total voting power proposer must hold
+	CheckpointLifecycleRetention uint64  `json:"checkpoint_lifecycle_retention" yaml:"checkpoint_lifecycle_retention"` // number of lifecycle log entries kept, 0 disables log
+
+	SyncGenesisBlocks []RootChainBlock `json:"sync_genesis_blocks" yaml:"sync_genesis_blocks"` // start block of first checkpoint sync per root chain
+
+	MaxCheckpointBatchSize uint64 `json:"max_checkpoint_batch_size" yaml:"max_checkpoint_batch_size"` // max number of checkpoints fetched in one batch query, 0 uses default
+	EnableTestRootChain    bool   `json:"enable_test_root_chain" yaml:"enable_test_root_chain"`       // accept checkpoints for test root chain, must be off on mainnet
+	EventTypeMode          string `json:"event_type_mode" yaml:"event_type_mode"`                     // generic, namespaced or both event types, empty is generic
+
+	AckNumberTolerances []RootChainTolerance `json:"ack_number_tolerances" yaml:"ack_number_tolerances"` // numbers ack may skip ahead of expected one per root chain
+
+	PausedRootChains []string `json:"paused_root_chains" yaml:"paused_root_chains"` // root chains new checkpoints and syncs are rejected for
+
+	VerifySyncProposerSignature bool `json:"verify_sync_proposer_signature" yaml:"verify_sync_proposer_signature"` // require sync messages to carry proposer signature
+
+	ProposerRotationRetention uint64 `json:"proposer_rotation_retention" yaml:"proposer_rotation_retention"` // number of proposer rotation log entries kept, 0 disables log
+
+	EpochDeadline time.Duration `json:"epoch_deadline" yaml:"epoch_deadline"` // time after epoch start no-ack is expedited, 0 disables
+
+	FirstCheckpointStartBlocks []RootChainBlock `json:"first_checkpoint_start_blocks" yaml:"first_checkpoint_start_blocks"` // start block of first checkpoint per root chain, overrides activation height
+
+	RequireAccountRoot []RootChainRequirement `json:"require_account_root" yaml:"require_account_root"` // whether checkpoint account root is checked per root chain, required if not listed
+
+	VerifyAckOnRootChain bool `json:"verify_ack_on_root_chain" yaml:"verify_ack_on_root_chain"` // check ack against root chain contract in side tx, reporting mismatch with dedicated code
+
+	CheckpointBufferTimes []RootChainDuration `json:"checkpoint_buffer_times" yaml:"checkpoint_buffer_times"` // checkpoint buffer time per root chain, overrides CheckpointBufferTime
+
+	CheckpointRetention uint64 `json:"checkpoint_retention" yaml:"checkpoint_retention"` // number of latest checkpoint epochs kept per root chain, older ones are pruned, 0 disables pruning
+
+	NoAckTimePerBlock  time.Duration `json:"no_ack_time_per_block" yaml:"no_ack_time_per_block"`   // extra no-ack wait per block of buffered checkpoint beyond AvgCheckpointLength, 0 disables
+	MaxNoAckBufferTime time.Duration `json:"max_no_ack_buffer_time" yaml:"max_no_ack_buffer_time"` // upper bound of extended no-ack wait, 0 leaves it unbounded
+
+	BackupProposerNoAcks uint64 `json:"backup_proposer_no_acks" yaml:"backup_proposer_no_acks"` // no-acks in a row after which backup proposers may propose checkpoint, 0 disables
+	BackupProposerCount  uint64 `json:"backup_proposer_count" yaml:"backup_proposer_count"`     // number of validators next in proposer rotation allowed to propose as backup
+
+	AccountHashGas uint64 `json:"account_hash_gas" yaml:"account_hash_gas"` // gas per dividend account tree node hashed while computing account root, 0 disables
+	ValidatorGas   uint64 `json:"validator_gas" yaml:"validator_gas"`       // gas per validator of validator set checkpoint and no-ack handlers work through, 0 disables
 }
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object, other params are set to their defaults
 func NewParams(
 	checkpointBufferTime time.Duration,
 	checkpointLength uint64,
@@ -47,6 +143,11 @@ func NewParams(
 		AvgCheckpointLength:  checkpointLength,
 		MaxCheckpointLength:  maxCheckpointLength,
 		ChildBlockInterval:   childBlockInterval,
+
+		MinProposerPowerFraction:     DefaultMinProposerPowerFraction,
+		CheckpointLifecycleRetention: DefaultCheckpointLifecycleRetention,
+		MaxCheckpointBatchSize:       DefaultMaxCheckpointBatchSize,
+		ProposerRotationRetention:    DefaultProposerRotationRetention,
 	}
 }
 
@@ -64,6 +165,28 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
 		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyMinProposerPowerFraction, &p.MinProposerPowerFraction},
+		{KeyCheckpointLifecycleRetention, &p.CheckpointLifecycleRetention},
+		{KeySyncGenesisBlocks, &p.SyncGenesisBlocks},
+		{KeyMaxCheckpointBatchSize, &p.MaxCheckpointBatchSize},
+		{KeyEnableTestRootChain, &p.EnableTestRootChain},
+		{KeyEventTypeMode, &p.EventTypeMode},
+		{KeyAckNumberTolerances, &p.AckNumberTolerances},
+		{KeyPausedRootChains, &p.PausedRootChains},
+		{KeyVerifySyncProposerSignature, &p.VerifySyncProposerSignature},
+		{KeyProposerRotationRetention, &p.ProposerRotationRetention},
+		{KeyEpochDeadline, &p.EpochDeadline},
+		{KeyFirstCheckpointStartBlocks, &p.FirstCheckpointStartBlocks},
+		{KeyRequireAccountRoot, &p.RequireAccountRoot},
+		{KeyVerifyAckOnRootChain, &p.VerifyAckOnRootChain},
+		{KeyCheckpointBufferTimes, &p.CheckpointBufferTimes},
+		{KeyCheckpointRetention, &p.CheckpointRetention},
+		{KeyNoAckTimePerBlock, &p.NoAckTimePerBlock},
+		{KeyMaxNoAckBufferTime, &p.MaxNoAckBufferTime},
+		{KeyBackupProposerNoAcks, &p.BackupProposerNoAcks},
+		{KeyBackupProposerCount, &p.BackupProposerCount},
+		{KeyAccountHashGas, &p.AccountHashGas},
+		{KeyValidatorGas, &p.ValidatorGas},
 	}
 }
 
@@ -81,6 +204,11 @@ func DefaultParams() Params {
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
 		ChildBlockInterval:   DefaultChildBlockInterval,
+
+		MinProposerPowerFraction:     DefaultMinProposerPowerFraction,
+		CheckpointLifecycleRetention: DefaultCheckpointLifecycleRetention,
+		MaxCheckpointBatchSize:       DefaultMaxCheckpointBatchSize,
+		ProposerRotationRetention:    DefaultProposerRotationRetention,
 	}
 }
 
@@ -92,6 +220,28 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("MinProposerPowerFraction: %s\n", p.MinProposerPowerFraction))
+	sb.WriteString(fmt.Sprintf("CheckpointLifecycleRetention: %d\n", p.CheckpointLifecycleRetention))
+	sb.WriteString(fmt.Sprintf("SyncGenesisBlocks: %v\n", p.SyncGenesisBlocks))
+	sb.WriteString(fmt.Sprintf("MaxCheckpointBatchSize: %d\n", p.MaxCheckpointBatchSize))
+	sb.WriteString(fmt.Sprintf("EnableTestRootChain: %v\n", p.EnableTestRootChain))
+	sb.WriteString(fmt.Sprintf("EventTypeMode: %s\n", p.EventTypeMode))
+	sb.WriteString(fmt.Sprintf("AckNumberTolerances: %v\n", p.AckNumberTolerances))
+	sb.WriteString(fmt.Sprintf("PausedRootChains: %v\n", p.PausedRootChains))
+	sb.WriteString(fmt.Sprintf("VerifySyncProposerSignature: %v\n", p.VerifySyncProposerSignature))
+	sb.WriteString(fmt.Sprintf("ProposerRotationRetention: %d\n", p.ProposerRotationRetention))
+	sb.WriteString(fmt.Sprintf("EpochDeadline: %s\n", p.EpochDeadline))
+	sb.WriteString(fmt.Sprintf("FirstCheckpointStartBlocks: %v\n", p.FirstCheckpointStartBlocks))
+	sb.WriteString(fmt.Sprintf("RequireAccountRoot: %v\n", p.RequireAccountRoot))
+	sb.WriteString(fmt.Sprintf("VerifyAckOnRootChain: %v\n", p.VerifyAckOnRootChain))
+	sb.WriteString(fmt.Sprintf("CheckpointBufferTimes: %v\n", p.CheckpointBufferTimes))
+	sb.WriteString(fmt.Sprintf("CheckpointRetention: %d\n", p.CheckpointRetention))
+	sb.WriteString(fmt.Sprintf("NoAckTimePerBlock: %s\n", p.NoAckTimePerBlock))
+	sb.WriteString(fmt.Sprintf("MaxNoAckBufferTime: %s\n", p.MaxNoAckBufferTime))
+	sb.WriteString(fmt.Sprintf("BackupProposerNoAcks: %d\n", p.BackupProposerNoAcks))
+	sb.WriteString(fmt.Sprintf("BackupProposerCount: %d\n", p.BackupProposerCount))
+	sb.WriteString(fmt.Sprintf("AccountHashGas: %d\n", p.AccountHashGas))
+	sb.WriteString(fmt.Sprintf("ValidatorGas: %d\n", p.ValidatorGas))
 	return sb.String()
 }
 
@@ -109,5 +259,175 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ChildBlockInterval should be greater than zero")
 	}
 
+	if !p.MinProposerPowerFraction.IsNil() &&
+		(p.MinProposerPowerFraction.IsNegative() || p.MinProposerPowerFraction.GT(sdk.OneDec())) {
+		return fmt.Errorf("MinProposerPowerFraction should be between 0 and 1")
+	}
+
+	if p.EpochDeadline < 0 {
+		return fmt.Errorf("EpochDeadline should not be negative")
+	}
+
+	if p.NoAckTimePerBlock < 0 || p.MaxNoAckBufferTime < 0 {
+		return fmt.Errorf("NoAckTimePerBlock, MaxNoAckBufferTime should not be negative")
+	}
+
+	if p.BackupProposerNoAcks > 0 && p.BackupProposerCount == 0 {
+		return fmt.Errorf("BackupProposerCount should be greater than zero when BackupProposerNoAcks is set")
+	}
+
+	switch p.EventTypeMode {
+	case "", EventTypeModeGeneric, EventTypeModeNamespaced, EventTypeModeBoth:
+	default:
+		return fmt.Errorf("EventTypeMode should be one of %s, %s or %s", EventTypeModeGeneric, EventTypeModeNamespaced, EventTypeModeBoth)
+	}
+
+	seen := make(map[string]bool)
+	for _, syncGenesis := range p.SyncGenesisBlocks {
+		if seen[syncGenesis.RootChain] {
+			return fmt.Errorf("SyncGenesisBlocks has duplicate root chain %s", syncGenesis.RootChain)
+		}
+		seen[syncGenesis.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, firstStart := range p.FirstCheckpointStartBlocks {
+		if seen[firstStart.RootChain] {
+			return fmt.Errorf("FirstCheckpointStartBlocks has duplicate root chain %s", firstStart.RootChain)
+		}
+		seen[firstStart.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, bufferTime := range p.CheckpointBufferTimes {
+		if seen[bufferTime.RootChain] {
+			return fmt.Errorf("CheckpointBufferTimes has duplicate root chain %s", bufferTime.RootChain)
+		}
+		if bufferTime.Duration <= 0 {
+			return fmt.Errorf("CheckpointBufferTimes of root chain %s should be positive", bufferTime.RootChain)
+		}
+		seen[bufferTime.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, requirement := range p.RequireAccountRoot {
+		if seen[requirement.RootChain] {
+			return fmt.Errorf("RequireAccountRoot has duplicate root chain %s", requirement.RootChain)
+		}
+		seen[requirement.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, tolerance := range p.AckNumberTolerances {
+		if seen[tolerance.RootChain] {
+			return fmt.Errorf("AckNumberTolerances has duplicate root chain %s", tolerance.RootChain)
+		}
+		seen[tolerance.RootChain] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, rootChain := range p.PausedRootChains {
+		if seen[rootChain] {
+			return fmt.Errorf("PausedRootChains has duplicate root chain %s", rootChain)
+		}
+		seen[rootChain] = true
+	}
+
 	return nil
 }
+
+// IsRootChainAllowed returns false for test root chain unless it is explicitly enabled
+func (p Params) IsRootChainAllowed(rootChain string) bool {
+	return rootChain != hmTypes.RootChainTypeTest || p.EnableTestRootChain
+}
+
+// IsRootChainPaused returns true if checkpoints are paused for root chain
+func (p Params) IsRootChainPaused(rootChain string) bool {
+	for _, paused := range p.PausedRootChains {
+		if paused == rootChain {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSyncGenesisBlock returns sync genesis block for root chain, false if it is not configured
+func (p Params) GetSyncGenesisBlock(rootChain string) (uint64, bool) {
+	for _, syncGenesis := range p.SyncGenesisBlocks {
+		if syncGenesis.RootChain == rootChain {
+			return syncGenesis.Block, true
+		}
+	}
+	return 0, false
+}
+
+// GetFirstCheckpointStartBlock returns start block of first checkpoint for root chain, false if it is not configured
+func (p Params) GetFirstCheckpointStartBlock(rootChain string) (uint64, bool) {
+	for _, firstStart := range p.FirstCheckpointStartBlocks {
+		if firstStart.RootChain == rootChain {
+			return firstStart.Block, true
+		}
+	}
+	return 0, false
+}
+
+// GetCheckpointBufferTime returns checkpoint buffer time for root chain, CheckpointBufferTime if it is not configured
+func (p Params) GetCheckpointBufferTime(rootChain string) time.Duration {
+	for _, bufferTime := range p.CheckpointBufferTimes {
+		if bufferTime.RootChain == rootChain {
+			return bufferTime.Duration
+		}
+	}
+	return p.CheckpointBufferTime
+}
+
+// GetNoAckBufferTime returns time no-ack waits for after last checkpoint of root chain while checkpoint
+// of length blocks is buffered. Large checkpoints take longer to land on root chain, so buffer time is
+// extended by NoAckTimePerBlock for each block beyond AvgCheckpointLength, up to MaxNoAckBufferTime.
+// It is never shorter than buffer time.
+func (p Params) GetNoAckBufferTime(rootChain string, length uint64) time.Duration {
+	bufferTime := p.GetCheckpointBufferTime(rootChain)
+	if p.NoAckTimePerBlock <= 0 || length <= p.AvgCheckpointLength {
+		return bufferTime
+	}
+
+	limit := time.Duration(math.MaxInt64)
+	if p.MaxNoAckBufferTime > 0 {
+		limit = p.MaxNoAckBufferTime
+	}
+	if limit <= bufferTime {
+		return bufferTime
+	}
+
+	// saturate instead of overflowing
+	extraBlocks := length - p.AvgCheckpointLength
+	if extraBlocks > uint64((limit-bufferTime)/p.NoAckTimePerBlock) {
+		return limit
+	}
+	return bufferTime + time.Duration(extraBlocks)*p.NoAckTimePerBlock
+}
+
+// BackupProposersActive returns true if backup proposers may propose checkpoint after noAckStreak no-acks in a row
+func (p Params) BackupProposersActive(noAckStreak uint64) bool {
+	return p.BackupProposerNoAcks > 0 && p.BackupProposerCount > 0 && noAckStreak >= p.BackupProposerNoAcks
+}
+
+// IsAccountRootRequired returns false if checkpoint account root check is relaxed for root chain
+func (p Params) IsAccountRootRequired(rootChain string) bool {
+	for _, requirement := range p.RequireAccountRoot {
+		if requirement.RootChain == rootChain {
+			return requirement.Required
+		}
+	}
+	return true
+}
+
+// GetAckNumberTolerance returns ack number tolerance for root chain, 0 if it is not configured
+func (p Params) GetAckNumberTolerance(rootChain string) uint64 {
+	for _, tolerance := range p.AckNumberTolerances {
+		if tolerance.RootChain == rootChain {
+			return tolerance.Tolerance
+		}
+	}
+	return 0
+}
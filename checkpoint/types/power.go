@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// CheckpointPower is voting power backing a checkpoint, captured when it is acked
+type CheckpointPower struct {
+	Number        uint64                  `json:"number"`
+	Proposer      hmTypes.HeimdallAddress `json:"proposer"`
+	ProposerPower int64                   `json:"proposer_power"`
+	TotalPower    int64                   `json:"total_power"`
+}
+
+// String returns the string representation of checkpoint power
+func (p CheckpointPower) String() string {
+	return fmt.Sprintf(
+		"CheckpointPower {%v %v %v %v}",
+		p.Number,
+		p.Proposer.String(),
+		p.ProposerPower,
+		p.TotalPower,
+	)
+}
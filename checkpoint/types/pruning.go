@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/crypto/sha3"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PrunedCheckpoints summarises checkpoints pruned from store of a root chain.
+// Commitment is a rolling hash over pruned checkpoints in number order, so pruned
+// history can still be checked against an archive.
+type PrunedCheckpoints struct {
+	RootChain  string               `json:"root_chain" yaml:"root_chain"`
+	Count      uint64               `json:"count" yaml:"count"`             // number of pruned checkpoints
+	LastNumber uint64               `json:"last_number" yaml:"last_number"` // checkpoints numbered up to it are pruned
+	Commitment hmTypes.HeimdallHash `json:"commitment" yaml:"commitment"`   // rolling hash of pruned checkpoints
+}
+
+// Extend returns pruned checkpoints with checkpoint folded into commitment
+func (p PrunedCheckpoints) Extend(number uint64, checkpoint hmTypes.Checkpoint) PrunedCheckpoints {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(p.Commitment.Bytes())
+	hasher.Write(sdk.Uint64ToBigEndian(number))
+	hasher.Write(sdk.Uint64ToBigEndian(checkpoint.StartBlock))
+	hasher.Write(sdk.Uint64ToBigEndian(checkpoint.EndBlock))
+	hasher.Write(checkpoint.RootHash.Bytes())
+	hasher.Write(checkpoint.Proposer.Bytes())
+	hasher.Write(sdk.Uint64ToBigEndian(checkpoint.TimeStamp))
+
+	p.Count++
+	p.Commitment = hmTypes.BytesToHeimdallHash(hasher.Sum(nil))
+	return p
+}
+
+// String returns the string representation of pruned checkpoints
+func (p PrunedCheckpoints) String() string {
+	return fmt.Sprintf(
+		"PrunedCheckpoints {%v %v %v %v}",
+		p.RootChain,
+		p.Count,
+		p.LastNumber,
+		p.Commitment.Hex(),
+	)
+}
@@ -1,20 +1,48 @@
 package types
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryParams               = "params"
-	QueryAckCount             = "ack-count"
-	QueryEpoch                = "epoch"
-	QueryCheckpoint           = "checkpoint"
-	QueryCheckpointBuffer     = "checkpoint-buffer"
-	QueryCheckpointSyncBuffer = "checkpoint-sync"
-	QueryCheckpointActivation = "checkpoint-activation"
-	QueryLastNoAck            = "last-no-ack"
-	QueryCheckpointList       = "checkpoint-list"
-	QueryNextCheckpoint       = "next-checkpoint"
-	QueryProposer             = "is-proposer"
-	QueryCurrentProposer      = "current-proposer"
-	StakingQuerierRoute       = "staking"
+	QueryParams                 = "params"
+	QueryAckCount               = "ack-count"
+	QueryEpoch                  = "epoch"
+	QueryCheckpoint             = "checkpoint"
+	QueryCheckpointByBlock      = "checkpoint-by-block"
+	QueryCheckpointProof        = "checkpoint-proof"
+	QueryCheckpointBuffer       = "checkpoint-buffer"
+	QueryCheckpointSyncBuffer   = "checkpoint-sync"
+	QueryCheckpointActivation   = "checkpoint-activation"
+	QueryLastNoAck              = "last-no-ack"
+	QueryNoAckCountdown         = "no-ack-countdown"
+	QueryCheckpointList         = "checkpoint-list"
+	QueryCheckpointLifecycle    = "checkpoint-lifecycle"
+	QueryCheckpointAckStatus    = "checkpoint-ack-status"
+	QueryCheckpointsByTime      = "checkpoints-by-time"
+	QueryCheckpointContinuity   = "checkpoint-continuity"
+	QueryCheckpointBatch        = "checkpoint-batch"
+	QueryCheckpointRange        = "checkpoint-range"
+	QueryCurrentAccountRoot     = "current-account-root"
+	QueryMaxCommittedBlock      = "max-committed-block"
+	QueryCheckpointCostEstimate = "checkpoint-cost-estimate"
+	QueryProposerRotations      = "proposer-rotations"
+	QueryStaleBuffers           = "stale-buffers"
+	QueryCheckpointRaw          = "checkpoint-raw"
+	QueryCheckpointPower        = "checkpoint-power"
+	QueryPrunedCheckpoints      = "pruned-checkpoints"
+	QueryHandlerStats           = "handler-stats"
+	QueryNextCheckpoint         = "next-checkpoint"
+	QueryNextCheckpointShape    = "next-checkpoint-shape"
+	QueryCheckpointReadiness    = "checkpoint-readiness"
+	QueryBackupProposers        = "backup-proposers"
+	QueryProposer               = "is-proposer"
+	QueryCurrentProposer        = "current-proposer"
+	StakingQuerierRoute         = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -31,12 +59,229 @@ func NewQueryCheckpointParams(number uint64, rootChain string) QueryCheckpointPa
 	}
 }
 
+// QueryCheckpointBatchParams defines the params for querying checkpoints by numbers
+type QueryCheckpointBatchParams struct {
+	Numbers   []uint64
+	RootChain string
+}
+
+// NewQueryCheckpointBatchParams creates a new instance of QueryCheckpointBatchParams.
+func NewQueryCheckpointBatchParams(numbers []uint64, rootChain string) QueryCheckpointBatchParams {
+	return QueryCheckpointBatchParams{
+		Numbers:   numbers,
+		RootChain: rootChain,
+	}
+}
+
+// QueryCheckpointCostEstimateParams defines the params for estimating checkpoint submission cost
+type QueryCheckpointCostEstimateParams struct {
+	RootChain  string
+	StartBlock uint64
+	EndBlock   uint64
+}
+
+// NewQueryCheckpointCostEstimateParams creates a new instance of QueryCheckpointCostEstimateParams.
+func NewQueryCheckpointCostEstimateParams(rootChain string, startBlock uint64, endBlock uint64) QueryCheckpointCostEstimateParams {
+	return QueryCheckpointCostEstimateParams{
+		RootChain:  rootChain,
+		StartBlock: startBlock,
+		EndBlock:   endBlock,
+	}
+}
+
+// QueryCheckpointReadinessParams defines the params for checking checkpoint of block range before submission
+type QueryCheckpointReadinessParams struct {
+	RootChain  string
+	StartBlock uint64
+	EndBlock   uint64
+}
+
+// NewQueryCheckpointReadinessParams creates a new instance of QueryCheckpointReadinessParams.
+func NewQueryCheckpointReadinessParams(rootChain string, startBlock uint64, endBlock uint64) QueryCheckpointReadinessParams {
+	return QueryCheckpointReadinessParams{
+		RootChain:  rootChain,
+		StartBlock: startBlock,
+		EndBlock:   endBlock,
+	}
+}
+
 // QueryBorChainID defines the params for querying with bor chain id
 type QueryBorChainID struct {
 	BorChainID string
+	RootChain  string
 }
 
 // NewQueryBorChainID creates a new instance of QueryBorChainID with give chain id
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// NewQueryNextCheckpointParams creates a new instance of QueryBorChainID with give chain id and root chain
+func NewQueryNextCheckpointParams(chainID string, rootChain string) QueryBorChainID {
+	return QueryBorChainID{BorChainID: chainID, RootChain: rootChain}
+}
+
+// CheckpointAckStatus is the result of checkpoint ack status query
+type CheckpointAckStatus struct {
+	Acked     bool   `json:"acked"`
+	AckNumber uint64 `json:"ack_number"`
+}
+
+// QueryCheckpointsByTimeRangeParams defines the params for querying checkpoints within time range
+type QueryCheckpointsByTimeRangeParams struct {
+	From      uint64
+	To        uint64
+	Page      uint64
+	Limit     uint64
+	RootChain string
+}
+
+// NewQueryCheckpointsByTimeRangeParams creates a new instance of QueryCheckpointsByTimeRangeParams.
+func NewQueryCheckpointsByTimeRangeParams(from, to, page, limit uint64, rootChain string) QueryCheckpointsByTimeRangeParams {
+	return QueryCheckpointsByTimeRangeParams{
+		From:      from,
+		To:        to,
+		Page:      page,
+		Limit:     limit,
+		RootChain: rootChain,
+	}
+}
+
+// QueryCheckpointByBlockParams defines the params for querying checkpoint containing a block
+type QueryCheckpointByBlockParams struct {
+	BlockNumber uint64
+	RootChain   string
+}
+
+// NewQueryCheckpointByBlockParams creates a new instance of QueryCheckpointByBlockParams.
+func NewQueryCheckpointByBlockParams(blockNumber uint64, rootChain string) QueryCheckpointByBlockParams {
+	return QueryCheckpointByBlockParams{
+		BlockNumber: blockNumber,
+		RootChain:   rootChain,
+	}
+}
+
+// QueryCheckpointProofParams defines the params for querying proof of bor block containing tx against checkpoint
+type QueryCheckpointProofParams struct {
+	BlockNumber uint64
+	TxHash      common.Hash
+	RootChain   string
+}
+
+// NewQueryCheckpointProofParams creates a new instance of QueryCheckpointProofParams.
+func NewQueryCheckpointProofParams(blockNumber uint64, txHash common.Hash, rootChain string) QueryCheckpointProofParams {
+	return QueryCheckpointProofParams{
+		BlockNumber: blockNumber,
+		TxHash:      txHash,
+		RootChain:   rootChain,
+	}
+}
+
+// QueryCheckpointRangeParams defines the params for querying checkpoints numbered from..to
+type QueryCheckpointRangeParams struct {
+	From      uint64
+	To        uint64
+	RootChain string
+}
+
+// NewQueryCheckpointRangeParams creates a new instance of QueryCheckpointRangeParams.
+func NewQueryCheckpointRangeParams(from, to uint64, rootChain string) QueryCheckpointRangeParams {
+	return QueryCheckpointRangeParams{
+		From:      from,
+		To:        to,
+		RootChain: rootChain,
+	}
+}
+
+// RootChainCheckpoint is a checkpoint with its number and root chain
+type RootChainCheckpoint struct {
+	Number     uint64             `json:"number"`
+	RootChain  string             `json:"root_chain"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+}
+
+// CheckpointProof is merkle inclusion proof of bor block header, containing tx, against root hash of checkpoint
+type CheckpointProof struct {
+	Number     uint64             `json:"number"`
+	RootChain  string             `json:"root_chain"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+
+	BlockNumber uint64           `json:"block_number"`
+	TxHash      common.Hash      `json:"tx_hash"`
+	TxIndex     uint             `json:"tx_index"`
+	Header      *ethTypes.Header `json:"header"`
+
+	Leaf      hmTypes.HexBytes `json:"leaf"`
+	LeafIndex uint64           `json:"leaf_index"` // position of block in checkpoint
+	Proof     hmTypes.HexBytes `json:"proof"`      // sibling hashes from leaf up to root, concatenated
+}
+
+// CheckpointContinuity describes whether buffered checkpoint extends last checkpoint
+type CheckpointContinuity struct {
+	LastEnd     uint64 `json:"last_end"`
+	BufferStart uint64 `json:"buffer_start"`
+	Continuous  bool   `json:"continuous"`
+	Gap         int64  `json:"gap"`
+}
+
+// MaxCommittedBlock is the highest checkpointed block across all root chains
+type MaxCommittedBlock struct {
+	Block     uint64 `json:"block"`
+	RootChain string `json:"root_chain"`
+	Found     bool   `json:"found"`
+}
+
+// QueryStaleBuffersParams defines the params for querying stale checkpoint buffers
+type QueryStaleBuffersParams struct {
+	Threshold uint64 // age threshold in seconds
+}
+
+// NewQueryStaleBuffersParams creates a new instance of QueryStaleBuffersParams.
+func NewQueryStaleBuffersParams(threshold uint64) QueryStaleBuffersParams {
+	return QueryStaleBuffersParams{Threshold: threshold}
+}
+
+// StaleBuffer is a buffered checkpoint which is waiting for ack longer than threshold
+type StaleBuffer struct {
+	RootChain  string             `json:"root_chain"`
+	Checkpoint hmTypes.Checkpoint `json:"checkpoint"`
+	Age        uint64             `json:"age"` // in seconds
+}
+
+// CheckpointRaw is stored bytes of checkpoint along with their decoded form (debug)
+type CheckpointRaw struct {
+	Key         hmTypes.HexBytes    `json:"key"`
+	Raw         hmTypes.HexBytes    `json:"raw"`
+	Decoded     *hmTypes.Checkpoint `json:"decoded,omitempty"`
+	DecodeError string              `json:"decode_error,omitempty"`
+}
+
+// NextCheckpointShape is everything next checkpoint msg of root chain must carry
+// to pass validation, except block range end and root hash which come from bor
+type NextCheckpointShape struct {
+	RootChain       string                  `json:"root_chain"`
+	StartBlock      uint64                  `json:"start_block"`
+	Epoch           uint64                  `json:"epoch"`
+	AccountRootHash hmTypes.HeimdallHash    `json:"account_root_hash"`
+	Proposer        hmTypes.HeimdallAddress `json:"proposer"`
+}
+
+// CheckpointReadiness is result of checks checkpoint msg of block range would face if submitted now
+type CheckpointReadiness struct {
+	RootChain       string                  `json:"root_chain"`
+	StartBlock      uint64                  `json:"start_block"`
+	EndBlock        uint64                  `json:"end_block"`
+	Epoch           uint64                  `json:"epoch"`
+	AccountRootHash hmTypes.HeimdallHash    `json:"account_root_hash"`
+	Proposer        hmTypes.HeimdallAddress `json:"proposer"`
+	BufferFree      bool                    `json:"buffer_free"`
+	BufferExpiry    uint64                  `json:"buffer_expiry,omitempty"`
+	Ready           bool                    `json:"ready"`
+	Errors          []string                `json:"errors,omitempty"`
+}
+
+// BackupProposers describes validators allowed to propose checkpoint besides current proposer
+type BackupProposers struct {
+	NoAckStreak uint64              `json:"no_ack_streak"`
+	Proposers   []hmTypes.Validator `json:"proposers"`
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposerRotationEntry is one proposer rotation in rotation log
+type ProposerRotationEntry struct {
+	Sequence    uint64                  `json:"sequence"`
+	OldProposer hmTypes.HeimdallAddress `json:"old_proposer"`
+	NewProposer hmTypes.HeimdallAddress `json:"new_proposer"`
+	TimeStamp   uint64                  `json:"timestamp"`
+	RootChain   string                  `json:"root_chain"` // root chain which checkpoint timed out
+}
+
+// String returns the string representation of rotation entry
+func (e ProposerRotationEntry) String() string {
+	return fmt.Sprintf(
+		"ProposerRotationEntry {%v %v %v %v %v}",
+		e.Sequence,
+		e.OldProposer.String(),
+		e.NewProposer.String(),
+		e.TimeStamp,
+		e.RootChain,
+	)
+}
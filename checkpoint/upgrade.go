@@ -0,0 +1,64 @@
+package checkpoint
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetUpgradeHeight stores height checkpoint upgrade activates at
+func (k Keeper) SetUpgradeHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(UpgradeHeightKey, []byte(strconv.FormatInt(height, 10)))
+}
+
+// GetUpgradeHeight returns height checkpoint upgrade activates at and if it is known.
+// It is set at genesis, or by upgrade plan on chains started before upgrade.
+func (k Keeper) GetUpgradeHeight(ctx sdk.Context) (int64, bool) {
+	// read without gas, chains started before upgrade must not pay for it
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	if store.Has(UpgradeHeightKey) {
+		if height, err := strconv.ParseInt(string(store.Get(UpgradeHeightKey)), 10, 64); err == nil {
+			return height, true
+		}
+	}
+	return 0, false
+}
+
+// IsUpgradeActive returns true once chain reached checkpoint upgrade height.
+// State introduced by upgrade (params, indexes, logs and stats) is only written from then on,
+// and new validation rules only apply from then on, so blocks before it are replayed with
+// rules and state they were produced with. Chains without known upgrade height never activate it.
+func (k Keeper) IsUpgradeActive(ctx sdk.Context) bool {
+	height, ok := k.GetUpgradeHeight(ctx)
+	return ok && ctx.BlockHeight() >= height
+}
+
+// ActivateUpgrade activates checkpoint upgrade at current height, it handles upgrade plan named
+// types.UpgradeName. Store is migrated by BeginBlock of this block, which runs after upgrade handlers.
+func (k Keeper) ActivateUpgrade(ctx sdk.Context) {
+	if height, ok := k.GetUpgradeHeight(ctx); ok {
+		k.Logger(ctx).Info("Checkpoint upgrade already activated", "height", height)
+		return
+	}
+
+	k.SetUpgradeHeight(ctx, ctx.BlockHeight())
+}
+
+// MigrateStore writes state introduced by checkpoint upgrade on chains started before it.
+// It runs once, at upgrade height.
+func (k Keeper) MigrateStore(ctx sdk.Context) {
+	k.Logger(ctx).Info("Migrating checkpoint store", "height", ctx.BlockHeight())
+
+	// persist defaults of params missing in store
+	k.SetParams(ctx, k.GetParams(ctx))
+
+	// seed per root chain last no-ack
+	k.MigrateLastNoAck(ctx)
+
+	// index checkpoints added before upgrade by timestamp
+	k.BackfillCheckpointTimeIndex(ctx)
+
+	// persist account root of existing dividend accounts
+	k.UpdateAccountRoot(ctx)
+}
@@ -103,17 +103,17 @@ func handleQueryRecordSequence(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	// get main tx receipt
 	switch params.RootChainType {
 	case hmTypes.RootChainTypeEth:
-		receipt, err = contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
+		receipt, err = contractCallerObj.GetConfirmedTxReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
 			chainParams.MainchainTxConfirmations, hmTypes.RootChainTypeEth)
 	case hmTypes.RootChainTypeBsc:
 		bscChain, err := keeper.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
 		if err != nil {
 			return nil, sdk.ErrInternal(fmt.Sprintf("wrong chain type = " + params.RootChainType + "plealse pass correct chainType like bsc"))
 		}
-		receipt, err = contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
+		receipt, err = contractCallerObj.GetConfirmedTxReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).EthHash(),
 			bscChain.TxConfirmations, hmTypes.RootChainTypeBsc)
 	case hmTypes.RootChainTypeTron:
-		receipt, err = contractCallerObj.GetTronTransactionReceipt(hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
+		receipt, err = contractCallerObj.GetTronTransactionReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
 	default:
 		return nil, sdk.ErrInternal(fmt.Sprintf("wrong chain type = " + params.RootChainType + "please pass correct chainType like eth or tron"))
 	}
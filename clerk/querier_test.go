@@ -20,6 +20,7 @@ import (
 	"github.com/maticnetwork/heimdall/types/simulation"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -207,7 +208,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	txreceipt := &ethTypes.Receipt{
 		BlockNumber: big.NewInt(1),
 	}
-	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).
+	suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).
 		Return(nil, errors.New("err confirmed txn receipt"))
 
 	req = abci.RequestQuery{
@@ -220,7 +221,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	index = simulation.RandIntBetween(r1, 0, 100)
 	logIndex = uint64(index)
 	txHash = hmTypes.HexToHeimdallHash("1234")
-	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).Return(txreceipt, nil)
+	suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).Return(txreceipt, nil)
 	req = abci.RequestQuery{
 		Path: route,
 		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordSequenceParams("1234", logIndex, rootChainType)),
@@ -234,7 +235,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	ck.SetRecordSequence(ctx, testSeq)
 	logIndex = uint64(1)
 	txHash = hmTypes.HexToHeimdallHash("12345")
-	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).Return(txreceipt, nil)
+	suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations, rootChainType).Return(txreceipt, nil)
 	req = abci.RequestQuery{
 		Path: route,
 		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordSequenceParams("12345", logIndex, rootChainType)),
@@ -246,7 +247,7 @@ func (suite *QuerierTestSuite) TestHandleQueryRecordSequence() {
 	// tron
 	testSeq = helper.CalculateSequence(big.NewInt(1), 1, hmTypes.RootChainTypeTron).String()
 	ck.SetRecordSequence(ctx, testSeq)
-	suite.contractCaller.On("GetTronTransactionReceipt", txHash.TronHash().String()).Return(txreceipt, nil)
+	suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.TronHash().String()).Return(txreceipt, nil)
 	req = abci.RequestQuery{
 		Path: route,
 		Data: app.Codec().MustMarshalJSON(types.NewQueryRecordSequenceParams("12345", logIndex, hmTypes.RootChainTypeTron)),
@@ -68,7 +68,7 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 	var contractAddress ethCommon.Address
 	switch msg.RootChainType {
 	case hmTypes.RootChainTypeEth:
-		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), params.MainchainTxConfirmations,
+		receipt, err = contractCaller.GetConfirmedTxReceipt(ctx.Context(), msg.TxHash.EthHash(), params.MainchainTxConfirmations,
 			hmTypes.RootChainTypeEth)
 		if err != nil || receipt == nil {
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
@@ -80,14 +80,14 @@ func SideHandleMsgEventRecord(ctx sdk.Context, k Keeper, msg types.MsgEventRecor
 			k.Logger(ctx).Error("RootChain type: ", msg.RootChainType, " does not  match bsc")
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWrongRootChainType)
 		}
-		receipt, err = contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), bscChain.TxConfirmations,
+		receipt, err = contractCaller.GetConfirmedTxReceipt(ctx.Context(), msg.TxHash.EthHash(), bscChain.TxConfirmations,
 			hmTypes.RootChainTypeBsc)
 		if err != nil || receipt == nil {
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 		}
 		contractAddress = bscChain.StateSenderAddress.EthAddress()
 	case hmTypes.RootChainTypeTron:
-		receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+		receipt, err = contractCaller.GetTronTransactionReceipt(ctx.Context(), msg.TxHash.Hex())
 		if err != nil || receipt == nil {
 			return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 		}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/app"
 	authCli "github.com/maticnetwork/heimdall/auth/client/cli"
+	checkpointCli "github.com/maticnetwork/heimdall/checkpoint/client/cli"
 	hmTxCli "github.com/maticnetwork/heimdall/client/tx"
 	"github.com/maticnetwork/heimdall/helper"
 )
@@ -98,6 +99,7 @@ func main() {
 		client.LineBreak,
 		keys.Commands(),
 		exportCmd(ctx, cdc),
+		checkpointCli.GetExportCheckpointsCmd(cdc),
 		convertAddressToHexCmd(cdc),
 		convertHexToAddressCmd(cdc),
 		generateKeystore(cdc),
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"math/big"
@@ -67,7 +68,7 @@ func StakeCmd(cliCtx cliContext.CLIContext) *cobra.Command {
 				return err
 			}
 
-			return contractCaller.StakeFor(
+			return contractCaller.StakeFor(context.Background(),
 				common.HexToAddress(validatorStr),
 				stakeAmount,
 				feeAmount,
@@ -127,7 +128,7 @@ func ApproveCmd(cliCtx cliContext.CLIContext) *cobra.Command {
 				return err
 			}
 
-			return contractCaller.ApproveTokens(stakeAmount.Add(stakeAmount, feeAmount), stakingManagerAddress, maticTokenAddress, maticTokenInstance)
+			return contractCaller.ApproveTokens(context.Background(), stakeAmount.Add(stakeAmount, feeAmount), stakingManagerAddress, maticTokenAddress, maticTokenInstance)
 		},
 	}
 
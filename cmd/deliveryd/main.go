@@ -19,7 +19,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	ethCommon "github.com/ethereum/go-ethereum/common"
@@ -166,6 +165,7 @@ func main() {
 
 	// rollback cmd
 	rootCmd.AddCommand(rollback.RollbackCmd(ctx))
+	rootCmd.AddCommand(pruneStateCmd(ctx))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.deliveryd"))
@@ -181,9 +181,12 @@ func getNewApp(serverCtx *server.Context) func(logger log.Logger, db dbm.DB, sto
 		// init heimdall config
 		helper.InitDeliveryConfig("")
 		helper.UpdateTendermintConfig(serverCtx.Config, viper.GetViper())
+		pruning, err := pruningOptions()
+		if err != nil {
+			panic(err)
+		}
 		// create new heimdall app
-		return app.NewHeimdallApp(logger, db,
-			baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
+		return app.NewHeimdallApp(logger, db, baseapp.SetPruning(pruning))
 	}
 }
 
@@ -199,10 +202,12 @@ func deliveryStart(shutdownCtx context.Context,
 		Use:   "start",
 		Short: "Run the full node",
 		Long: `Run the full node application with Tendermint in process.
-Pruning options can be provided via the '--pruning' flag. The options are as follows:
-syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
+Pruning options are read from delivery config and can be overridden via the '--pruning' flag. The options are as follows:
+default, syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: all saved states will be deleted, storing only the current state
+custom: keeps last pruning_keep_recent states + every pruning_keep_every-th state set in delivery config
+Historic states accumulated before switching strategy can be deleted with 'prune-state'.
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
@@ -228,7 +233,7 @@ which accepts a path for the resulting pprof file.
 	// core flags for the ABCI application
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(flagPruning, "", "Pruning strategy: default, syncable, nothing, everything or custom, overrides pruning of delivery config")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		`Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum 
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/cli"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/cmd/deliveryd/rollback"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// pruningOptions returns pruning options set in delivery config, strategy of --pruning flag overrides it
+func pruningOptions() (storeTypes.PruningOptions, error) {
+	config := helper.GetConfig()
+	if strategy := viper.GetString(flagPruning); strategy != "" {
+		config.Pruning = strategy
+	}
+
+	return helper.NewPruningOptions(config)
+}
+
+// pruneStateCmd deletes historical versions of application state of stopped node
+func pruneStateCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{ // nolint: exhaustivestruct
+		Use:   "prune-state",
+		Short: "Delete historical versions of application state not kept by pruning strategy",
+		Long: `Delete historical versions of application state the pruning strategy does not keep and
+compact application db, as if node had been running with the strategy all along. Strategy is
+read from delivery config, '--pruning' flag overrides it. Node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+			helper.InitDeliveryConfig("")
+
+			pruning, err := pruningOptions()
+			if err != nil {
+				return err
+			}
+
+			database, err := openDB(config.RootDir)
+			if err != nil {
+				return fmt.Errorf("failed to open DB: %w", err)
+			}
+			defer database.Close()
+
+			cms := rollback.NewMultiStore(database)
+			cms.MountKeys()
+			if err := cms.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load application state: %w", err)
+			}
+
+			deleted, err := cms.PruneVersions(func(version int64, latest int64) bool {
+				return helper.KeepVersion(pruning, version, latest)
+			})
+			if err != nil {
+				return err
+			}
+			// nolint: forbidigo
+			fmt.Printf("Deleted %d store versions below height %d\n", deleted, cms.LastCommitID().Version)
+
+			// deleted nodes only free disk space once compacted
+			goLevelDB, ok := database.(*dbm.GoLevelDB)
+			if !ok {
+				// nolint: forbidigo
+				fmt.Println("Application db is not goleveldb, skipped compaction")
+				return nil
+			}
+
+			return goLevelDB.DB().CompactRange(util.Range{})
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagPruning, "", "Pruning strategy: default, syncable, nothing, everything or custom, overrides pruning of delivery config")
+
+	return cmd
+}
@@ -116,6 +116,21 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return nil
 }
 
+// AvailableVersions returns versions stored by the MutableTree in ascending order.
+// An immutable store only holds the version it was loaded at.
+func (st *Store) AvailableVersions() []int64 {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return []int64{st.tree.Version()}
+	}
+
+	versions := make([]int64, 0)
+	for _, version := range tree.AvailableVersions() {
+		versions = append(versions, int64(version))
+	}
+	return versions
+}
+
 // LoadVersionForOverwriting attempts to load a tree at a previously committed
 // version, or the latest version below it. Any versions greater than targetVersion will be deleted.
 func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
@@ -89,6 +89,33 @@ func (rs *Store) RollbackToVersion(target int64) int64 {
 	return target
 }
 
+// PruneVersions deletes versions older than the latest version from each mounted IAVL sub-store
+// unless keep returns true for them. Returns the number of store versions deleted.
+func (rs *Store) PruneVersions(keep func(version int64, latest int64) bool) (int, error) {
+	latest := rs.lastCommitID.Version
+	deleted := 0
+
+	for key := range rs.stores {
+		store := rs.GetCommitKVStore(key)
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		for _, version := range store.(*iavl.Store).AvailableVersions() {
+			if version >= latest || keep(version, latest) {
+				continue
+			}
+
+			if err := store.(*iavl.Store).DeleteVersions(version); err != nil {
+				return deleted, fmt.Errorf("cannot delete version %d of store %s: %w", version, key.Name(), err)
+			}
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
 // pruneStores will batch delete a list of heights from each mounted sub-store.
 // Afterwards, pruneHeights is reset.
 func (rs *Store) pruneStores() {
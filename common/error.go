@@ -1,6 +1,7 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -32,6 +33,20 @@ const (
 	CodeWrongRootChain           CodeType = 1512
 	CodeNoChainParams            CodeType = 1513
 	CodeChainParamsExist         CodeType = 1514
+	CodeLowProposerPower         CodeType = 1515
+	CodeInvalidSyncStart         CodeType = 1516
+	CodeCheckpointTooLarge       CodeType = 1517
+	CodeInvalidAckNumber         CodeType = 1518
+	CodeCostEstimateUnsupported  CodeType = 1519
+	CodeRootChainPaused          CodeType = 1520
+	CodeInvalidProposerSignature CodeType = 1521
+	CodeAckBufferNotFound        CodeType = 1522
+	CodeAckNotOnRootChain        CodeType = 1523
+	CodeInvalidCheckpointCancel  CodeType = 1524
+	CodeEpochMismatch            CodeType = 1525
+	CodeProposerMismatch         CodeType = 1526
+	CodeNoProposer               CodeType = 1527
+	CodeInvalidCheckpointStart   CodeType = 1528
 
 	CodeOldValidator        CodeType = 2500
 	CodeNoValidator         CodeType = 2501
@@ -145,6 +160,106 @@ func ErrChainPamramsExist(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeChainParamsExist, "root chain chain params has exist")
 }
 
+func ErrLowProposerPower(codespace sdk.CodespaceType, power int64, totalPower int64) sdk.Error {
+	return newError(codespace, CodeLowProposerPower, fmt.Sprintf("Proposer voting power too low, power %d of total %d", power, totalPower))
+}
+
+func ErrInvalidSyncStart(codespace sdk.CodespaceType, expected uint64, start uint64) sdk.Error {
+	return newError(codespace, CodeInvalidSyncStart, fmt.Sprintf("First checkpoint sync should start at sync genesis block %d, got %d", expected, start))
+}
+
+func ErrCheckpointTooLarge(codespace sdk.CodespaceType, span uint64, maxSpan uint64) sdk.Error {
+	return newError(codespace, CodeCheckpointTooLarge, fmt.Sprintf("Checkpoint too large, span %d exceeds max %d", span, maxSpan))
+}
+
+func ErrInvalidAckNumber(codespace sdk.CodespaceType, expected uint64, tolerance uint64, number uint64) sdk.Error {
+	return newError(codespace, CodeInvalidAckNumber, fmt.Sprintf("Invalid ack number %d, expected %d with tolerance %d", number, expected, tolerance))
+}
+
+func ErrCostEstimateUnsupported(codespace sdk.CodespaceType, rootChain string) sdk.Error {
+	return newError(codespace, CodeCostEstimateUnsupported, fmt.Sprintf("Checkpoint cost estimate is not supported for root chain %s", rootChain))
+}
+
+func ErrRootChainPaused(codespace sdk.CodespaceType, rootChain string) sdk.Error {
+	return newError(codespace, CodeRootChainPaused, fmt.Sprintf("Checkpoints are paused for root chain %s", rootChain))
+}
+
+func ErrInvalidProposerSignature(codespace sdk.CodespaceType, proposer string) sdk.Error {
+	return newError(codespace, CodeInvalidProposerSignature, fmt.Sprintf("Message is not signed by proposer %s", proposer))
+}
+
+// ErrAckBufferNotFound is returned for ack arriving before checkpoint is buffered, relayer should retry it.
+// Ack not matching existing buffer fails with ErrBadAck and should be abandoned.
+func ErrAckBufferNotFound(codespace sdk.CodespaceType, rootChain string) sdk.Error {
+	return newError(codespace, CodeAckBufferNotFound, fmt.Sprintf("No checkpoint in buffer to ack for root chain %s, retry later", rootChain))
+}
+
+func ErrAckNotOnRootChain(codespace sdk.CodespaceType, rootChain string, number uint64) sdk.Error {
+	return newError(codespace, CodeAckNotOnRootChain, fmt.Sprintf("Ack doesn't match checkpoint %d recorded on root chain %s", number, rootChain))
+}
+
+func ErrInvalidCheckpointCancel(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return newError(codespace, CodeInvalidCheckpointCancel, fmt.Sprintf("Invalid checkpoint cancel: %s", reason))
+}
+
+// EpochMismatch is data of ErrEpochMismatch
+type EpochMismatch struct {
+	Expected uint64 `json:"expected"`
+	Received uint64 `json:"received"`
+}
+
+// ErrEpochMismatch is returned for checkpoint proposed for other than current epoch, proposer should
+// wait for ack of previous checkpoint or propose again for expected epoch
+func ErrEpochMismatch(codespace sdk.CodespaceType, expected uint64, received uint64) sdk.Error {
+	return newErrorWithData(codespace, CodeEpochMismatch, fmt.Sprintf("Checkpoint epoch %d doesn't match current epoch %d", received, expected),
+		EpochMismatch{Expected: expected, Received: received})
+}
+
+// ProposerMismatch is data of ErrProposerMismatch
+type ProposerMismatch struct {
+	Expected types.HeimdallAddress `json:"expected"`
+	Received types.HeimdallAddress `json:"received"`
+}
+
+// ErrProposerMismatch is returned for checkpoint proposed by validator other than current proposer
+// or its backups
+func ErrProposerMismatch(codespace sdk.CodespaceType, expected types.HeimdallAddress, received types.HeimdallAddress) sdk.Error {
+	return newErrorWithData(codespace, CodeProposerMismatch, fmt.Sprintf("Checkpoint proposer %v is not current proposer %v", received.String(), expected.String()),
+		ProposerMismatch{Expected: expected, Received: received})
+}
+
+func ErrNoProposer(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeNoProposer, "No proposer in stored validator set")
+}
+
+// AccountRootMismatch is data of ErrAccountRootMismatch
+type AccountRootMismatch struct {
+	RootChain string             `json:"root_chain"`
+	Expected  types.HeimdallHash `json:"expected"`
+	Received  types.HeimdallHash `json:"received"`
+}
+
+// ErrAccountRootMismatch is returned for checkpoint carrying stale account root, proposer should
+// recompute account root from current dividend accounts
+func ErrAccountRootMismatch(codespace sdk.CodespaceType, rootChain string, expected types.HeimdallHash, received types.HeimdallHash) sdk.Error {
+	return newErrorWithData(codespace, CodeAccountRootMismatch, fmt.Sprintf("Account root %v doesn't match current account root %v of root chain %s", received.String(), expected.String(), rootChain),
+		AccountRootMismatch{RootChain: rootChain, Expected: expected, Received: received})
+}
+
+// CheckpointStartMismatch is data of ErrInvalidCheckpointStart
+type CheckpointStartMismatch struct {
+	RootChain string `json:"root_chain"`
+	Expected  uint64 `json:"expected"`
+	Received  uint64 `json:"received"`
+}
+
+// ErrInvalidCheckpointStart is returned for first checkpoint of root chain not starting at its first
+// checkpoint start block
+func ErrInvalidCheckpointStart(codespace sdk.CodespaceType, rootChain string, expected uint64, received uint64) sdk.Error {
+	return newErrorWithData(codespace, CodeInvalidCheckpointStart, fmt.Sprintf("First checkpoint of root chain %s should start at block %d, got %d", rootChain, expected, received),
+		CheckpointStartMismatch{RootChain: rootChain, Expected: expected, Received: received})
+}
+
 func ErrInvalidNoACK(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidNoACK, "Invalid No ACK -- Waiting for last checkpoint ACK")
 }
@@ -301,6 +416,36 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "Checkpoint not in countinuity"
 	case CodeNoCheckpointBuffer:
 		return "Checkpoint buffer Not Found"
+	case CodeLowProposerPower:
+		return "Proposer voting power too low"
+	case CodeInvalidSyncStart:
+		return "Invalid checkpoint sync start block"
+	case CodeCheckpointTooLarge:
+		return "Checkpoint too large"
+	case CodeInvalidAckNumber:
+		return "Invalid checkpoint ack number"
+	case CodeCostEstimateUnsupported:
+		return "Checkpoint cost estimate not supported"
+	case CodeRootChainPaused:
+		return "Checkpoints paused for root chain"
+	case CodeInvalidProposerSignature:
+		return "Invalid proposer signature"
+	case CodeAckBufferNotFound:
+		return "Checkpoint buffer not found for ack"
+	case CodeAckNotOnRootChain:
+		return "Ack doesn't match root chain contract"
+	case CodeInvalidCheckpointCancel:
+		return "Invalid checkpoint cancel"
+	case CodeEpochMismatch:
+		return "Checkpoint epoch mismatch"
+	case CodeProposerMismatch:
+		return "Checkpoint proposer mismatch"
+	case CodeNoProposer:
+		return "No proposer in stored validator set"
+	case CodeInvalidCheckpointStart:
+		return "Invalid first checkpoint start block"
+	case CodeAccountRootMismatch:
+		return "Account root mismatch"
 
 	case CodeOldValidator:
 		return "Start Epoch behind Current Epoch"
@@ -355,6 +500,32 @@ func newError(codespace sdk.CodespaceType, code CodeType, msg string) sdk.Error
 	return sdk.NewError(codespace, code, msg)
 }
 
+// sdkError is embedded by dataError under other name than its Error method
+type sdkError = sdk.Error
+
+// dataError is error carrying machine readable payload, which is set as JSON result data
+// so clients can react to error without parsing its log
+type dataError struct {
+	sdkError
+	data interface{}
+}
+
+func newErrorWithData(codespace sdk.CodespaceType, code CodeType, msg string, data interface{}) sdk.Error {
+	return dataError{sdkError: newError(codespace, code, msg), data: data}
+}
+
+// Data returns payload of error
+func (err dataError) Data() interface{} {
+	return err.data
+}
+
+// Result returns result of error with payload as data
+func (err dataError) Result() sdk.Result {
+	res := err.sdkError.Result()
+	res.Data, _ = json.Marshal(err.data)
+	return res
+}
+
 // Slashing errors
 func ErrValidatorSigningInfoSave(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeValSigningInfoSave, "Cannot save validator signing info")
@@ -41,6 +41,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30 and 0x31 are used by upgrade module
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -0,0 +1,149 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/tendermint/crypto/sha3"
+	"github.com/xsleonard/go-merkle"
+	"golang.org/x/sync/errgroup"
+)
+
+// batchCall sends elements in JSON-RPC batches of batch size, concurrently. Batch call succeeds even if
+// single calls fail, so error of first failed element is returned as well.
+func batchCall(ctx context.Context, rpcClient *rpc.Client, elements []rpc.BatchElem, batchSize int) error {
+	if batchSize <= 0 {
+		batchSize = DefaultRPCBatchSize
+	}
+
+	var g errgroup.Group
+	for i := 0; i < len(elements); i += batchSize {
+		end := i + batchSize
+		if end > len(elements) {
+			end = len(elements)
+		}
+
+		batch := elements[i:end]
+		g.Go(func() error {
+			return rpcClient.BatchCallContext(ctx, batch)
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		if element.Error != nil {
+			return fmt.Errorf("%s failed: %w", element.Method, element.Error)
+		}
+	}
+	return nil
+}
+
+// headersRootHash computes root hash of checkpoint from child chain headers the way bor does: leaves are
+// keccak hashes of number, time, tx hash and receipt hash of headers, padded to power of two with empty leaves
+func headersRootHash(headers []*ethTypes.Header) ([]byte, error) {
+	leaves := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	err := ParallelFor(len(leaves), MerkleWorkers, func(start, end int) error {
+		for i := start; i < end; i++ {
+			if i < len(headers) {
+				leaves[i] = headerLeaf(headers[i])
+			} else {
+				leaves[i] = make([]byte, 32)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ParallelMerkleRoot(leaves, sha3.NewLegacyKeccak256, MerkleWorkers)
+}
+
+// HeaderProof returns leaf of header at index and its merkle proof, sibling hashes from leaf up
+// concatenated, against root hash of headers
+func HeaderProof(headers []*ethTypes.Header, index uint64) (leaf []byte, proof []byte, root []byte, err error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, nil, fmt.Errorf("header index %d out of range of %d headers", index, len(headers))
+	}
+
+	tree, err := headersTree(headers)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	leaves := tree.Leaves()
+	leaf = leaves[index].Hash
+
+	// levels are padded to power of two, so every node below root has a sibling
+	position := index
+	for height := tree.Height(); height > 1; height-- {
+		proof = append(proof, tree.GetNodesAtHeight(height)[position^1].Hash...)
+		position /= 2
+	}
+
+	return leaf, proof, tree.Root().Hash, nil
+}
+
+// headersTree builds tree of header leaves padded with zero leaves to power of two, as bor does for checkpoints
+func headersTree(headers []*ethTypes.Header) (*merkle.Tree, error) {
+	leaves := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i := range leaves {
+		leaves[i] = make([]byte, 32)
+	}
+
+	for i, header := range headers {
+		leaves[i] = headerLeaf(header)
+	}
+
+	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
+	if err := tree.Generate(leaves, sha3.NewLegacyKeccak256()); err != nil {
+		return nil, err
+	}
+	return &tree, nil
+}
+
+// headerLeaf returns checkpoint tree leaf of header
+func headerLeaf(header *ethTypes.Header) []byte {
+	return crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// appendBytes32 concatenates non empty values left padded to 32 bytes
+func appendBytes32(data ...[]byte) []byte {
+	var result []byte
+	for _, v := range data {
+		if len(v) == 0 || len(v) > 32 {
+			continue
+		}
+		result = append(result, common.LeftPadBytes(v, 32)...)
+	}
+	return result
+}
+
+func nextPowerOfTwo(n uint64) uint64 {
+	if n == 0 {
+		return 1
+	}
+	// http://graphics.stanford.edu/~seander/bithacks.html#RoundUpPowerOf2
+	n--
+	n |= n >> 1
+	n |= n >> 2
+	n |= n >> 4
+	n |= n >> 8
+	n |= n >> 16
+	n |= n >> 32
+	n++
+	return n
+}
@@ -0,0 +1,111 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testBatchService struct{}
+
+func (s *testBatchService) Echo(n uint64) uint64 {
+	return n
+}
+
+func TestBatchCall(t *testing.T) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("test", new(testBatchService)))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	// elements are split into batches, each result lands in its element
+	results := make([]uint64, 7)
+	elements := make([]rpc.BatchElem, len(results))
+	for i := range elements {
+		elements[i] = rpc.BatchElem{Method: "test_echo", Args: []interface{}{uint64(i)}, Result: &results[i]}
+	}
+	require.NoError(t, batchCall(context.Background(), client, elements, 3))
+	for i, result := range results {
+		require.Equal(t, uint64(i), result)
+	}
+
+	// failed element fails whole call
+	elements = append(elements, rpc.BatchElem{Method: "test_missing", Result: new(uint64)})
+	require.Error(t, batchCall(context.Background(), client, elements, 3))
+}
+
+func TestHeadersRootHash(t *testing.T) {
+	header := func(number int64) *ethTypes.Header {
+		return &ethTypes.Header{
+			Number:      big.NewInt(number),
+			Time:        uint64(1000 + number),
+			TxHash:      common.BigToHash(big.NewInt(number)),
+			ReceiptHash: common.BigToHash(big.NewInt(number + 1)),
+		}
+	}
+	leaf := func(h *ethTypes.Header) []byte {
+		return crypto.Keccak256(
+			common.LeftPadBytes(h.Number.Bytes(), 32),
+			common.LeftPadBytes(new(big.Int).SetUint64(h.Time).Bytes(), 32),
+			h.TxHash.Bytes(),
+			h.ReceiptHash.Bytes(),
+		)
+	}
+
+	// single header is root itself
+	root, err := headersRootHash([]*ethTypes.Header{header(1)})
+	require.NoError(t, err)
+	require.Equal(t, leaf(header(1)), root)
+
+	// leaves are padded to power of two with empty ones
+	root, err = headersRootHash([]*ethTypes.Header{header(1), header(2), header(3)})
+	require.NoError(t, err)
+	left := crypto.Keccak256(leaf(header(1)), leaf(header(2)))
+	right := crypto.Keccak256(leaf(header(3)), make([]byte, 32))
+	require.Equal(t, crypto.Keccak256(left, right), root)
+}
+
+func TestHeaderProof(t *testing.T) {
+	headers := make([]*ethTypes.Header, 5)
+	for i := range headers {
+		number := int64(100 + i)
+		headers[i] = &ethTypes.Header{
+			Number:      big.NewInt(number),
+			Time:        uint64(1000 + number),
+			TxHash:      common.BigToHash(big.NewInt(number)),
+			ReceiptHash: common.BigToHash(big.NewInt(number + 1)),
+		}
+	}
+
+	root, err := headersRootHash(headers)
+	require.NoError(t, err)
+
+	// every header proves against root, with sibling on side given by its position
+	for i := range headers {
+		leaf, proof, proofRoot, err := HeaderProof(headers, uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, root, proofRoot)
+		require.Len(t, proof, 3*32)
+
+		computed := leaf
+		position := i
+		for j := 0; j < len(proof); j += 32 {
+			if position%2 == 0 {
+				computed = crypto.Keccak256(computed, proof[j:j+32])
+			} else {
+				computed = crypto.Keccak256(proof[j:j+32], computed)
+			}
+			position /= 2
+		}
+		require.Equal(t, root, computed)
+	}
+
+	_, _, _, err = HeaderProof(headers, uint64(len(headers)))
+	require.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// immutable cache entry kinds
+const (
+	cacheKindReceipt  = "receipt"
+	cacheKindRootHash = "roothash"
+)
+
+// immutableCacheKey identifies contract caller read which never changes once final. Reads of child chain
+// ranges are keyed by hash of their last block, so a reorged range never serves stale entry.
+type immutableCacheKey struct {
+	kind      string
+	rootChain string
+	blockHash common.Hash
+	id        string
+}
+
+// getImmutable returns cached read, cache is optional so contract caller built without it never hits
+func (c *ContractCaller) getImmutable(key immutableCacheKey) (interface{}, bool) {
+	if c.ImmutableCache == nil {
+		return nil, false
+	}
+	return c.ImmutableCache.Get(key)
+}
+
+// addImmutable caches read which is final
+func (c *ContractCaller) addImmutable(key immutableCacheKey, value interface{}) {
+	if c.ImmutableCache == nil {
+		return
+	}
+	c.ImmutableCache.Add(key, value)
+}
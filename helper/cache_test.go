@@ -0,0 +1,32 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmutableCache(t *testing.T) {
+	// contract caller without cache never hits
+	var uncached ContractCaller
+	key := immutableCacheKey{kind: cacheKindRootHash, blockHash: common.HexToHash("0x1"), id: "1"}
+	uncached.addImmutable(key, []byte{1})
+	_, ok := uncached.getImmutable(key)
+	require.False(t, ok)
+
+	cache, err := NewLru(10)
+	require.NoError(t, err)
+	caller := ContractCaller{ImmutableCache: cache}
+
+	caller.addImmutable(key, []byte{1})
+	cached, ok := caller.getImmutable(key)
+	require.True(t, ok)
+	require.Equal(t, []byte{1}, cached)
+
+	// same range ending in reorged block misses
+	reorged := key
+	reorged.blockHash = common.HexToHash("0x2")
+	_, ok = caller.getImmutable(reorged)
+	require.False(t, ok)
+}
@@ -34,20 +34,22 @@ import (
 
 // IContractCaller represents contract caller
 type IContractCaller interface {
-	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
-	GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error)
-	GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
-	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
-	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
-	GetBalance(address common.Address) (*big.Int, error)
-	SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) (err error)
-	SendTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error
-	SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
-	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
-	GetMainChainBlock(*big.Int, string) (*ethTypes.Header, error)
-	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
-	GetConfirmedTxReceipt(common.Hash, uint64, string) (*ethTypes.Receipt, error)
-	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
+	GetHeaderInfo(ctx context.Context, headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetRootHash(ctx context.Context, start uint64, end uint64, checkpointLength uint64) ([]byte, error)
+	GetValidatorInfo(ctx context.Context, valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
+	GetLastChildBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain) (uint64, error)
+	CurrentHeaderBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
+	GetBalance(ctx context.Context, address common.Address) (*big.Int, error)
+	GetGasPrice(ctx context.Context, rootChain string) (*big.Int, error)
+	SendCheckpoint(ctx context.Context, sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) (err error)
+	SendTronCheckpoint(ctx context.Context, signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error
+	SendTick(ctx context.Context, sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (err error)
+	GetCheckpointSign(ctx context.Context, txHash common.Hash) ([]byte, []byte, []byte, error)
+	GetMainChainBlock(context.Context, *big.Int, string) (*ethTypes.Header, error)
+	GetMaticChainBlock(context.Context, *big.Int) (*ethTypes.Header, error)
+	GetMaticChainHeaders(ctx context.Context, start uint64, end uint64) ([]*ethTypes.Header, error)
+	GetConfirmedTxReceipt(context.Context, common.Hash, uint64, string) (*ethTypes.Receipt, error)
+	GetBlockNumberFromTxHash(context.Context, common.Hash) (*big.Int, error)
 
 	// decode header event
 	DecodeNewHeaderBlockEvent(common.Address, *ethTypes.Receipt, uint64) (*rootchain.RootchainNewHeaderBlock, error)
@@ -64,23 +66,24 @@ type IContractCaller interface {
 	DecodeSlashedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSlashed, error)
 	DecodeUnJailedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnJailed, error)
 
-	GetMainTxReceipt(common.Hash, string) (*ethTypes.Receipt, error)
-	GetMaticTxReceipt(common.Hash) (*ethTypes.Receipt, error)
-	ApproveTokens(*big.Int, common.Address, common.Address, *erc20.Erc20) error
-	StakeFor(common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error
-	CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error)
+	GetMainTxReceipt(context.Context, common.Hash, string) (*ethTypes.Receipt, error)
+	GetMaticTxReceipt(context.Context, common.Hash) (*ethTypes.Receipt, error)
+	GetTxReceipts(context.Context, []common.Hash, string) ([]*ethTypes.Receipt, error)
+	ApproveTokens(context.Context, *big.Int, common.Address, common.Address, *erc20.Erc20) error
+	StakeFor(context.Context, common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error
+	CurrentAccountStateRoot(ctx context.Context, stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error)
 
 	// bor related contracts
-	CurrentSpanNumber(validatorset *validatorset.Validatorset) (Number *big.Int)
-	GetSpanDetails(id *big.Int, validatorset *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
-	CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int)
-	CheckIfBlocksExist(end uint64) bool
+	CurrentSpanNumber(ctx context.Context, validatorset *validatorset.Validatorset) (Number *big.Int)
+	GetSpanDetails(ctx context.Context, id *big.Int, validatorset *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
+	CurrentStateCounter(ctx context.Context, stateSenderInstance *statesender.Statesender) (Number *big.Int)
+	CheckIfBlocksExist(ctx context.Context, end uint64) bool
 
 	// staking sync
-	GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64)
-	GetTronStakingSyncNonce(validatorID uint64, stakingManagerAddress string) (nonce uint64)
-	SendMainStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (err error)
-	SendTronStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (err error)
+	GetMainStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64)
+	GetTronStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerAddress string) (nonce uint64)
+	SendMainStakingSync(ctx context.Context, stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (err error)
+	SendTronStakingSync(ctx context.Context, stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (err error)
 
 	GetRootChainInstance(rootchainAddress common.Address, rootChain string) (*rootchain.Rootchain, error)
 	GetStakingInfoInstance(stakingInfoAddress common.Address, rootChain string) (*stakinginfo.Stakinginfo, error)
@@ -91,13 +94,13 @@ type IContractCaller interface {
 	GetStateReceiverInstance(stateReceiverAddress common.Address) (*statereceiver.Statereceiver, error)
 	GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error)
 
-	GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
-	GetTronEventsByContractAddress(address []string, from, to int64) ([]ethTypes.Log, error)
-	GetTronTransactionReceipt(txID string) (*ethTypes.Receipt, error)
-	GetTronLatestBlockNumber() (int64, error)
+	GetTronHeaderInfo(ctx context.Context, headerID uint64, rootChainAddress string, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetTronEventsByContractAddress(ctx context.Context, address []string, from, to int64) ([]ethTypes.Log, error)
+	GetTronTransactionReceipt(ctx context.Context, txID string) (*ethTypes.Receipt, error)
+	GetTronLatestBlockNumber(ctx context.Context) (int64, error)
 
 	// checkpoint sync
-	GetSyncedCheckpointId(rootChain string, contractAddress string) (currentHeader uint64, err error)
+	GetSyncedCheckpointId(ctx context.Context, rootChain string, contractAddress string) (currentHeader uint64, err error)
 	GetStartListenBlock(rootChainType string) uint64
 
 	// new chain
@@ -123,7 +126,7 @@ type ContractCaller struct {
 	SlashManagerABI  abi.ABI
 	MaticTokenABI    abi.ABI
 
-	ReceiptCache     *lru.Cache
+	ImmutableCache   *lru.Cache // receipts and child chain reads which are final, shared by processors validating the same checkpoint
 	LatestBlockCache map[string]uint64
 
 	ContractInstanceCache map[string]interface{}
@@ -144,11 +147,16 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MainChainClient = GetMainClient()
 	contractCallerObj.MaticChainClient = GetMaticClient()
 	contractCallerObj.BscChainClient = GetBscClient()
-	contractCallerObj.TronChainRPC = GetTronChainRPCClient()
 	contractCallerObj.MainChainRPC = GetMainChainRPCClient()
 	contractCallerObj.BscChainRPC = GetBscChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
-	contractCallerObj.ReceiptCache, _ = NewLru(5000)
+	contractCallerObj.ImmutableCache, _ = NewLru(GetConfig().ContractCallerCacheSize)
+	contractCallerObj.LatestBlockCache = make(map[string]uint64)
+
+	if contractCallerObj.TronChainRPC, err = GetTronChainRPCClient(); err != nil {
+		Logger.Error("Unable to create tron grpc client", "url", GetConfig().TronRPCUrl, "error", err)
+		return
+	}
 
 	//
 	// ABIs
@@ -191,18 +199,43 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	return
 }
 
+// callContext bounds ctx of contract caller method by contract call timeout, so a hung node fails the call
+// instead of stalling its caller
+func callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := GetConfig().ContractCallTimeout; timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// rootChainClient returns eth client of eth fork root chain, nil for other root chains
+func (c *ContractCaller) rootChainClient(rootChain string) *ethclient.Client {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return c.MainChainClient
+	case hmTypes.RootChainTypeBsc:
+		return c.BscChainClient
+	}
+	return GetRootChainClient(rootChain)
+}
+
+// rootChainRPC returns RPC client of eth fork root chain, nil for other root chains
+func (c *ContractCaller) rootChainRPC(rootChain string) *rpc.Client {
+	switch rootChain {
+	case hmTypes.RootChainTypeEth:
+		return c.MainChainRPC
+	case hmTypes.RootChainTypeBsc:
+		return c.BscChainRPC
+	}
+	return GetRootChainRPCClient(rootChain)
+}
+
 // GetRootChainInstance returns RootChain contract instance for selected base chain
 func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address, rootChain string) (*rootchain.Rootchain, error) {
 	cacheKey := rootchainAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
-		}
+		client := c.rootChainClient(rootChain)
 		ci, err := rootchain.NewRootchain(rootchainAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
 		return ci, err
@@ -215,13 +248,7 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 	cacheKey := stakingInfoAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
-		}
+		client := c.rootChainClient(rootChain)
 		ci, err := stakinginfo.NewStakinginfo(stakingInfoAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
 		return ci, err
@@ -246,13 +273,7 @@ func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Ad
 	cacheKey := stakingManagerAddress.String() + rootChain
 	contractInstance, ok := c.ContractInstanceCache[cacheKey]
 	if !ok {
-		var client *ethclient.Client
-		switch rootChain {
-		case hmTypes.RootChainTypeEth:
-			client = mainChainClient
-		case hmTypes.RootChainTypeBsc:
-			client = bscChainClient
-		}
+		client := c.rootChainClient(rootChain)
 		ci, err := stakemanager.NewStakemanager(stakingManagerAddress, client)
 		c.ContractInstanceCache[cacheKey] = ci
 		return ci, err
@@ -314,7 +335,7 @@ func NewLru(size int) (*lru.Cache, error) {
 }
 
 // GetHeaderInfo get header info from checkpoint number
-func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (
+func (c *ContractCaller) GetHeaderInfo(ctx context.Context, number uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (
 	root common.Hash,
 	start uint64,
 	end uint64,
@@ -322,9 +343,12 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 	proposer types.HeimdallAddress,
 	err error,
 ) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// get header from rootchain
 	checkpointBigInt := big.NewInt(0).Mul(big.NewInt(0).SetUint64(number), big.NewInt(0).SetUint64(childBlockInterval))
-	headerBlock, err := rootChainInstance.HeaderBlocks(nil, checkpointBigInt)
+	headerBlock, err := rootChainInstance.HeaderBlocks(&bind.CallOpts{Context: ctx}, checkpointBigInt)
 	if err != nil {
 		Logger.Error("Unable to fetch checkpoint block", "error", err)
 		return root, start, end, createdAt, proposer, errors.New("Unable to fetch checkpoint block")
@@ -339,7 +363,10 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 }
 
 // GetRootHash get root hash from bor chain
-func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
+func (c *ContractCaller) GetRootHash(ctx context.Context, start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	noOfBlock := end - start + 1
 
 	if start > end {
@@ -350,17 +377,45 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 		return nil, errors.New("number of headers requested exceeds")
 	}
 
-	rootHash, err := c.MaticChainClient.GetRootHash(context.Background(), start, end)
+	// root hash is keyed by hash of end block, so it is computed again if range is reorged
+	endHeader, err := c.GetMaticChainBlock(ctx, new(big.Int).SetUint64(end))
 	if err != nil {
 		return nil, errors.New("Could not fetch roothash from matic chain")
 	}
 
-	return common.FromHex(rootHash), nil
+	cacheKey := immutableCacheKey{kind: cacheKindRootHash, blockHash: endHeader.Hash(), id: strconv.FormatUint(start, 10)}
+	if cached, ok := c.getImmutable(cacheKey); ok {
+		return cached.([]byte), nil
+	}
+
+	var root []byte
+	if rootHash, err := c.MaticChainClient.GetRootHash(ctx, start, end); err == nil {
+		root = common.FromHex(rootHash)
+	} else {
+		// node without bor api, compute root hash from headers fetched in batches
+		Logger.Debug("Unable to fetch roothash from matic chain, computing it from headers", "start", start, "end", end, "error", err)
+
+		headers, err := c.GetMaticChainHeaders(ctx, start, end)
+		if err != nil {
+			Logger.Error("Unable to fetch headers from matic chain", "start", start, "end", end, "error", err)
+			return nil, errors.New("Could not fetch roothash from matic chain")
+		}
+
+		if root, err = headersRootHash(headers); err != nil {
+			return nil, err
+		}
+	}
+
+	c.addImmutable(cacheKey, root)
+	return root, nil
 }
 
 // GetLastChildBlock fetch current child block
-func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
-	GetLastChildBlock, err := rootChainInstance.GetLastChildBlock(nil)
+func (c *ContractCaller) GetLastChildBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	GetLastChildBlock, err := rootChainInstance.GetLastChildBlock(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		Logger.Error("Could not fetch current child block from rootchain contract", "Error", err)
 		return 0, err
@@ -369,8 +424,11 @@ func (c *ContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchai
 }
 
 // CurrentHeaderBlock fetches current header block
-func (c *ContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
-	currentHeaderBlock, err := rootChainInstance.CurrentHeaderBlock(nil)
+func (c *ContractCaller) CurrentHeaderBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	currentHeaderBlock, err := rootChainInstance.CurrentHeaderBlock(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		Logger.Error("Could not fetch current header block from rootchain contract", "Error", err)
 		return 0, err
@@ -379,8 +437,11 @@ func (c *ContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootcha
 }
 
 // GetBalance get balance of account (returns big.Int balance wont fit in uint64)
-func (c *ContractCaller) GetBalance(address common.Address) (*big.Int, error) {
-	balance, err := c.MainChainClient.BalanceAt(context.Background(), address, nil)
+func (c *ContractCaller) GetBalance(ctx context.Context, address common.Address) (*big.Int, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	balance, err := c.MainChainClient.BalanceAt(ctx, address, nil)
 	if err != nil {
 		Logger.Error("Unable to fetch balance of account from root chain", "Error", err, "Address", address.String())
 		return big.NewInt(0), err
@@ -389,10 +450,32 @@ func (c *ContractCaller) GetBalance(address common.Address) (*big.Int, error) {
 	return balance, nil
 }
 
+// GetGasPrice returns suggested gas price of root chain, error for chains without eth gas model
+func (c *ContractCaller) GetGasPrice(ctx context.Context, rootChain string) (*big.Int, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	client := c.rootChainClient(rootChain)
+	if client == nil {
+		return nil, fmt.Errorf("gas price is not supported for root chain %s", rootChain)
+	}
+
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		Logger.Error("Unable to fetch gas price from root chain", "root", rootChain, "error", err)
+		return nil, err
+	}
+
+	return gasPrice, nil
+}
+
 // GetValidatorInfo get validator info
-func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error) {
+func (c *ContractCaller) GetValidatorInfo(ctx context.Context, valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// amount, startEpoch, endEpoch, signer, status, err := c.StakingInfoInstance.GetStakerDetails(nil, big.NewInt(int64(valID)))
-	stakerDetails, err := stakingInfoInstance.GetStakerDetails(nil, big.NewInt(int64(valID)))
+	stakerDetails, err := stakingInfoInstance.GetStakerDetails(&bind.CallOpts{Context: ctx}, big.NewInt(int64(valID)))
 	if err != nil {
 		Logger.Error("Error fetching validator information from stake manager", "error", err, "validatorId", valID, "status", stakerDetails.Status)
 		return
@@ -416,16 +499,15 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID, stakingInfoIn
 }
 
 // GetMainChainBlock returns main chain block header
-func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int, rootChain string) (header *ethTypes.Header, err error) {
-	var latestBlock *ethTypes.Header
-	switch rootChain {
-	case hmTypes.RootChainTypeEth:
-		latestBlock, err = c.MainChainClient.HeaderByNumber(context.Background(), blockNum)
-	case hmTypes.RootChainTypeBsc:
-		latestBlock, err = c.BscChainClient.HeaderByNumber(context.Background(), blockNum)
-	default:
+func (c *ContractCaller) GetMainChainBlock(ctx context.Context, blockNum *big.Int, rootChain string) (header *ethTypes.Header, err error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	client := c.rootChainClient(rootChain)
+	if client == nil {
 		return nil, errors.New("wrong chain type")
 	}
+	latestBlock, err := client.HeaderByNumber(ctx, blockNum)
 	if err != nil {
 		Logger.Error("Unable to connect to main chain", "Error", err)
 		return
@@ -434,8 +516,11 @@ func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int, rootChain string)
 }
 
 // GetMaticChainBlock returns child chain block header
-func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
-	latestBlock, err := c.MaticChainClient.HeaderByNumber(context.Background(), blockNum)
+func (c *ContractCaller) GetMaticChainBlock(ctx context.Context, blockNum *big.Int) (header *ethTypes.Header, err error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	latestBlock, err := c.MaticChainClient.HeaderByNumber(ctx, blockNum)
 	if err != nil {
 		Logger.Error("Unable to connect to matic chain", "Error", err)
 		return
@@ -443,10 +528,44 @@ func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes
 	return latestBlock, nil
 }
 
+// GetMaticChainHeaders returns child chain headers from start to end block, fetched in JSON-RPC batches
+func (c *ContractCaller) GetMaticChainHeaders(ctx context.Context, start uint64, end uint64) ([]*ethTypes.Header, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	headers := make([]*ethTypes.Header, end-start+1)
+	elements := make([]rpc.BatchElem, len(headers))
+	for i := range elements {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{fmt.Sprintf("0x%x", start+uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+
+	if err := batchCall(ctx, c.MaticChainRPC, elements, GetConfig().RPCBatchSize); err != nil {
+		return nil, err
+	}
+
+	for i, header := range headers {
+		if header == nil {
+			return nil, fmt.Errorf("block %d not found on matic chain", start+uint64(i))
+		}
+	}
+	return headers, nil
+}
+
 // GetBlockNumberFromTxHash gets block number of transaction
-func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, error) {
+func (c *ContractCaller) GetBlockNumberFromTxHash(ctx context.Context, tx common.Hash) (*big.Int, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	var rpcTx rpcTransaction
-	if err := c.MainChainRPC.CallContext(context.Background(), &rpcTx, "eth_getTransactionByHash", tx); err != nil {
+	if err := c.MainChainRPC.CallContext(ctx, &rpcTx, "eth_getTransactionByHash", tx); err != nil {
 		return nil, err
 	}
 
@@ -463,47 +582,49 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 }
 
 // GetConfirmedTxReceipt returns confirmed tx receipt
-func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64, rootChain string) (*ethTypes.Receipt, error) {
-
-	var receipt *ethTypes.Receipt = nil
-	receiptCache, ok := c.ReceiptCache.Get(tx.String())
-
-	if !ok {
-		var err error
-
-		// get main tx receipt
-		receipt, err = c.GetMainTxReceipt(tx, rootChain)
-		if err != nil {
-			Logger.Error("Error while fetching mainchain receipt", "error", err, "txHash", tx.Hex())
-			return nil, err
+func (c *ContractCaller) GetConfirmedTxReceipt(ctx context.Context, tx common.Hash, requiredConfirmations uint64, rootChain string) (*ethTypes.Receipt, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	// receipts are cached once confirmed, unconfirmed one may still be reorged into another block
+	cacheKey := immutableCacheKey{kind: cacheKindReceipt, rootChain: rootChain, id: tx.String()}
+	if cached, ok := c.getImmutable(cacheKey); ok {
+		receipt := cached.(*ethTypes.Receipt)
+		if receipt.BlockNumber.Uint64()+requiredConfirmations <= c.LatestBlockCache[rootChain] {
+			Logger.Debug("receipt block is confirmed by cache", "root", rootChain, "receiptBlock", receipt.BlockNumber.Uint64())
+			return receipt, nil
 		}
+	}
 
-		c.ReceiptCache.Add(tx.String(), receipt)
-	} else {
-		receipt, _ = receiptCache.(*ethTypes.Receipt)
+	// get main tx receipt
+	receipt, err := c.GetMainTxReceipt(ctx, tx, rootChain)
+	if err != nil {
+		Logger.Error("Error while fetching mainchain receipt", "error", err, "txHash", tx.Hex())
+		return nil, err
 	}
 
 	Logger.Debug("Tx included in block", "root", rootChain, "block", receipt.BlockNumber.Uint64(), "tx", tx)
 
 	latestBlkNumber := c.LatestBlockCache[rootChain]
-	if latestBlkNumber-receipt.BlockNumber.Uint64() >= requiredConfirmations {
+	if latestBlkNumber >= receipt.BlockNumber.Uint64()+requiredConfirmations {
 		Logger.Debug("receipt block is confirmed by cache",
 			"root", rootChain, "latestBlockCached", latestBlkNumber, "receiptBlock", receipt.BlockNumber.Uint64())
+		c.addImmutable(cacheKey, receipt)
 		return receipt, nil
 	}
 	// get main chain block
-	latestBlk, err := c.GetMainChainBlock(nil, rootChain)
+	latestBlk, err := c.GetMainChainBlock(ctx, nil, rootChain)
 	if err != nil {
 		Logger.Error("error getting latest block from main chain", "Error", err)
 		return nil, err
 	}
 	Logger.Debug("Latest block on main chain obtained", "root", rootChain, "Block", latestBlk.Number.Uint64())
 	c.LatestBlockCache[rootChain] = latestBlk.Number.Uint64()
-	diff := latestBlk.Number.Uint64() - receipt.BlockNumber.Uint64()
-	if diff < requiredConfirmations {
+	if latestBlk.Number.Uint64() < receipt.BlockNumber.Uint64()+requiredConfirmations {
 		return nil, errors.New("not enough confirmations")
 	}
 
+	c.addImmutable(cacheKey, receipt)
 	return receipt, nil
 }
 
@@ -716,8 +837,11 @@ func (c *ContractCaller) DecodeUnJailedEvent(contractAddress common.Address, rec
 //
 
 // CurrentAccountStateRoot get current account root from on chain
-func (c *ContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error) {
-	accountStateRoot, err := stakingInfoInstance.GetAccountStateRoot(nil)
+func (c *ContractCaller) CurrentAccountStateRoot(ctx context.Context, stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	accountStateRoot, err := stakingInfoInstance.GetAccountStateRoot(&bind.CallOpts{Context: ctx})
 
 	if err != nil {
 		Logger.Error("Unable to get current account state roor", "Error", err)
@@ -733,8 +857,11 @@ func (c *ContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakinginf
 //
 
 // CurrentSpanNumber get current span
-func (c *ContractCaller) CurrentSpanNumber(validatorSetInstance *validatorset.Validatorset) (Number *big.Int) {
-	result, err := validatorSetInstance.CurrentSpanNumber(nil)
+func (c *ContractCaller) CurrentSpanNumber(ctx context.Context, validatorSetInstance *validatorset.Validatorset) (Number *big.Int) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	result, err := validatorSetInstance.CurrentSpanNumber(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		Logger.Error("Unable to get current span number", "Error", err)
 		return nil
@@ -744,19 +871,25 @@ func (c *ContractCaller) CurrentSpanNumber(validatorSetInstance *validatorset.Va
 }
 
 // GetSpanDetails get span details
-func (c *ContractCaller) GetSpanDetails(id *big.Int, validatorSetInstance *validatorset.Validatorset) (
+func (c *ContractCaller) GetSpanDetails(ctx context.Context, id *big.Int, validatorSetInstance *validatorset.Validatorset) (
 	*big.Int,
 	*big.Int,
 	*big.Int,
 	error,
 ) {
-	d, err := validatorSetInstance.GetSpan(nil, id)
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	d, err := validatorSetInstance.GetSpan(&bind.CallOpts{Context: ctx}, id)
 	return d.Number, d.StartBlock, d.EndBlock, err
 }
 
 // CurrentStateCounter get state counter
-func (c *ContractCaller) CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int) {
-	result, err := stateSenderInstance.Counter(nil)
+func (c *ContractCaller) CurrentStateCounter(ctx context.Context, stateSenderInstance *statesender.Statesender) (Number *big.Int) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	result, err := stateSenderInstance.Counter(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		Logger.Error("Unable to get current counter number", "Error", err)
 		return nil
@@ -766,11 +899,14 @@ func (c *ContractCaller) CurrentStateCounter(stateSenderInstance *statesender.St
 }
 
 // CheckIfBlocksExist - check if the given block exists on local chain
-func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
+func (c *ContractCaller) CheckIfBlocksExist(ctx context.Context, end uint64) bool {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// Get block by number.
 	var block *ethTypes.Header
 
-	err := c.MaticChainRPC.Call(&block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", end), false)
+	err := c.MaticChainRPC.CallContext(ctx, &block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", end), false)
 	if err != nil {
 		return false
 	}
@@ -783,25 +919,59 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 //
 
 // GetMainTxReceipt returns main tx receipt
-func (c *ContractCaller) GetMainTxReceipt(txHash common.Hash, rootChain string) (*ethTypes.Receipt, error) {
-	switch rootChain {
-	case hmTypes.RootChainTypeEth:
-		return c.getTxReceipt(c.MainChainClient, txHash)
-	case hmTypes.RootChainTypeBsc:
-		return c.getTxReceipt(c.BscChainClient, txHash)
+func (c *ContractCaller) GetMainTxReceipt(ctx context.Context, txHash common.Hash, rootChain string) (*ethTypes.Receipt, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	client := c.rootChainClient(rootChain)
+	if client == nil {
+		return nil, errors.New("wrong chain type")
 	}
-	return nil, errors.New("wrong chain type")
+	return c.getTxReceipt(ctx, client, txHash)
 }
 
 // GetMaticTxReceipt returns matic tx receipt
-func (c *ContractCaller) GetMaticTxReceipt(txHash common.Hash) (*ethTypes.Receipt, error) {
-	return c.getTxReceipt(c.MaticChainClient, txHash)
+func (c *ContractCaller) GetMaticTxReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	return c.getTxReceipt(ctx, c.MaticChainClient, txHash)
 }
 
-func (c *ContractCaller) getTxReceipt(client *ethclient.Client, txHash common.Hash) (*ethTypes.Receipt, error) {
-	return client.TransactionReceipt(context.Background(), txHash)
+// GetTxReceipts returns receipts of txs on root chain, fetched in JSON-RPC batches. Receipt of tx which is
+// not mined yet is nil.
+func (c *ContractCaller) GetTxReceipts(ctx context.Context, txHashes []common.Hash, rootChain string) ([]*ethTypes.Receipt, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	rpcClient := c.rootChainRPC(rootChain)
+	if rpcClient == nil {
+		return nil, errors.New("wrong chain type")
+	}
+
+	receipts := make([]*ethTypes.Receipt, len(txHashes))
+	elements := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{txHash},
+			Result: &receipts[i],
+		}
+	}
+
+	if err := batchCall(ctx, rpcClient, elements, GetConfig().RPCBatchSize); err != nil {
+		return nil, err
+	}
+	return receipts, nil
 }
-func (c *ContractCaller) GetTronTransactionReceipt(txID string) (*ethTypes.Receipt, error) {
+
+func (c *ContractCaller) getTxReceipt(ctx context.Context, client *ethclient.Client, txHash common.Hash) (*ethTypes.Receipt, error) {
+	return client.TransactionReceipt(ctx, txHash)
+}
+func (c *ContractCaller) GetTronTransactionReceipt(ctx context.Context, txID string) (*ethTypes.Receipt, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// create filter
 	var txIDs = []string{txID}
 	queryFilter := tron.FilterOtherParams{
@@ -810,7 +980,7 @@ func (c *ContractCaller) GetTronTransactionReceipt(txID string) (*ethTypes.Recei
 		Params:         txIDs,
 	}
 	queryByte, err := json.Marshal(queryFilter)
-	req, err := http.NewRequest("POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
+	req, err := http.NewRequestWithContext(ctx, "POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
 	if err != nil {
 		return nil, err
 	}
@@ -834,9 +1004,12 @@ func getABI(data string) (abi.ABI, error) {
 }
 
 // GetCheckpointSign returns sigs input of committed checkpoint tranasction
-func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
+func (c *ContractCaller) GetCheckpointSign(ctx context.Context, txHash common.Hash) ([]byte, []byte, []byte, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	mainChainClient := GetMainClient()
-	transaction, isPending, err := mainChainClient.TransactionByHash(context.Background(), txHash)
+	transaction, isPending, err := mainChainClient.TransactionByHash(ctx, txHash)
 	if err != nil {
 		Logger.Error("Error while Fetching Transaction By hash from MainChain", "error", err)
 		return []byte{}, []byte{}, []byte{}, err
@@ -854,8 +1027,11 @@ func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte,
 //
 
 // GetMainStakingSyncNonce return validator nonce
-func (c *ContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64) {
-	validatorNonce, err := stakingManagerInstance.ValidatorNonce(nil, big.NewInt(int64(validatorID)))
+func (c *ContractCaller) GetMainStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) (nonce uint64) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
+	validatorNonce, err := stakingManagerInstance.ValidatorNonce(&bind.CallOpts{Context: ctx}, big.NewInt(int64(validatorID)))
 	if err != nil {
 		Logger.Error("Error fetching validator nonce from stake manager",
 			"error", err, "validatorId", validatorID)
@@ -865,7 +1041,10 @@ func (c *ContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingMana
 }
 
 // GetMainStakingSyncNonce return validator nonce
-func (c *ContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingManagerAddress string) (nonce uint64) {
+func (c *ContractCaller) GetTronStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerAddress string) (nonce uint64) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// Pack the input
 	data, err := c.StakeManagerABI.Pack("validatorNonce", big.NewInt(0).SetUint64(validatorID))
 	if err != nil {
@@ -873,7 +1052,7 @@ func (c *ContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingMana
 
 		return 0
 	}
-	result, err := c.TronChainRPC.TriggerConstantContract(stakingManagerAddress, data)
+	result, err := c.TronChainRPC.TriggerConstantContract(ctx, stakingManagerAddress, data)
 	if err != nil {
 		Logger.Error("Error fetching validator nonce from stake manager",
 			"error", err, "validatorId", validatorID)
@@ -891,7 +1070,10 @@ func (c *ContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingMana
 	return (*ret0).Uint64()
 }
 
-func (c *ContractCaller) GetTronEventsByContractAddress(address []string, from, to int64) ([]ethTypes.Log, error) {
+func (c *ContractCaller) GetTronEventsByContractAddress(ctx context.Context, address []string, from, to int64) ([]ethTypes.Log, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	var decodedAddress []string
 	for _, adr := range address {
 		decodedAddress = append(decodedAddress, adr[2:])
@@ -910,7 +1092,7 @@ func (c *ContractCaller) GetTronEventsByContractAddress(address []string, from,
 	}
 
 	queryByte, err := json.Marshal(queryFilter)
-	req, err := http.NewRequest("POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
+	req, err := http.NewRequestWithContext(ctx, "POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
 	if err != nil {
 		return nil, err
 	}
@@ -925,7 +1107,10 @@ func (c *ContractCaller) GetTronEventsByContractAddress(address []string, from,
 	return filterChangeResult.Result, nil
 }
 
-func (c *ContractCaller) GetTronLatestBlockNumber() (int64, error) {
+func (c *ContractCaller) GetTronLatestBlockNumber(ctx context.Context) (int64, error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	var empty []string
 	queryFilter := tron.FilterOtherParams{
 		BaseQueryParam: tron.GetDefaultBaseParm(),
@@ -934,7 +1119,7 @@ func (c *ContractCaller) GetTronLatestBlockNumber() (int64, error) {
 	}
 
 	queryByte, err := json.Marshal(queryFilter)
-	req, err := http.NewRequest("POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
+	req, err := http.NewRequestWithContext(ctx, "POST", GetTronGridEndpoint("/jsonrpc"), bytes.NewBuffer(queryByte))
 	if err != nil {
 		return 0, err
 	}
@@ -966,8 +1151,11 @@ func (c *ContractCaller) GetStartListenBlock(rootChainType string) uint64 {
 	}
 }
 
-func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress string, childBlockInterval uint64) (
+func (c *ContractCaller) GetTronHeaderInfo(ctx context.Context, headerID uint64, contractAddress string, childBlockInterval uint64) (
 	root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// Pack the input
 	btsPack, err := c.RootChainABI.Pack("headerBlocks",
 		big.NewInt(0).Mul(big.NewInt(0).SetUint64(headerID), big.NewInt(0).SetUint64(childBlockInterval)))
@@ -976,7 +1164,7 @@ func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress stri
 	}
 
 	// Call
-	data, err := c.TronChainRPC.TriggerConstantContract(contractAddress, btsPack)
+	data, err := c.TronChainRPC.TriggerConstantContract(ctx, contractAddress, btsPack)
 	if err != nil {
 		return root, 0, 0, 0, types.HeimdallAddress{}, err
 	}
@@ -997,7 +1185,10 @@ func (c *ContractCaller) GetTronHeaderInfo(headerID uint64, contractAddress stri
 		ret.CreatedAt.Uint64(), types.HeimdallAddress(ret.Proposer), nil
 }
 
-func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChain string) (currentHeader uint64, err error) {
+func (c *ContractCaller) GetSyncedCheckpointId(ctx context.Context, contractAddress string, rootChain string) (currentHeader uint64, err error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	// Pack the input
 	chainID := types.GetRootChainID(rootChain)
 	btsPack, err := c.StakeManagerABI.Pack("getCurrentSyncedCheckpoint", big.NewInt(int64(chainID)))
@@ -1006,7 +1197,7 @@ func (c *ContractCaller) GetSyncedCheckpointId(contractAddress string, rootChain
 	}
 
 	// Call
-	data, err := c.TronChainRPC.TriggerConstantContract(contractAddress, btsPack)
+	data, err := c.TronChainRPC.TriggerConstantContract(ctx, contractAddress, btsPack)
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"errors"
+	"hash"
+	"runtime"
+	"sync"
+)
+
+// merkleParallelThreshold is number of hashes in tree level below which level is hashed sequentially,
+// as handing out small levels to workers costs more than it saves
+const merkleParallelThreshold = 512
+
+// MerkleWorkers is number of workers hashing leaves and tree levels of large merkle trees
+var MerkleWorkers = runtime.NumCPU()
+
+// ParallelMerkleRoot returns root of binary merkle tree over leaf hashes, hashing concatenated children
+// of each level concurrently. Last node of level with odd number of nodes is paired with itself, single
+// leaf is root itself.
+func ParallelMerkleRoot(leaves [][]byte, newHash func() hash.Hash, workers int) ([]byte, error) {
+	levels, err := ParallelMerkleLevels(leaves, newHash, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	return levels[len(levels)-1][0], nil
+}
+
+// ParallelMerkleLevels returns levels of binary merkle tree over leaf hashes, from leaves up to root,
+// built the way ParallelMerkleRoot builds it
+func ParallelMerkleLevels(leaves [][]byte, newHash func() hash.Hash, workers int) ([][][]byte, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("cannot build merkle tree without leaves")
+	}
+
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; level = levels[len(levels)-1] {
+		parents := make([][]byte, (len(level)+1)/2)
+		err := ParallelFor(len(parents), workers, func(start, end int) error {
+			h := newHash()
+			for i := start; i < end; i++ {
+				left, right := level[2*i], level[2*i]
+				if 2*i+1 < len(level) {
+					right = level[2*i+1]
+				}
+
+				h.Reset()
+				h.Write(left)
+				h.Write(right)
+				parents[i] = h.Sum(nil)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		levels = append(levels, parents)
+	}
+
+	return levels, nil
+}
+
+// ParallelFor calls fn over consecutive ranges of [0, n) split between workers and returns first error
+// returned by fn. Small n is run in single range on calling goroutine.
+func ParallelFor(n int, workers int, fn func(start, end int) error) error {
+	if workers <= 0 {
+		workers = MerkleWorkers
+	}
+	if n < merkleParallelThreshold || workers == 1 {
+		return fn(0, n)
+	}
+
+	chunk := (n + workers - 1) / workers
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for start := 0; start < n; start += chunk {
+		end := start + chunk
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			if err := fn(start, end); err != nil {
+				errOnce.Do(func() { firstErr = err })
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return firstErr
+}
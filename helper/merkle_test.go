@@ -0,0 +1,104 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/crypto/sha3"
+)
+
+// sequentialMerkleRoot is reference root, pairing last node of odd level with itself
+func sequentialMerkleRoot(level [][]byte) []byte {
+	for len(level) > 1 {
+		var parents [][]byte
+		for i := 0; i < len(level); i += 2 {
+			right := level[i]
+			if i+1 < len(level) {
+				right = level[i+1]
+			}
+			parents = append(parents, crypto.Keccak256(level[i], right))
+		}
+		level = parents
+	}
+	return level[0]
+}
+
+func TestParallelMerkleRoot(t *testing.T) {
+	_, err := ParallelMerkleRoot(nil, sha3.NewLegacyKeccak256, 4)
+	require.Error(t, err)
+
+	for _, n := range []int{1, 2, 3, 7, 1024, 1500, 4099} {
+		leaves := make([][]byte, n)
+		for i := range leaves {
+			leaves[i] = crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+		}
+
+		expected := sequentialMerkleRoot(leaves)
+		for _, workers := range []int{1, 3, 8} {
+			root, err := ParallelMerkleRoot(leaves, sha3.NewLegacyKeccak256, workers)
+			require.NoError(t, err)
+			require.Equal(t, expected, root, fmt.Sprintf("%d leaves, %d workers", n, workers))
+		}
+	}
+}
+
+func TestParallelFor(t *testing.T) {
+	covered := make([]int, 5000)
+	require.NoError(t, ParallelFor(len(covered), 7, func(start, end int) error {
+		for i := start; i < end; i++ {
+			covered[i]++
+		}
+		return nil
+	}))
+	for i, count := range covered {
+		require.Equal(t, 1, count, "index %d", i)
+	}
+
+	failed := errors.New("failed")
+	require.Equal(t, failed, ParallelFor(len(covered), 7, func(start, end int) error {
+		if start > 0 {
+			return failed
+		}
+		return nil
+	}))
+}
+
+func BenchmarkHeadersRootHash(b *testing.B) {
+	// headers of 50k block checkpoint
+	headers := make([]*ethTypes.Header, 50000)
+	for i := range headers {
+		headers[i] = &ethTypes.Header{
+			Number:      big.NewInt(int64(i)),
+			Time:        uint64(i),
+			TxHash:      common.BigToHash(big.NewInt(int64(i))),
+			ReceiptHash: common.BigToHash(big.NewInt(int64(i + 1))),
+		}
+	}
+
+	b.Run("tree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := headersTree(headers); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	for _, workers := range []int{1, MerkleWorkers} {
+		b.Run(fmt.Sprintf("workers-%d", workers), func(b *testing.B) {
+			defer func(previous int) { MerkleWorkers = previous }(MerkleWorkers)
+			MerkleWorkers = workers
+
+			for i := 0; i < b.N; i++ {
+				if _, err := headersRootHash(headers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package helper
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	// rootChainGasEstimate tracks gas estimated for latest tx sent to root chain
+	rootChainGasEstimate = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bridge",
+		Subsystem: "rootchain_tx",
+		Name:      "gas_estimate",
+		Help:      "Gas estimated for latest tx sent to root chain, by root chain.",
+	}, []string{"root_chain"})
+
+	// rootChainGasLimitGauge tracks gas limit of latest tx sent to root chain
+	rootChainGasLimitGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bridge",
+		Subsystem: "rootchain_tx",
+		Name:      "gas_limit",
+		Help:      "Gas limit of latest tx sent to root chain, estimate with margin bounded by max gas limit, by root chain.",
+	}, []string{"root_chain"})
+)
+
+func init() {
+	prometheus.MustRegister(rootChainGasEstimate, rootChainGasLimitGauge)
+}
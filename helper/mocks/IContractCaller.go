@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
@@ -34,13 +36,13 @@ type IContractCaller struct {
 	mock.Mock
 }
 
-// ApproveTokens provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IContractCaller) ApproveTokens(_a0 *big.Int, _a1 common.Address, _a2 common.Address, _a3 *erc20.Erc20) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3)
+// ApproveTokens provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *IContractCaller) ApproveTokens(_a0 context.Context, _a1 *big.Int, _a2 common.Address, _a3 common.Address, _a4 *erc20.Erc20) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*big.Int, common.Address, common.Address, *erc20.Erc20) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, common.Address, common.Address, *erc20.Erc20) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,13 +50,13 @@ func (_m *IContractCaller) ApproveTokens(_a0 *big.Int, _a1 common.Address, _a2 c
 	return r0
 }
 
-// CheckIfBlocksExist provides a mock function with given fields: end
-func (_m *IContractCaller) CheckIfBlocksExist(end uint64) bool {
-	ret := _m.Called(end)
+// CheckIfBlocksExist provides a mock function with given fields: ctx, end
+func (_m *IContractCaller) CheckIfBlocksExist(ctx context.Context, end uint64) bool {
+	ret := _m.Called(ctx, end)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(uint64) bool); ok {
-		r0 = rf(end)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) bool); ok {
+		r0 = rf(ctx, end)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -62,13 +64,13 @@ func (_m *IContractCaller) CheckIfBlocksExist(end uint64) bool {
 	return r0
 }
 
-// CurrentAccountStateRoot provides a mock function with given fields: stakingInfoInstance
-func (_m *IContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error) {
-	ret := _m.Called(stakingInfoInstance)
+// CurrentAccountStateRoot provides a mock function with given fields: ctx, stakingInfoInstance
+func (_m *IContractCaller) CurrentAccountStateRoot(ctx context.Context, stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error) {
+	ret := _m.Called(ctx, stakingInfoInstance)
 
 	var r0 [32]byte
-	if rf, ok := ret.Get(0).(func(*stakinginfo.Stakinginfo) [32]byte); ok {
-		r0 = rf(stakingInfoInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, *stakinginfo.Stakinginfo) [32]byte); ok {
+		r0 = rf(ctx, stakingInfoInstance)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([32]byte)
@@ -76,8 +78,8 @@ func (_m *IContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakingi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*stakinginfo.Stakinginfo) error); ok {
-		r1 = rf(stakingInfoInstance)
+	if rf, ok := ret.Get(1).(func(context.Context, *stakinginfo.Stakinginfo) error); ok {
+		r1 = rf(ctx, stakingInfoInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -85,20 +87,20 @@ func (_m *IContractCaller) CurrentAccountStateRoot(stakingInfoInstance *stakingi
 	return r0, r1
 }
 
-// CurrentHeaderBlock provides a mock function with given fields: rootChainInstance, childBlockInterval
-func (_m *IContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
-	ret := _m.Called(rootChainInstance, childBlockInterval)
+// CurrentHeaderBlock provides a mock function with given fields: ctx, rootChainInstance, childBlockInterval
+func (_m *IContractCaller) CurrentHeaderBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error) {
+	ret := _m.Called(ctx, rootChainInstance, childBlockInterval)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(*rootchain.Rootchain, uint64) uint64); ok {
-		r0 = rf(rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(0).(func(context.Context, *rootchain.Rootchain, uint64) uint64); ok {
+		r0 = rf(ctx, rootChainInstance, childBlockInterval)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rootchain.Rootchain, uint64) error); ok {
-		r1 = rf(rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(1).(func(context.Context, *rootchain.Rootchain, uint64) error); ok {
+		r1 = rf(ctx, rootChainInstance, childBlockInterval)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,13 +108,13 @@ func (_m *IContractCaller) CurrentHeaderBlock(rootChainInstance *rootchain.Rootc
 	return r0, r1
 }
 
-// CurrentSpanNumber provides a mock function with given fields: _a0
-func (_m *IContractCaller) CurrentSpanNumber(_a0 *validatorset.Validatorset) *big.Int {
-	ret := _m.Called(_a0)
+// CurrentSpanNumber provides a mock function with given fields: ctx, _a1
+func (_m *IContractCaller) CurrentSpanNumber(ctx context.Context, _a1 *validatorset.Validatorset) *big.Int {
+	ret := _m.Called(ctx, _a1)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(*validatorset.Validatorset) *big.Int); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *validatorset.Validatorset) *big.Int); ok {
+		r0 = rf(ctx, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -122,13 +124,13 @@ func (_m *IContractCaller) CurrentSpanNumber(_a0 *validatorset.Validatorset) *bi
 	return r0
 }
 
-// CurrentStateCounter provides a mock function with given fields: stateSenderInstance
-func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.Statesender) *big.Int {
-	ret := _m.Called(stateSenderInstance)
+// CurrentStateCounter provides a mock function with given fields: ctx, stateSenderInstance
+func (_m *IContractCaller) CurrentStateCounter(ctx context.Context, stateSenderInstance *statesender.Statesender) *big.Int {
+	ret := _m.Called(ctx, stateSenderInstance)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(*statesender.Statesender) *big.Int); ok {
-		r0 = rf(stateSenderInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, *statesender.Statesender) *big.Int); ok {
+		r0 = rf(ctx, stateSenderInstance)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -368,13 +370,13 @@ func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 common.Address, _a1
 	return r0, r1
 }
 
-// GetBalance provides a mock function with given fields: address
-func (_m *IContractCaller) GetBalance(address common.Address) (*big.Int, error) {
-	ret := _m.Called(address)
+// GetBalance provides a mock function with given fields: ctx, address
+func (_m *IContractCaller) GetBalance(ctx context.Context, address common.Address) (*big.Int, error) {
+	ret := _m.Called(ctx, address)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(common.Address) *big.Int); ok {
-		r0 = rf(address)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address) *big.Int); ok {
+		r0 = rf(ctx, address)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -382,8 +384,8 @@ func (_m *IContractCaller) GetBalance(address common.Address) (*big.Int, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Address) error); ok {
-		r1 = rf(address)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address) error); ok {
+		r1 = rf(ctx, address)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -391,13 +393,13 @@ func (_m *IContractCaller) GetBalance(address common.Address) (*big.Int, error)
 	return r0, r1
 }
 
-// GetBlockNumberFromTxHash provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int, error) {
-	ret := _m.Called(_a0)
+// GetBlockNumberFromTxHash provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 context.Context, _a1 common.Hash) (*big.Int, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(common.Hash) *big.Int); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) *big.Int); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -405,8 +407,8 @@ func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -414,13 +416,13 @@ func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int,
 	return r0, r1
 }
 
-// GetCheckpointSign provides a mock function with given fields: txHash
-func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
-	ret := _m.Called(txHash)
+// GetCheckpointSign provides a mock function with given fields: ctx, txHash
+func (_m *IContractCaller) GetCheckpointSign(ctx context.Context, txHash common.Hash) ([]byte, []byte, []byte, error) {
+	ret := _m.Called(ctx, txHash)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(common.Hash) []byte); ok {
-		r0 = rf(txHash)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) []byte); ok {
+		r0 = rf(ctx, txHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -428,8 +430,8 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	}
 
 	var r1 []byte
-	if rf, ok := ret.Get(1).(func(common.Hash) []byte); ok {
-		r1 = rf(txHash)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) []byte); ok {
+		r1 = rf(ctx, txHash)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]byte)
@@ -437,8 +439,8 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	}
 
 	var r2 []byte
-	if rf, ok := ret.Get(2).(func(common.Hash) []byte); ok {
-		r2 = rf(txHash)
+	if rf, ok := ret.Get(2).(func(context.Context, common.Hash) []byte); ok {
+		r2 = rf(ctx, txHash)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).([]byte)
@@ -446,8 +448,8 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	}
 
 	var r3 error
-	if rf, ok := ret.Get(3).(func(common.Hash) error); ok {
-		r3 = rf(txHash)
+	if rf, ok := ret.Get(3).(func(context.Context, common.Hash) error); ok {
+		r3 = rf(ctx, txHash)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -455,13 +457,13 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
-// GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64, _a2 string) (*types.Receipt, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 context.Context, _a1 common.Hash, _a2 uint64, _a3 string) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash, uint64, string) *types.Receipt); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64, string) *types.Receipt); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -469,8 +471,8 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64, _a
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash, uint64, string) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, uint64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -478,13 +480,36 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64, _a
 	return r0, r1
 }
 
-// GetHeaderInfo provides a mock function with given fields: headerID, rootChainInstance, childBlockInterval
-func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
-	ret := _m.Called(headerID, rootChainInstance, childBlockInterval)
+// GetGasPrice provides a mock function with given fields: ctx, rootChain
+func (_m *IContractCaller) GetGasPrice(ctx context.Context, rootChain string) (*big.Int, error) {
+	ret := _m.Called(ctx, rootChain)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(context.Context, string) *big.Int); ok {
+		r0 = rf(ctx, rootChain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, rootChain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHeaderInfo provides a mock function with given fields: ctx, headerID, rootChainInstance, childBlockInterval
+func (_m *IContractCaller) GetHeaderInfo(ctx context.Context, headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(ctx, headerID, rootChainInstance, childBlockInterval)
 
 	var r0 common.Hash
-	if rf, ok := ret.Get(0).(func(uint64, *rootchain.Rootchain, uint64) common.Hash); ok {
-		r0 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *rootchain.Rootchain, uint64) common.Hash); ok {
+		r0 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Hash)
@@ -492,29 +517,29 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r1 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *rootchain.Rootchain, uint64) uint64); ok {
+		r1 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 uint64
-	if rf, ok := ret.Get(2).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r2 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, *rootchain.Rootchain, uint64) uint64); ok {
+		r2 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
 	var r3 uint64
-	if rf, ok := ret.Get(3).(func(uint64, *rootchain.Rootchain, uint64) uint64); ok {
-		r3 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(3).(func(context.Context, uint64, *rootchain.Rootchain, uint64) uint64); ok {
+		r3 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		r3 = ret.Get(3).(uint64)
 	}
 
 	var r4 heimdalltypes.HeimdallAddress
-	if rf, ok := ret.Get(4).(func(uint64, *rootchain.Rootchain, uint64) heimdalltypes.HeimdallAddress); ok {
-		r4 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(4).(func(context.Context, uint64, *rootchain.Rootchain, uint64) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		if ret.Get(4) != nil {
 			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
@@ -522,8 +547,8 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	}
 
 	var r5 error
-	if rf, ok := ret.Get(5).(func(uint64, *rootchain.Rootchain, uint64) error); ok {
-		r5 = rf(headerID, rootChainInstance, childBlockInterval)
+	if rf, ok := ret.Get(5).(func(context.Context, uint64, *rootchain.Rootchain, uint64) error); ok {
+		r5 = rf(ctx, headerID, rootChainInstance, childBlockInterval)
 	} else {
 		r5 = ret.Error(5)
 	}
@@ -531,20 +556,20 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64, rootChainInstance *roo
 	return r0, r1, r2, r3, r4, r5
 }
 
-// GetLastChildBlock provides a mock function with given fields: rootChainInstance
-func (_m *IContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error) {
-	ret := _m.Called(rootChainInstance)
+// GetLastChildBlock provides a mock function with given fields: ctx, rootChainInstance
+func (_m *IContractCaller) GetLastChildBlock(ctx context.Context, rootChainInstance *rootchain.Rootchain) (uint64, error) {
+	ret := _m.Called(ctx, rootChainInstance)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(*rootchain.Rootchain) uint64); ok {
-		r0 = rf(rootChainInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, *rootchain.Rootchain) uint64); ok {
+		r0 = rf(ctx, rootChainInstance)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rootchain.Rootchain) error); ok {
-		r1 = rf(rootChainInstance)
+	if rf, ok := ret.Get(1).(func(context.Context, *rootchain.Rootchain) error); ok {
+		r1 = rf(ctx, rootChainInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -552,13 +577,13 @@ func (_m *IContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootch
 	return r0, r1
 }
 
-// GetMainChainBlock provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) GetMainChainBlock(_a0 *big.Int, _a1 string) (*types.Header, error) {
-	ret := _m.Called(_a0, _a1)
+// GetMainChainBlock provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) GetMainChainBlock(_a0 context.Context, _a1 *big.Int, _a2 string) (*types.Header, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *types.Header
-	if rf, ok := ret.Get(0).(func(*big.Int, string) *types.Header); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, string) *types.Header); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Header)
@@ -566,8 +591,8 @@ func (_m *IContractCaller) GetMainChainBlock(_a0 *big.Int, _a1 string) (*types.H
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*big.Int, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -575,13 +600,13 @@ func (_m *IContractCaller) GetMainChainBlock(_a0 *big.Int, _a1 string) (*types.H
 	return r0, r1
 }
 
-// GetMainStakingSyncNonce provides a mock function with given fields: validatorID, stakingManagerInstance
-func (_m *IContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) uint64 {
-	ret := _m.Called(validatorID, stakingManagerInstance)
+// GetMainStakingSyncNonce provides a mock function with given fields: ctx, validatorID, stakingManagerInstance
+func (_m *IContractCaller) GetMainStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerInstance *stakemanager.Stakemanager) uint64 {
+	ret := _m.Called(ctx, validatorID, stakingManagerInstance)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(uint64, *stakemanager.Stakemanager) uint64); ok {
-		r0 = rf(validatorID, stakingManagerInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *stakemanager.Stakemanager) uint64); ok {
+		r0 = rf(ctx, validatorID, stakingManagerInstance)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
@@ -589,13 +614,13 @@ func (_m *IContractCaller) GetMainStakingSyncNonce(validatorID uint64, stakingMa
 	return r0
 }
 
-// GetMainTxReceipt provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) GetMainTxReceipt(_a0 common.Hash, _a1 string) (*types.Receipt, error) {
-	ret := _m.Called(_a0, _a1)
+// GetMainTxReceipt provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) GetMainTxReceipt(_a0 context.Context, _a1 common.Hash, _a2 string) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash, string) *types.Receipt); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, string) *types.Receipt); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -603,8 +628,8 @@ func (_m *IContractCaller) GetMainTxReceipt(_a0 common.Hash, _a1 string) (*types
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash, string) error); ok {
-		r1 = rf(_a0, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -612,13 +637,13 @@ func (_m *IContractCaller) GetMainTxReceipt(_a0 common.Hash, _a1 string) (*types
 	return r0, r1
 }
 
-// GetMaticChainBlock provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, error) {
-	ret := _m.Called(_a0)
+// GetMaticChainBlock provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetMaticChainBlock(_a0 context.Context, _a1 *big.Int) (*types.Header, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *types.Header
-	if rf, ok := ret.Get(0).(func(*big.Int) *types.Header); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) *types.Header); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Header)
@@ -626,8 +651,31 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*big.Int) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMaticChainHeaders provides a mock function with given fields: ctx, start, end
+func (_m *IContractCaller) GetMaticChainHeaders(ctx context.Context, start uint64, end uint64) ([]*types.Header, error) {
+	ret := _m.Called(ctx, start, end)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*types.Header); ok {
+		r0 = rf(ctx, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, start, end)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -658,13 +706,13 @@ func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Addres
 	return r0, r1
 }
 
-// GetMaticTxReceipt provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetMaticTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
-	ret := _m.Called(_a0)
+// GetMaticTxReceipt provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetMaticTxReceipt(_a0 context.Context, _a1 common.Hash) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash) *types.Receipt); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash) *types.Receipt); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -672,8 +720,8 @@ func (_m *IContractCaller) GetMaticTxReceipt(_a0 common.Hash) (*types.Receipt, e
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -704,13 +752,13 @@ func (_m *IContractCaller) GetRootChainInstance(rootchainAddress common.Address,
 	return r0, r1
 }
 
-// GetRootHash provides a mock function with given fields: start, end, checkpointLength
-func (_m *IContractCaller) GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
-	ret := _m.Called(start, end, checkpointLength)
+// GetRootHash provides a mock function with given fields: ctx, start, end, checkpointLength
+func (_m *IContractCaller) GetRootHash(ctx context.Context, start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
+	ret := _m.Called(ctx, start, end, checkpointLength)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) []byte); ok {
-		r0 = rf(start, end, checkpointLength)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64) []byte); ok {
+		r0 = rf(ctx, start, end, checkpointLength)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -718,8 +766,8 @@ func (_m *IContractCaller) GetRootHash(start uint64, end uint64, checkpointLengt
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uint64, uint64, uint64) error); ok {
-		r1 = rf(start, end, checkpointLength)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, uint64) error); ok {
+		r1 = rf(ctx, start, end, checkpointLength)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -750,13 +798,13 @@ func (_m *IContractCaller) GetSlashManagerInstance(slashManagerAddress common.Ad
 	return r0, r1
 }
 
-// GetSpanDetails provides a mock function with given fields: id, _a1
-func (_m *IContractCaller) GetSpanDetails(id *big.Int, _a1 *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error) {
-	ret := _m.Called(id, _a1)
+// GetSpanDetails provides a mock function with given fields: ctx, id, _a2
+func (_m *IContractCaller) GetSpanDetails(ctx context.Context, id *big.Int, _a2 *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error) {
+	ret := _m.Called(ctx, id, _a2)
 
 	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
-		r0 = rf(id, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r0 = rf(ctx, id, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*big.Int)
@@ -764,8 +812,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int, _a1 *validatorset.Validat
 	}
 
 	var r1 *big.Int
-	if rf, ok := ret.Get(1).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
-		r1 = rf(id, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r1 = rf(ctx, id, _a2)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*big.Int)
@@ -773,8 +821,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int, _a1 *validatorset.Validat
 	}
 
 	var r2 *big.Int
-	if rf, ok := ret.Get(2).(func(*big.Int, *validatorset.Validatorset) *big.Int); ok {
-		r2 = rf(id, _a1)
+	if rf, ok := ret.Get(2).(func(context.Context, *big.Int, *validatorset.Validatorset) *big.Int); ok {
+		r2 = rf(ctx, id, _a2)
 	} else {
 		if ret.Get(2) != nil {
 			r2 = ret.Get(2).(*big.Int)
@@ -782,8 +830,8 @@ func (_m *IContractCaller) GetSpanDetails(id *big.Int, _a1 *validatorset.Validat
 	}
 
 	var r3 error
-	if rf, ok := ret.Get(3).(func(*big.Int, *validatorset.Validatorset) error); ok {
-		r3 = rf(id, _a1)
+	if rf, ok := ret.Get(3).(func(context.Context, *big.Int, *validatorset.Validatorset) error); ok {
+		r3 = rf(ctx, id, _a2)
 	} else {
 		r3 = ret.Error(3)
 	}
@@ -897,20 +945,20 @@ func (_m *IContractCaller) GetStateSenderInstance(stateSenderAddress common.Addr
 	return r0, r1
 }
 
-// GetSyncedCheckpointId provides a mock function with given fields: rootChain, contractAddress
-func (_m *IContractCaller) GetSyncedCheckpointId(rootChain string, contractAddress string) (uint64, error) {
-	ret := _m.Called(rootChain, contractAddress)
+// GetSyncedCheckpointId provides a mock function with given fields: ctx, rootChain, contractAddress
+func (_m *IContractCaller) GetSyncedCheckpointId(ctx context.Context, rootChain string, contractAddress string) (uint64, error) {
+	ret := _m.Called(ctx, rootChain, contractAddress)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(string, string) uint64); ok {
-		r0 = rf(rootChain, contractAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) uint64); ok {
+		r0 = rf(ctx, rootChain, contractAddress)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(rootChain, contractAddress)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, rootChain, contractAddress)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -918,13 +966,13 @@ func (_m *IContractCaller) GetSyncedCheckpointId(rootChain string, contractAddre
 	return r0, r1
 }
 
-// GetTronEventsByContractAddress provides a mock function with given fields: address, from, to
-func (_m *IContractCaller) GetTronEventsByContractAddress(address []string, from int64, to int64) ([]types.Log, error) {
-	ret := _m.Called(address, from, to)
+// GetTronEventsByContractAddress provides a mock function with given fields: ctx, address, from, to
+func (_m *IContractCaller) GetTronEventsByContractAddress(ctx context.Context, address []string, from int64, to int64) ([]types.Log, error) {
+	ret := _m.Called(ctx, address, from, to)
 
 	var r0 []types.Log
-	if rf, ok := ret.Get(0).(func([]string, int64, int64) []types.Log); ok {
-		r0 = rf(address, from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int64, int64) []types.Log); ok {
+		r0 = rf(ctx, address, from, to)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]types.Log)
@@ -932,8 +980,8 @@ func (_m *IContractCaller) GetTronEventsByContractAddress(address []string, from
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]string, int64, int64) error); ok {
-		r1 = rf(address, from, to)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int64, int64) error); ok {
+		r1 = rf(ctx, address, from, to)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -941,13 +989,13 @@ func (_m *IContractCaller) GetTronEventsByContractAddress(address []string, from
 	return r0, r1
 }
 
-// GetTronHeaderInfo provides a mock function with given fields: headerID, rootChainAddress, childBlockInterval
-func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress string, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
-	ret := _m.Called(headerID, rootChainAddress, childBlockInterval)
+// GetTronHeaderInfo provides a mock function with given fields: ctx, headerID, rootChainAddress, childBlockInterval
+func (_m *IContractCaller) GetTronHeaderInfo(ctx context.Context, headerID uint64, rootChainAddress string, childBlockInterval uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(ctx, headerID, rootChainAddress, childBlockInterval)
 
 	var r0 common.Hash
-	if rf, ok := ret.Get(0).(func(uint64, string, uint64) common.Hash); ok {
-		r0 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64) common.Hash); ok {
+		r0 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Hash)
@@ -955,29 +1003,29 @@ func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress s
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64, string, uint64) uint64); ok {
-		r1 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64) uint64); ok {
+		r1 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 uint64
-	if rf, ok := ret.Get(2).(func(uint64, string, uint64) uint64); ok {
-		r2 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, string, uint64) uint64); ok {
+		r2 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
 	var r3 uint64
-	if rf, ok := ret.Get(3).(func(uint64, string, uint64) uint64); ok {
-		r3 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(3).(func(context.Context, uint64, string, uint64) uint64); ok {
+		r3 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r3 = ret.Get(3).(uint64)
 	}
 
 	var r4 heimdalltypes.HeimdallAddress
-	if rf, ok := ret.Get(4).(func(uint64, string, uint64) heimdalltypes.HeimdallAddress); ok {
-		r4 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(4).(func(context.Context, uint64, string, uint64) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		if ret.Get(4) != nil {
 			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
@@ -985,8 +1033,8 @@ func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress s
 	}
 
 	var r5 error
-	if rf, ok := ret.Get(5).(func(uint64, string, uint64) error); ok {
-		r5 = rf(headerID, rootChainAddress, childBlockInterval)
+	if rf, ok := ret.Get(5).(func(context.Context, uint64, string, uint64) error); ok {
+		r5 = rf(ctx, headerID, rootChainAddress, childBlockInterval)
 	} else {
 		r5 = ret.Error(5)
 	}
@@ -994,20 +1042,20 @@ func (_m *IContractCaller) GetTronHeaderInfo(headerID uint64, rootChainAddress s
 	return r0, r1, r2, r3, r4, r5
 }
 
-// GetTronLatestBlockNumber provides a mock function with given fields:
-func (_m *IContractCaller) GetTronLatestBlockNumber() (int64, error) {
-	ret := _m.Called()
+// GetTronLatestBlockNumber provides a mock function with given fields: ctx
+func (_m *IContractCaller) GetTronLatestBlockNumber(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func() int64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1015,13 +1063,13 @@ func (_m *IContractCaller) GetTronLatestBlockNumber() (int64, error) {
 	return r0, r1
 }
 
-// GetTronStakingSyncNonce provides a mock function with given fields: validatorID, stakingManagerAddress
-func (_m *IContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingManagerAddress string) uint64 {
-	ret := _m.Called(validatorID, stakingManagerAddress)
+// GetTronStakingSyncNonce provides a mock function with given fields: ctx, validatorID, stakingManagerAddress
+func (_m *IContractCaller) GetTronStakingSyncNonce(ctx context.Context, validatorID uint64, stakingManagerAddress string) uint64 {
+	ret := _m.Called(ctx, validatorID, stakingManagerAddress)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(uint64, string) uint64); ok {
-		r0 = rf(validatorID, stakingManagerAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string) uint64); ok {
+		r0 = rf(ctx, validatorID, stakingManagerAddress)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
@@ -1029,13 +1077,13 @@ func (_m *IContractCaller) GetTronStakingSyncNonce(validatorID uint64, stakingMa
 	return r0
 }
 
-// GetTronTransactionReceipt provides a mock function with given fields: txID
-func (_m *IContractCaller) GetTronTransactionReceipt(txID string) (*types.Receipt, error) {
-	ret := _m.Called(txID)
+// GetTronTransactionReceipt provides a mock function with given fields: ctx, txID
+func (_m *IContractCaller) GetTronTransactionReceipt(ctx context.Context, txID string) (*types.Receipt, error) {
+	ret := _m.Called(ctx, txID)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(string) *types.Receipt); ok {
-		r0 = rf(txID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Receipt); ok {
+		r0 = rf(ctx, txID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -1043,8 +1091,31 @@ func (_m *IContractCaller) GetTronTransactionReceipt(txID string) (*types.Receip
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(txID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, txID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTxReceipts provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) GetTxReceipts(_a0 context.Context, _a1 []common.Hash, _a2 string) ([]*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []*types.Receipt
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Hash, string) []*types.Receipt); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Hash, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1052,20 +1123,20 @@ func (_m *IContractCaller) GetTronTransactionReceipt(txID string) (*types.Receip
 	return r0, r1
 }
 
-// GetValidatorInfo provides a mock function with given fields: valID, stakingInfoInstance
-func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (heimdalltypes.Validator, error) {
-	ret := _m.Called(valID, stakingInfoInstance)
+// GetValidatorInfo provides a mock function with given fields: ctx, valID, stakingInfoInstance
+func (_m *IContractCaller) GetValidatorInfo(ctx context.Context, valID heimdalltypes.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (heimdalltypes.Validator, error) {
+	ret := _m.Called(ctx, valID, stakingInfoInstance)
 
 	var r0 heimdalltypes.Validator
-	if rf, ok := ret.Get(0).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) heimdalltypes.Validator); ok {
-		r0 = rf(valID, stakingInfoInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) heimdalltypes.Validator); ok {
+		r0 = rf(ctx, valID, stakingInfoInstance)
 	} else {
 		r0 = ret.Get(0).(heimdalltypes.Validator)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) error); ok {
-		r1 = rf(valID, stakingInfoInstance)
+	if rf, ok := ret.Get(1).(func(context.Context, heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) error); ok {
+		r1 = rf(ctx, valID, stakingInfoInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1096,13 +1167,13 @@ func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress common.Ad
 	return r0, r1
 }
 
-// SendCheckpoint provides a mock function with given fields: sigedData, sigs, rootchainAddress, rootChainInstance, rootChain
-func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) error {
-	ret := _m.Called(sigedData, sigs, rootchainAddress, rootChainInstance, rootChain)
+// SendCheckpoint provides a mock function with given fields: ctx, sigedData, sigs, rootchainAddress, rootChainInstance, rootChain
+func (_m *IContractCaller) SendCheckpoint(ctx context.Context, sigedData []byte, sigs [][3]*big.Int, rootchainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) error {
+	ret := _m.Called(ctx, sigedData, sigs, rootchainAddress, rootChainInstance, rootChain)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, common.Address, *rootchain.Rootchain, string) error); ok {
-		r0 = rf(sigedData, sigs, rootchainAddress, rootChainInstance, rootChain)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][3]*big.Int, common.Address, *rootchain.Rootchain, string) error); ok {
+		r0 = rf(ctx, sigedData, sigs, rootchainAddress, rootChainInstance, rootChain)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1110,13 +1181,13 @@ func (_m *IContractCaller) SendCheckpoint(sigedData []byte, sigs [][3]*big.Int,
 	return r0
 }
 
-// SendMainStakingSync provides a mock function with given fields: stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain
-func (_m *IContractCaller) SendMainStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) error {
-	ret := _m.Called(stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
+// SendMainStakingSync provides a mock function with given fields: ctx, stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain
+func (_m *IContractCaller) SendMainStakingSync(ctx context.Context, stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) error {
+	ret := _m.Called(ctx, stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, []byte, [][3]*big.Int, common.Address, *stakemanager.Stakemanager, string) error); ok {
-		r0 = rf(stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, [][3]*big.Int, common.Address, *stakemanager.Stakemanager, string) error); ok {
+		r0 = rf(ctx, stakingType, sigedData, sigs, stakingManagerAddress, stakingManagerInstance, rootChain)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1124,13 +1195,13 @@ func (_m *IContractCaller) SendMainStakingSync(stakingType string, sigedData []b
 	return r0
 }
 
-// SendTick provides a mock function with given fields: sigedData, sigs, slashManagerAddress, slashManagerInstance
-func (_m *IContractCaller) SendTick(sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) error {
-	ret := _m.Called(sigedData, sigs, slashManagerAddress, slashManagerInstance)
+// SendTick provides a mock function with given fields: ctx, sigedData, sigs, slashManagerAddress, slashManagerInstance
+func (_m *IContractCaller) SendTick(ctx context.Context, sigedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) error {
+	ret := _m.Called(ctx, sigedData, sigs, slashManagerAddress, slashManagerInstance)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, []byte, common.Address, *slashmanager.Slashmanager) error); ok {
-		r0 = rf(sigedData, sigs, slashManagerAddress, slashManagerInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, []byte, common.Address, *slashmanager.Slashmanager) error); ok {
+		r0 = rf(ctx, sigedData, sigs, slashManagerAddress, slashManagerInstance)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1138,13 +1209,13 @@ func (_m *IContractCaller) SendTick(sigedData []byte, sigs []byte, slashManagerA
 	return r0
 }
 
-// SendTronCheckpoint provides a mock function with given fields: signedData, sigs, rootChainAddress
-func (_m *IContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error {
-	ret := _m.Called(signedData, sigs, rootChainAddress)
+// SendTronCheckpoint provides a mock function with given fields: ctx, signedData, sigs, rootChainAddress
+func (_m *IContractCaller) SendTronCheckpoint(ctx context.Context, signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error {
+	ret := _m.Called(ctx, signedData, sigs, rootChainAddress)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]byte, [][3]*big.Int, string) error); ok {
-		r0 = rf(signedData, sigs, rootChainAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][3]*big.Int, string) error); ok {
+		r0 = rf(ctx, signedData, sigs, rootChainAddress)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1152,13 +1223,13 @@ func (_m *IContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.
 	return r0
 }
 
-// SendTronStakingSync provides a mock function with given fields: stakingType, sigedData, sigs, stakingManagerAddress
-func (_m *IContractCaller) SendTronStakingSync(stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) error {
-	ret := _m.Called(stakingType, sigedData, sigs, stakingManagerAddress)
+// SendTronStakingSync provides a mock function with given fields: ctx, stakingType, sigedData, sigs, stakingManagerAddress
+func (_m *IContractCaller) SendTronStakingSync(ctx context.Context, stakingType string, sigedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) error {
+	ret := _m.Called(ctx, stakingType, sigedData, sigs, stakingManagerAddress)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, []byte, [][3]*big.Int, string) error); ok {
-		r0 = rf(stakingType, sigedData, sigs, stakingManagerAddress)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, [][3]*big.Int, string) error); ok {
+		r0 = rf(ctx, stakingType, sigedData, sigs, stakingManagerAddress)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1166,13 +1237,13 @@ func (_m *IContractCaller) SendTronStakingSync(stakingType string, sigedData []b
 	return r0
 }
 
-// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
-func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool, _a4 common.Address, _a5 *stakemanager.Stakemanager) error {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5, _a6
+func (_m *IContractCaller) StakeFor(_a0 context.Context, _a1 common.Address, _a2 *big.Int, _a3 *big.Int, _a4 bool, _a5 common.Address, _a6 *stakemanager.Stakemanager) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,189 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// PendingTx is root chain transaction sent by bridge which is not known to be mined yet
+type PendingTx struct {
+	RootChain string
+	From      common.Address
+	Tx        *ethTypes.Transaction
+	SentAt    time.Time
+}
+
+// NonceAccount is account sending transactions to root chain
+type NonceAccount struct {
+	RootChain string
+	From      common.Address
+}
+
+// NonceManager hands out nonces of root chain accounts and tracks transactions sent with them
+// until they are mined, so a stuck transaction can be replaced instead of blocking the account
+type NonceManager struct {
+	mu      sync.Mutex
+	pending map[NonceAccount]map[uint64]*PendingTx
+}
+
+// rootChainNonces tracks transactions bridge sends to root chains
+var rootChainNonces = NewNonceManager()
+
+// NewNonceManager creates nonce manager without pending transactions
+func NewNonceManager() *NonceManager {
+	return &NonceManager{
+		pending: make(map[NonceAccount]map[uint64]*PendingTx),
+	}
+}
+
+// GetRootChainNonceManager returns nonce manager of transactions sent to root chains
+func GetRootChainNonceManager() *NonceManager {
+	return rootChainNonces
+}
+
+// NextNonce returns nonce of next transaction of account, following its pending transactions.
+// Pending transactions below chain nonce are mined and dropped.
+func (m *NonceManager) NextNonce(account NonceAccount, chainNonce uint64) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune(account, chainNonce)
+
+	nonce := chainNonce
+	for pendingNonce := range m.pending[account] {
+		if pendingNonce >= nonce {
+			nonce = pendingNonce + 1
+		}
+	}
+	return nonce
+}
+
+// Track records transaction sent from account, replacing one sent before with the same nonce
+func (m *NonceManager) Track(account NonceAccount, tx *ethTypes.Transaction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.pending[account] == nil {
+		m.pending[account] = make(map[uint64]*PendingTx)
+	}
+	m.pending[account][tx.Nonce()] = &PendingTx{
+		RootChain: account.RootChain,
+		From:      account.From,
+		Tx:        tx,
+		SentAt:    time.Now(),
+	}
+}
+
+// Accounts returns accounts with pending transactions
+func (m *NonceManager) Accounts() []NonceAccount {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	accounts := make([]NonceAccount, 0, len(m.pending))
+	for account := range m.pending {
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// Stuck drops mined transactions of account and returns pending ones sent before timeout elapsed, by nonce
+func (m *NonceManager) Stuck(account NonceAccount, chainNonce uint64, timeout time.Duration) []*PendingTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune(account, chainNonce)
+
+	stuck := make([]*PendingTx, 0)
+	for _, pendingTx := range m.pending[account] {
+		if time.Since(pendingTx.SentAt) >= timeout {
+			stuck = append(stuck, pendingTx)
+		}
+	}
+
+	sort.Slice(stuck, func(i, j int) bool {
+		return stuck[i].Tx.Nonce() < stuck[j].Tx.Nonce()
+	})
+	return stuck
+}
+
+// prune drops transactions of account with nonce below chain nonce, caller holds lock
+func (m *NonceManager) prune(account NonceAccount, chainNonce uint64) {
+	for nonce := range m.pending[account] {
+		if nonce < chainNonce {
+			delete(m.pending[account], nonce)
+		}
+	}
+	if len(m.pending[account]) == 0 {
+		delete(m.pending, account)
+	}
+}
+
+// ReplaceTransaction resends transaction with the same nonce and fees bumped by percent, signed with
+// validator key. Replacement fees are bounded by max gas price, so operator cap is never exceeded.
+func ReplaceTransaction(ctx context.Context, client *ethclient.Client, tx *ethTypes.Transaction, bumpPercent int64) (*ethTypes.Transaction, error) {
+	maxGasPrice := GetConfig().MainchainMaxGasPrice
+	if maxGasPrice <= 0 {
+		maxGasPrice = DefaultMainchainMaxGasPrice
+	}
+
+	var txData ethTypes.TxData
+	switch tx.Type() {
+	case ethTypes.DynamicFeeTxType:
+		gasFeeCap := bumpFee(tx.GasFeeCap(), bumpPercent)
+		if gasFeeCap.Cmp(big.NewInt(maxGasPrice)) == 1 {
+			return nil, fmt.Errorf("bumped fee cap is more than max_gas_price, gasFeeCap = %v, maxGasPrice = %d", gasFeeCap, maxGasPrice)
+		}
+
+		txData = &ethTypes.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: bumpFee(tx.GasTipCap(), bumpPercent),
+			GasFeeCap: gasFeeCap,
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		}
+	default:
+		gasPrice := bumpFee(tx.GasPrice(), bumpPercent)
+		if gasPrice.Cmp(big.NewInt(maxGasPrice)) == 1 {
+			return nil, fmt.Errorf("bumped gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %d", gasPrice, maxGasPrice)
+		}
+
+		txData = &ethTypes.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}
+	}
+
+	replacement, err := SignTx(ctx, GetSigner(), ethTypes.LatestSignerForChainID(tx.ChainId()), ethTypes.NewTx(txData))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.SendTransaction(ctx, replacement); err != nil {
+		return nil, err
+	}
+	return replacement, nil
+}
+
+// bumpFee returns fee raised by percent, by at least one wei
+func bumpFee(fee *big.Int, percent int64) *big.Int {
+	bump := new(big.Int).Div(new(big.Int).Mul(fee, big.NewInt(percent)), big.NewInt(100))
+	if bump.Sign() <= 0 {
+		bump = big.NewInt(1)
+	}
+	return new(big.Int).Add(fee, bump)
+}
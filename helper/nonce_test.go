@@ -0,0 +1,46 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceManager(t *testing.T) {
+	manager := NewNonceManager()
+	account := NonceAccount{RootChain: "eth", From: common.HexToAddress("0x1")}
+	newTx := func(nonce uint64) *ethTypes.Transaction {
+		return ethTypes.NewTx(&ethTypes.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(1)})
+	}
+
+	// without pending txs chain nonce is used
+	require.Equal(t, uint64(5), manager.NextNonce(account, 5))
+
+	// next nonce follows pending txs
+	manager.Track(account, newTx(5))
+	manager.Track(account, newTx(6))
+	require.Equal(t, uint64(7), manager.NextNonce(account, 5))
+	require.Len(t, manager.Accounts(), 1)
+
+	// pending txs are stuck once timeout elapsed
+	require.Empty(t, manager.Stuck(account, 5, time.Hour))
+	stuck := manager.Stuck(account, 5, 0)
+	require.Len(t, stuck, 2)
+	require.Equal(t, uint64(5), stuck[0].Tx.Nonce())
+
+	// mined txs are dropped
+	require.Len(t, manager.Stuck(account, 6, 0), 1)
+	require.Equal(t, uint64(8), manager.NextNonce(account, 8))
+	require.Empty(t, manager.Accounts())
+}
+
+func TestBumpFee(t *testing.T) {
+	require.Equal(t, big.NewInt(120), bumpFee(big.NewInt(100), 20))
+
+	// fee is always raised
+	require.Equal(t, big.NewInt(2), bumpFee(big.NewInt(1), 20))
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"fmt"
+
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Pruning strategies of application state
+const (
+	PruningDefault    = "default"
+	PruningSyncable   = "syncable"
+	PruningNothing    = "nothing"
+	PruningEverything = "everything"
+	PruningCustom     = "custom"
+)
+
+// NewPruningOptions returns pruning options of strategy set in config, keep-recent and keep-every are
+// used by custom strategy only. Versions are pruned as blocks are committed, there is no pruning interval.
+func NewPruningOptions(config Configuration) (storeTypes.PruningOptions, error) {
+	switch config.Pruning {
+	case "", PruningDefault, PruningSyncable:
+		return storeTypes.PruneSyncable, nil
+	case PruningNothing:
+		return storeTypes.PruneNothing, nil
+	case PruningEverything:
+		return storeTypes.PruneEverything, nil
+	case PruningCustom:
+		if config.PruningKeepRecent < 0 || config.PruningKeepEvery < 0 {
+			return storeTypes.PruningOptions{}, fmt.Errorf("pruning keep-recent %d and keep-every %d must not be negative", config.PruningKeepRecent, config.PruningKeepEvery)
+		}
+		return storeTypes.NewPruningOptions(config.PruningKeepRecent, config.PruningKeepEvery), nil
+	default:
+		return storeTypes.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", config.Pruning)
+	}
+}
+
+// KeepVersion returns whether version of store at latest version is kept by pruning options, same way
+// iavl store releases old versions on commit
+func KeepVersion(opts storeTypes.PruningOptions, version int64, latest int64) bool {
+	if version >= latest-opts.KeepRecent() {
+		return true
+	}
+	return opts.KeepEvery() != 0 && version%opts.KeepEvery() == 0
+}
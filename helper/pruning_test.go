@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"testing"
+
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPruningOptions(t *testing.T) {
+	for strategy, expected := range map[string]storeTypes.PruningOptions{
+		"":                storeTypes.PruneSyncable,
+		PruningDefault:    storeTypes.PruneSyncable,
+		PruningSyncable:   storeTypes.PruneSyncable,
+		PruningNothing:    storeTypes.PruneNothing,
+		PruningEverything: storeTypes.PruneEverything,
+	} {
+		opts, err := NewPruningOptions(Configuration{Pruning: strategy})
+		require.NoError(t, err)
+		require.Equal(t, expected, opts, strategy)
+	}
+
+	opts, err := NewPruningOptions(Configuration{Pruning: PruningCustom, PruningKeepRecent: 10, PruningKeepEvery: 100})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), opts.KeepRecent())
+	require.Equal(t, int64(100), opts.KeepEvery())
+
+	_, err = NewPruningOptions(Configuration{Pruning: PruningCustom, PruningKeepRecent: -1})
+	require.Error(t, err)
+
+	_, err = NewPruningOptions(Configuration{Pruning: "sometimes"})
+	require.Error(t, err)
+}
+
+func TestKeepVersion(t *testing.T) {
+	opts := storeTypes.NewPruningOptions(2, 5)
+
+	var kept []int64
+	for version := int64(1); version <= 12; version++ {
+		if KeepVersion(opts, version, 12) {
+			kept = append(kept, version)
+		}
+	}
+	require.Equal(t, []int64{5, 10, 11, 12}, kept)
+
+	// nothing keeps every version, everything keeps latest only
+	require.True(t, KeepVersion(storeTypes.PruneNothing, 1, 12))
+	require.False(t, KeepVersion(storeTypes.PruneEverything, 11, 12))
+	require.True(t, KeepVersion(storeTypes.PruneEverything, 12, 12))
+}
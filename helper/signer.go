@@ -0,0 +1,143 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// Signer types of validator key
+const (
+	SignerTypeLocal  = "local"
+	SignerTypeGRPC   = "grpc"
+	SignerTypeAWSKMS = "aws_kms"
+)
+
+// Signer signs with validator key, which may be kept outside of the process by remote signer or HSM
+type Signer interface {
+	// PubKey returns uncompressed public key of validator key
+	PubKey() secp256k1.PubKeySecp256k1
+
+	// Sign returns 65 byte [R || S || V] secp256k1 signature of 32 byte hash
+	Sign(ctx context.Context, hash []byte) ([]byte, error)
+}
+
+// signer signs transactions and data with validator key
+var signer Signer
+
+// GetSigner returns signer of validator key
+func GetSigner() Signer {
+	return signer
+}
+
+// NewSigner creates signer of validator key of type set in config, private key is used by local signer only
+func NewSigner(config Configuration, privKey secp256k1.PrivKeySecp256k1) (Signer, error) {
+	switch config.SignerType {
+	case "", SignerTypeLocal:
+		return NewLocalSigner(privKey)
+	case SignerTypeGRPC:
+		return NewGRPCSigner(config.RemoteSignerURL, config.RemoteSignerTLSCertFile)
+	case SignerTypeAWSKMS:
+		return NewKMSSigner(config.AWSKMSKeyID, config.AWSKMSRegion)
+	default:
+		return nil, fmt.Errorf("unknown signer type %s", config.SignerType)
+	}
+}
+
+// LocalSigner signs with private key loaded from priv_validator_key.json
+type LocalSigner struct {
+	privKey *ecdsa.PrivateKey
+	pubKey  secp256k1.PubKeySecp256k1
+}
+
+// NewLocalSigner creates signer of private key
+func NewLocalSigner(privKey secp256k1.PrivKeySecp256k1) (*LocalSigner, error) {
+	ecdsaPrivKey, err := ethCrypto.ToECDSA(privKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey secp256k1.PubKeySecp256k1
+	copy(pubKey[:], ethCrypto.FromECDSAPub(&ecdsaPrivKey.PublicKey))
+
+	return &LocalSigner{
+		privKey: ecdsaPrivKey,
+		pubKey:  pubKey,
+	}, nil
+}
+
+// PubKey returns public key of private key
+func (s *LocalSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Sign signs hash with private key
+func (s *LocalSigner) Sign(_ context.Context, hash []byte) ([]byte, error) {
+	return ethCrypto.Sign(hash, s.privKey)
+}
+
+// SignerAddress returns address of signer's validator key
+func SignerAddress(s Signer) common.Address {
+	return common.BytesToAddress(s.PubKey().Address().Bytes())
+}
+
+// NewSignerTransactor creates transactor signing root chain transactions with signer
+func NewSignerTransactor(ctx context.Context, s Signer, chainID *big.Int) (*bind.TransactOpts, error) {
+	if chainID == nil {
+		return nil, bind.ErrNoChainID
+	}
+
+	from := SignerAddress(s)
+	txSigner := ethTypes.LatestSignerForChainID(chainID)
+
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			return SignTx(ctx, s, txSigner, tx)
+		},
+		Context: ctx,
+	}, nil
+}
+
+// SignTx signs transaction with signer
+func SignTx(ctx context.Context, s Signer, txSigner ethTypes.Signer, tx *ethTypes.Transaction) (*ethTypes.Transaction, error) {
+	hash := txSigner.Hash(tx)
+
+	sig, err := s.Sign(ctx, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.WithSignature(txSigner, sig)
+}
+
+// verifySignature checks remote signature is 65 byte signature of hash by public key
+func verifySignature(hash []byte, sig []byte, pubKey secp256k1.PubKeySecp256k1) error {
+	if len(sig) != 65 {
+		return fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	recovered, err := ethCrypto.Ecrecover(hash, sig)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(recovered, pubKey[:]) {
+		return errors.New("signature does not match public key of signer")
+	}
+
+	return nil
+}
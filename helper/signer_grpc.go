@@ -0,0 +1,101 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Methods of remote signer service, which is served as
+//
+//	service RemoteSigner {
+//	  rpc PubKey(google.protobuf.Empty) returns (google.protobuf.BytesValue);
+//	  rpc Sign(google.protobuf.BytesValue) returns (google.protobuf.BytesValue);
+//	}
+//
+// PubKey returns 65 byte uncompressed public key, Sign takes 32 byte hash and returns 65 byte [R || S || V] signature.
+const (
+	remoteSignerPubKeyMethod = "/signer.RemoteSigner/PubKey"
+	remoteSignerSignMethod   = "/signer.RemoteSigner/Sign"
+)
+
+// remoteSignerInitTimeout is time remote signer may take to return public key on startup
+const remoteSignerInitTimeout = 30 * time.Second
+
+// GRPCSigner signs with validator key kept by remote signing service over gRPC
+type GRPCSigner struct {
+	conn   *grpc.ClientConn
+	pubKey secp256k1.PubKeySecp256k1
+}
+
+// NewGRPCSigner dials remote signer and fetches public key of validator key from it
+func NewGRPCSigner(url string, tlsCertFile string) (*GRPCSigner, error) {
+	if url == "" {
+		return nil, fmt.Errorf("remote signer url is required by %s signer", SignerTypeGRPC)
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsCertFile != "" {
+		var err error
+		if creds, err = credentials.NewClientTLSFromFile(tlsCertFile, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerInitTimeout)
+	defer cancel()
+
+	var pubKeyBytes wrapperspb.BytesValue
+	if err := conn.Invoke(ctx, remoteSignerPubKeyMethod, &emptypb.Empty{}, &pubKeyBytes); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("fetching public key from remote signer: %w", err)
+	}
+
+	var pubKey secp256k1.PubKeySecp256k1
+	if len(pubKeyBytes.Value) != len(pubKey) {
+		conn.Close()
+		return nil, fmt.Errorf("invalid public key length %d from remote signer", len(pubKeyBytes.Value))
+	}
+	copy(pubKey[:], pubKeyBytes.Value)
+
+	return &GRPCSigner{
+		conn:   conn,
+		pubKey: pubKey,
+	}, nil
+}
+
+// PubKey returns public key of validator key
+func (s *GRPCSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Sign requests signature of hash from remote signer
+func (s *GRPCSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	var sig wrapperspb.BytesValue
+	if err := s.conn.Invoke(ctx, remoteSignerSignMethod, wrapperspb.Bytes(hash), &sig); err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(hash, sig.Value, s.pubKey); err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+
+	return sig.Value, nil
+}
+
+// Close closes connection to remote signer
+func (s *GRPCSigner) Close() error {
+	return s.conn.Close()
+}
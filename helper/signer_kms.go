@@ -0,0 +1,137 @@
+package helper
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+var (
+	secp256k1N     = ethCrypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// KMSSigner signs with ECC_SECG_P256K1 validator key kept in aws kms
+type KMSSigner struct {
+	client *kms.KMS
+	keyID  string
+	pubKey secp256k1.PubKeySecp256k1
+}
+
+// NewKMSSigner creates signer of aws kms key and fetches its public key
+func NewKMSSigner(keyID string, region string) (*KMSSigner, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("aws kms key id is required by %s signer", SignerTypeAWSKMS)
+	}
+
+	config := aws.NewConfig()
+	if region != "" {
+		config = config.WithRegion(region)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := kms.New(sess)
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerInitTimeout)
+	defer cancel()
+
+	out, err := client.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return nil, fmt.Errorf("fetching public key from aws kms: %w", err)
+	}
+
+	pubKey, err := parseKMSPublicKey(out.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KMSSigner{
+		client: client,
+		keyID:  keyID,
+		pubKey: pubKey,
+	}, nil
+}
+
+// PubKey returns public key of kms key
+func (s *KMSSigner) PubKey() secp256k1.PubKeySecp256k1 {
+	return s.pubKey
+}
+
+// Sign signs hash with kms key
+func (s *KMSSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	out, err := s.client.SignWithContext(ctx, &kms.SignInput{
+		KeyId:            aws.String(s.keyID),
+		Message:          hash,
+		MessageType:      aws.String(kms.MessageTypeDigest),
+		SigningAlgorithm: aws.String(kms.SigningAlgorithmSpecEcdsaSha256),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoverableSignature(hash, out.Signature, s.pubKey)
+}
+
+// parseKMSPublicKey returns uncompressed public key from DER encoded SubjectPublicKeyInfo returned by kms
+func parseKMSPublicKey(der []byte) (pubKey secp256k1.PubKeySecp256k1, err error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	if _, err = asn1.Unmarshal(der, &info); err != nil {
+		return pubKey, fmt.Errorf("invalid kms public key: %w", err)
+	}
+
+	if len(info.PublicKey.Bytes) != len(pubKey) {
+		return pubKey, fmt.Errorf("invalid kms public key length %d", len(info.PublicKey.Bytes))
+	}
+
+	copy(pubKey[:], info.PublicKey.Bytes)
+	return pubKey, nil
+}
+
+// recoverableSignature converts DER encoded signature returned by kms to [R || S || V] one, with S in lower
+// half of curve order as required by ethereum, and recovery id V found by recovering public key
+func recoverableSignature(hash []byte, der []byte, pubKey secp256k1.PubKeySecp256k1) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("invalid kms signature: %w", err)
+	}
+
+	if sig.S.Cmp(secp256k1HalfN) > 0 {
+		sig.S = new(big.Int).Sub(secp256k1N, sig.S)
+	}
+
+	result := make([]byte, 65)
+	sig.R.FillBytes(result[:32])
+	sig.S.FillBytes(result[32:64])
+
+	for v := byte(0); v < 2; v++ {
+		result[64] = v
+		if verifySignature(hash, result, pubKey) == nil {
+			return result, nil
+		}
+	}
+
+	return nil, errors.New("kms signature does not match public key of signer")
+}
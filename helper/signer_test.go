@@ -0,0 +1,77 @@
+package helper
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestLocalSigner(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	s, err := NewLocalSigner(privKey)
+	require.NoError(t, err)
+
+	var pubKey secp256k1.PubKeySecp256k1
+	cdc.MustUnmarshalBinaryBare(privKey.PubKey().Bytes(), &pubKey)
+	require.Equal(t, pubKey, s.PubKey())
+
+	hash := ethCrypto.Keccak256([]byte("checkpoint"))
+	sig, err := s.Sign(context.Background(), hash)
+	require.NoError(t, err)
+	require.NoError(t, verifySignature(hash, sig, s.PubKey()))
+
+	// signature of other hash is rejected
+	require.Error(t, verifySignature(ethCrypto.Keccak256([]byte("other")), sig, s.PubKey()))
+}
+
+func TestKMSSignature(t *testing.T) {
+	s, err := NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+
+	hash := ethCrypto.Keccak256([]byte("checkpoint"))
+	sig, err := s.Sign(context.Background(), hash)
+	require.NoError(t, err)
+
+	r := new(big.Int).SetBytes(sig[:32])
+	lowS := new(big.Int).SetBytes(sig[32:64])
+	highS := new(big.Int).Sub(secp256k1N, lowS)
+
+	// kms may return either S, both convert to same signature
+	for _, signatureS := range []*big.Int{lowS, highS} {
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, signatureS})
+		require.NoError(t, err)
+
+		converted, err := recoverableSignature(hash, der, s.PubKey())
+		require.NoError(t, err)
+		require.Equal(t, sig, converted)
+	}
+
+	// public key is taken from SubjectPublicKeyInfo
+	pubKey := s.PubKey()
+	der, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}},
+		PublicKey: asn1.BitString{Bytes: pubKey[:], BitLength: len(pubKey) * 8},
+	})
+	require.NoError(t, err)
+
+	parsed, err := parseKMSPublicKey(der)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, parsed)
+
+	// signature of other key is rejected
+	other, err := NewLocalSigner(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	der, err = asn1.Marshal(struct{ R, S *big.Int }{r, lowS})
+	require.NoError(t, err)
+	_, err = recoverableSignature(hash, der, other.PubKey())
+	require.Error(t, err)
+}
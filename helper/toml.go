@@ -24,6 +24,15 @@ bsc_rpc_url = "{{ .BscRPCUrl }}"
 # RPC endpoint for bttc chain
 bttc_rpc_url = "{{ .BttcRPCUrl }}"
 
+# comma separated <root chain>=<url> RPC endpoints of root chains registered through governance
+root_chain_rpc_urls = "{{ .RootChainRPCUrls }}"
+
+# comma separated fallback RPC endpoints bridge listeners fail over to
+eth_rpc_fallback_urls = "{{ .EthRPCFallbackUrls }}"
+bsc_rpc_fallback_urls = "{{ .BscRPCFallbackUrls }}"
+bttc_rpc_fallback_urls = "{{ .BttcRPCFallbackUrls }}"
+rpc_health_check_interval = "{{ .RPCHealthCheckInterval }}"
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
@@ -37,9 +46,15 @@ tron_grid_api_key = "{{ .TronGridApiKey }}"
 
 #### Bridge configs ####
 
+# Queue backend bridge tasks go through: amqp, leveldb (embedded in bridge db) or nats
+bridge_queue = "{{ .BridgeQueue }}"
+
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# NATS endpoint
+nats_url = "{{ .NatsURL }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 eth_syncer_poll_interval = "{{ .EthSyncerPollInterval }}"
@@ -56,15 +71,85 @@ tron_chain_fee_limit = "{{ .TronchainFeeLimit }}"
 #### gas price ####
 main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
+# comma separated root chains sent EIP-1559 dynamic fee transactions, eg. "eth,bsc"
+dynamic_fee_root_chains = "{{ .DynamicFeeRootChains }}"
+max_priority_fee_per_gas = "{{ .MaxPriorityFeePerGas }}"
+
+#### stuck root chain transactions ####
+root_chain_tx_stuck_timeout = "{{ .RootChainTxStuckTimeout }}"
+root_chain_tx_gas_bump_percent = "{{ .RootChainTxGasBumpPercent }}"
+
+#### root chain gas limit ####
+# gas limit of root chain tx is estimated gas times multiplier, bounded by max gas limit
+root_chain_gas_limit_multiplier = "{{ .RootChainGasLimitMultiplier }}"
+root_chain_max_gas_limit = "{{ .RootChainMaxGasLimit }}"
+
+# max number of calls sent in single JSON-RPC batch when fetching headers and receipts
+rpc_batch_size = "{{ .RPCBatchSize }}"
+
+# number of confirmed receipts and child chain root hashes cached by contract caller, 0 disables cache
+contract_caller_cache_size = "{{ .ContractCallerCacheSize }}"
+
+# time each contract caller call to root and child chain nodes may take, 0 waits until caller context is done
+contract_call_timeout = "{{ .ContractCallTimeout }}"
+
+#### validator key signer ####
+# local, grpc or aws_kms
+signer_type = "{{ .SignerType }}"
+remote_signer_url = "{{ .RemoteSignerURL }}"
+remote_signer_tls_cert_file = "{{ .RemoteSignerTLSCertFile }}"
+aws_kms_key_id = "{{ .AWSKMSKeyID }}"
+aws_kms_region = "{{ .AWSKMSRegion }}"
+
 #### busy limits ####
 eth_unconfirmed_txs_busy_limit = "{{ .EthUnconfirmedTxsBusyLimit }}"
 bsc_unconfirmed_txs_busy_limit = "{{ .BscUnconfirmedTxsBusyLimit }}"
 tron_unconfirmed_txs_busy_limit = "{{ .TronUnconfirmedTxsBusyLimit }}"
 
+#### reorg protection ####
+max_reorg_depth = "{{ .MaxReorgDepth }}"
+replay_depth = "{{ .ReplayDepth }}"
+
+#### header confirmations ####
+eth_required_confirmations = "{{ .EthRequiredConfirmations }}"
+bsc_required_confirmations = "{{ .BscRequiredConfirmations }}"
+bttc_required_confirmations = "{{ .BttcRequiredConfirmations }}"
+
+#### header processing ####
+header_process_workers = "{{ .HeaderProcessWorkers }}"
+header_fanout_buffer = "{{ .HeaderFanoutBuffer }}"
+header_fanout_policy = "{{ .HeaderFanoutPolicy }}"
+
+#### queue backpressure ####
+queue_high_water_mark = "{{ .QueueHighWaterMark }}"
+queue_low_water_mark = "{{ .QueueLowWaterMark }}"
+
+#### confirmation latency throttle ####
+latency_throttle_threshold = "{{ .LatencyThrottleThreshold }}"
+latency_throttle_max_delay = "{{ .LatencyThrottleMaxDelay }}"
+latency_throttle_smoothing = "{{ .LatencyThrottleSmoothing }}"
+
+#### metrics and admin ####
+bridge_metrics_addr = "{{ .BridgeMetricsAddr }}"
+bridge_admin_addr = "{{ .BridgeAdminAddr }}"
+bridge_listeners = "{{ .BridgeListeners }}"
+
+#### dead-letter queue ####
+bridge_task_max_attempts = "{{ .BridgeTaskMaxAttempts }}"
+
 eth_max_query_blocks = "{{ .EthMaxQueryBlocks }}"
 bsc_max_query_blocks = "{{ .BscMaxQueryBlocks }}"
 tron_max_query_blocks = "{{ .TronMaxQueryBlocks }}"
 
+#### state pruning ####
+pruning = "{{ .Pruning }}"
+pruning_keep_recent = "{{ .PruningKeepRecent }}"
+pruning_keep_every = "{{ .PruningKeepEvery }}"
+
+#### debug ####
+account_root_self_check = "{{ .AccountRootSelfCheck }}"
+enable_debug_queries = "{{ .EnableDebugQueries }}"
+
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
@@ -3,7 +3,9 @@ package helper
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 
@@ -11,8 +13,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	ethCrypto "github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/maticnetwork/heimdall/contracts/erc20"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -22,52 +22,84 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// GenerateAuthObj creates transactor of legacy gas price transaction
 func GenerateAuthObj(client *ethclient.Client, address common.Address, data []byte) (auth *bind.TransactOpts, err error) {
+	return generateAuthObj(context.Background(), client, address, data, "")
+}
+
+// GenerateRootChainAuthObj creates transactor of transaction to root chain, dynamic fee one if it is enabled for
+// root chain. Nonce follows pending transactions of root chain account tracked by nonce manager.
+func GenerateRootChainAuthObj(ctx context.Context, client *ethclient.Client, address common.Address, data []byte, rootChain string) (auth *bind.TransactOpts, err error) {
+	return generateAuthObj(ctx, client, address, data, rootChain)
+}
+
+func generateAuthObj(ctx context.Context, client *ethclient.Client, address common.Address, data []byte, rootChain string) (auth *bind.TransactOpts, err error) {
 	// generate call msg
 	callMsg := ethereum.CallMsg{
 		To:   &address,
 		Data: data,
 	}
 
-	// get priv key
-	pkObject := GetPrivKey()
-
-	// create ecdsa private key
-	ecdsaPrivateKey, err := crypto.ToECDSA(pkObject[:])
-	if err != nil {
-		return
-	}
-
 	// from address
-	fromAddress := common.BytesToAddress(pkObject.PubKey().Address().Bytes())
-	// fetch gas price
-	gasprice, err := client.SuggestGasPrice(context.Background())
-	if err != nil {
-		return
-	}
+	fromAddress := SignerAddress(GetSigner())
 
 	mainChainMaxGasPrice := GetConfig().MainchainMaxGasPrice
 	// Check if configured or not, Use default in case of invalid value
 	if mainChainMaxGasPrice <= 0 {
 		mainChainMaxGasPrice = DefaultMainchainMaxGasPrice
 	}
-	if gasprice.Cmp(big.NewInt(mainChainMaxGasPrice)) == 1 {
-		Logger.Error("Gas price is more than max gas price", "gasprice", gasprice)
-		err = fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %d", gasprice, mainChainMaxGasPrice)
-		return
+
+	// fetch fees
+	var gasprice, gasTipCap, gasFeeCap *big.Int
+	if IsDynamicFeeEnabled(rootChain) {
+		gasTipCap, gasFeeCap, err = suggestDynamicFee(ctx, client, big.NewInt(mainChainMaxGasPrice))
+		if err != nil {
+			Logger.Error("Unable to suggest dynamic fee", "error", err)
+			return
+		}
+	} else {
+		gasprice, err = client.SuggestGasPrice(ctx)
+		if err != nil {
+			return
+		}
+
+		if gasprice.Cmp(big.NewInt(mainChainMaxGasPrice)) == 1 {
+			Logger.Error("Gas price is more than max gas price", "gasprice", gasprice)
+			err = fmt.Errorf("gas price is more than max_gas_price, gasprice = %v, maxGasPrice = %d", gasprice, mainChainMaxGasPrice)
+			return
+		}
 	}
 
 	// fetch nonce
-	nonce, err := client.NonceAt(context.Background(), fromAddress, nil)
+	nonce, err := client.NonceAt(ctx, fromAddress, nil)
 	if err != nil {
 		return
 	}
+	if rootChain != "" {
+		nonce = rootChainNonces.NextNonce(NonceAccount{RootChain: rootChain, From: fromAddress}, nonce)
+	}
 
 	// fetch gas limit
 	callMsg.From = fromAddress
-	gasLimit, err := client.EstimateGas(context.Background(), callMsg)
+	gasLimit, err := client.EstimateGas(ctx, callMsg)
+	if err != nil {
+		Logger.Error("Unable to estimate gas", "error", err)
+		return
+	}
+	if rootChain != "" {
+		estimatedGas := gasLimit
+		gasLimit, err = rootChainGasLimit(estimatedGas, GetConfig().RootChainGasLimitMultiplier, GetConfig().RootChainMaxGasLimit)
+		if err != nil {
+			Logger.Error("Estimated gas is more than max gas limit", "rootChain", rootChain, "estimatedGas", estimatedGas, "error", err)
+			return
+		}
 
-	chainID, err := client.ChainID(context.Background())
+		Logger.Info("Estimated gas of root chain tx", "rootChain", rootChain, "estimatedGas", estimatedGas, "gasLimit", gasLimit)
+		rootChainGasEstimate.WithLabelValues(rootChain).Set(float64(estimatedGas))
+		rootChainGasLimitGauge.WithLabelValues(rootChain).Set(float64(gasLimit))
+	}
+
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
 		Logger.Error("Unable to fetch ChainID", "error", err)
 
@@ -75,38 +107,99 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	}
 
 	// create auth
-	auth, err = bind.NewKeyedTransactorWithChainID(ecdsaPrivateKey, chainID)
+	auth, err = NewSignerTransactor(ctx, GetSigner(), chainID)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 
 		return
 	}
 
+	// transaction is dynamic fee one if fee caps are set, legacy one with gas price otherwise
 	auth.GasPrice = gasprice
+	auth.GasTipCap = gasTipCap
+	auth.GasFeeCap = gasFeeCap
 	auth.Nonce = big.NewInt(int64(nonce))
-	auth.GasLimit = uint64(gasLimit) // uint64(gasLimit)
+	auth.GasLimit = gasLimit
+	auth.Context = ctx
 
 	return
 }
 
+// suggestDynamicFee returns priority fee and fee cap of dynamic fee transaction, from priority fee
+// suggested by root chain and base fee of its latest block
+func suggestDynamicFee(ctx context.Context, client *ethclient.Client, maxGasPrice *big.Int) (gasTipCap *big.Int, gasFeeCap *big.Int, err error) {
+	gasTipCap, err = client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.BaseFee == nil {
+		return nil, nil, errors.New("root chain block has no base fee, dynamic fee transactions are not supported")
+	}
+
+	gasTipCap, gasFeeCap, err = dynamicFeeCaps(header.BaseFee, gasTipCap, big.NewInt(GetConfig().MaxPriorityFeePerGas), maxGasPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	Logger.Debug("Suggested dynamic fee", "baseFee", header.BaseFee, "gasTipCap", gasTipCap, "gasFeeCap", gasFeeCap)
+	return gasTipCap, gasFeeCap, nil
+}
+
+// dynamicFeeCaps bounds priority fee by max priority fee (unbounded if it is 0) and sets fee cap to twice
+// base fee plus priority fee, so tx stays includable while base fee rises, bounded by max gas price
+func dynamicFeeCaps(baseFee *big.Int, gasTipCap *big.Int, maxPriorityFee *big.Int, maxGasPrice *big.Int) (*big.Int, *big.Int, error) {
+	if maxPriorityFee.Sign() > 0 && gasTipCap.Cmp(maxPriorityFee) == 1 {
+		gasTipCap = new(big.Int).Set(maxPriorityFee)
+	}
+
+	if minFee := new(big.Int).Add(baseFee, gasTipCap); minFee.Cmp(maxGasPrice) == 1 {
+		return nil, nil, fmt.Errorf("base fee and priority fee are more than max_gas_price, baseFee = %v, gasTipCap = %v, maxGasPrice = %v", baseFee, gasTipCap, maxGasPrice)
+	}
+
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gasTipCap)
+	if gasFeeCap.Cmp(maxGasPrice) == 1 {
+		gasFeeCap = new(big.Int).Set(maxGasPrice)
+	}
+	return gasTipCap, gasFeeCap, nil
+}
+
+// rootChainGasLimit raises estimated gas by multiplier (at least 1) and bounds it by max gas limit (unbounded
+// if it is 0). Estimate above max gas limit is an error, as tx sent with it would run out of gas.
+func rootChainGasLimit(estimatedGas uint64, multiplier float64, maxGasLimit uint64) (uint64, error) {
+	if maxGasLimit > 0 && estimatedGas > maxGasLimit {
+		return 0, fmt.Errorf("estimated gas is more than max_gas_limit, estimatedGas = %d, maxGasLimit = %d", estimatedGas, maxGasLimit)
+	}
+
+	gasLimit := estimatedGas
+	if multiplier > 1 {
+		gasLimit = uint64(math.Ceil(float64(estimatedGas) * multiplier))
+	}
+	if maxGasLimit > 0 && gasLimit > maxGasLimit {
+		gasLimit = maxGasLimit
+	}
+	return gasLimit, nil
+}
+
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
-func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int,
+func (c *ContractCaller) SendCheckpoint(ctx context.Context, signedData []byte, sigs [][3]*big.Int,
 	rootChainAddress common.Address, rootChainInstance *rootchain.Rootchain, rootChain string) (er error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.RootChainABI.Pack("submitCheckpoint", signedData, sigs)
 	if err != nil {
 		Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
 		return err
 	}
 
-	var client *ethclient.Client
-	switch rootChain {
-	case hmtypes.RootChainTypeEth:
-		client = GetMainClient()
-	case hmtypes.RootChainTypeBsc:
-		client = GetBscClient()
-	}
-	auth, err := GenerateAuthObj(client, rootChainAddress, data)
+	client := GetRootChainClient(rootChain)
+	auth, err := GenerateRootChainAuthObj(ctx, client, rootChainAddress, data, rootChain)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -127,19 +220,23 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int,
 		Logger.Error("Error while submitting checkpoint", "error", err)
 		return err
 	}
+	rootChainNonces.Track(NonceAccount{RootChain: rootChain, From: auth.From}, tx)
 	Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", tx.Hash().String())
 	return
 }
 
 // SendTick sends slash tick to rootchain contract
-func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (er error) {
+func (c *ContractCaller) SendTick(ctx context.Context, signedData []byte, sigs []byte, slashManagerAddress common.Address, slashManagerInstance *slashmanager.Slashmanager) (er error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.SlashManagerABI.Pack("updateSlashedAmounts", signedData, sigs)
 	if err != nil {
 		Logger.Error("Unable to pack tx for updateSlashedAmounts", "error", err)
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), slashManagerAddress, data)
+	auth, err := GenerateRootChainAuthObj(ctx, GetMainClient(), slashManagerAddress, data, hmtypes.RootChainTypeEth)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -155,12 +252,16 @@ func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAd
 		Logger.Error("Error while submitting tick", "error", err)
 		return err
 	}
+	rootChainNonces.Track(NonceAccount{RootChain: hmtypes.RootChainTypeEth, From: auth.From}, tx)
 	Logger.Info("Submitted new tick to slashmanager successfully", "txHash", tx.Hash().String())
 	return
 }
 
 // StakeFor stakes for a validator
-func (c *ContractCaller) StakeFor(val common.Address, stakeAmount *big.Int, feeAmount *big.Int, acceptDelegation bool, stakeManagerAddress common.Address, stakeManagerInstance *stakemanager.Stakemanager) error {
+func (c *ContractCaller) StakeFor(ctx context.Context, val common.Address, stakeAmount *big.Int, feeAmount *big.Int, acceptDelegation bool, stakeManagerAddress common.Address, stakeManagerInstance *stakemanager.Stakemanager) error {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	signerPubkey := GetPubKey()
 	signerPubkeyBytes := signerPubkey[1:] // remove 04 prefix
 
@@ -171,7 +272,7 @@ func (c *ContractCaller) StakeFor(val common.Address, stakeAmount *big.Int, feeA
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), stakeManagerAddress, data)
+	auth, err := GenerateRootChainAuthObj(ctx, GetMainClient(), stakeManagerAddress, data, hmtypes.RootChainTypeEth)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -192,19 +293,23 @@ func (c *ContractCaller) StakeFor(val common.Address, stakeAmount *big.Int, feeA
 		return err
 	}
 
+	rootChainNonces.Track(NonceAccount{RootChain: hmtypes.RootChainTypeEth, From: auth.From}, tx)
 	Logger.Info("Submitted stake sucessfully", "txHash", tx.Hash().String())
 	return nil
 }
 
 // ApproveTokens approves matic token for stake
-func (c *ContractCaller) ApproveTokens(amount *big.Int, stakeManager common.Address, tokenAddress common.Address, maticTokenInstance *erc20.Erc20) error {
+func (c *ContractCaller) ApproveTokens(ctx context.Context, amount *big.Int, stakeManager common.Address, tokenAddress common.Address, maticTokenInstance *erc20.Erc20) error {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.MaticTokenABI.Pack("approve", stakeManager, amount)
 	if err != nil {
 		Logger.Error("Unable to pack tx for approve", "error", err)
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), tokenAddress, data)
+	auth, err := GenerateRootChainAuthObj(ctx, GetMainClient(), tokenAddress, data, hmtypes.RootChainTypeEth)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -216,19 +321,22 @@ func (c *ContractCaller) ApproveTokens(amount *big.Int, stakeManager common.Addr
 		return err
 	}
 
+	rootChainNonces.Track(NonceAccount{RootChain: hmtypes.RootChainTypeEth, From: auth.From}, tx)
 	Logger.Info("Sent approve tx sucessfully", "txHash", tx.Hash().String())
 	return nil
 }
 
 // SendMainStakingSync sends staking sync to rootchain contract
-func (c *ContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error {
+func (c *ContractCaller) SendTronCheckpoint(ctx context.Context, signedData []byte, sigs [][3]*big.Int, rootChainAddress string) error {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.RootChainABI.Pack("submitCheckpoint", signedData, sigs)
 	if err != nil {
 		return err
 	}
-	privateKey := GetPrivKey()
 	// trigger
-	trx, err := c.TronChainRPC.TriggerContract(privateKey.PubKey().Address().String(), rootChainAddress, data)
+	trx, err := c.TronChainRPC.TriggerContract(ctx, GetPubKey().Address().String(), rootChainAddress, data)
 	if err != nil {
 		return err
 	}
@@ -239,14 +347,14 @@ func (c *ContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.In
 		return err
 	}
 
-	signature, err := ethCrypto.Sign(hash, privateKey[:])
+	signature, err := GetSigner().Sign(ctx, hash)
 	if err != nil {
 		return err
 	}
 
 	trx.Signature = append(trx.GetSignature(), signature)
 
-	err = c.TronChainRPC.BroadcastTransaction(context.Background(), trx)
+	err = c.TronChainRPC.BroadcastTransaction(ctx, trx)
 	if err != nil {
 		return err
 	}
@@ -254,14 +362,16 @@ func (c *ContractCaller) SendTronCheckpoint(signedData []byte, sigs [][3]*big.In
 }
 
 // SendCheckpointSyncToTron sends staking sync to tron stake manager contract
-func (c *ContractCaller) SendCheckpointSyncToTron(signedData []byte, sigs [][3]*big.Int, stakeManagerAddress string) error {
+func (c *ContractCaller) SendCheckpointSyncToTron(ctx context.Context, signedData []byte, sigs [][3]*big.Int, stakeManagerAddress string) error {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.StakeManagerABI.Pack("submitCheckpointSync", signedData, sigs)
 	if err != nil {
 		return err
 	}
-	privateKey := GetPrivKey()
 	// trigger
-	trx, err := c.TronChainRPC.TriggerContract(privateKey.PubKey().Address().String(), stakeManagerAddress, data)
+	trx, err := c.TronChainRPC.TriggerContract(ctx, GetPubKey().Address().String(), stakeManagerAddress, data)
 	if err != nil {
 		return err
 	}
@@ -272,14 +382,14 @@ func (c *ContractCaller) SendCheckpointSyncToTron(signedData []byte, sigs [][3]*
 		return err
 	}
 
-	signature, err := ethCrypto.Sign(hash, privateKey[:])
+	signature, err := GetSigner().Sign(ctx, hash)
 	if err != nil {
 		return err
 	}
 
 	trx.Signature = append(trx.GetSignature(), signature)
 
-	err = c.TronChainRPC.BroadcastTransaction(context.Background(), trx)
+	err = c.TronChainRPC.BroadcastTransaction(ctx, trx)
 	if err != nil {
 		return err
 	}
@@ -287,20 +397,17 @@ func (c *ContractCaller) SendCheckpointSyncToTron(signedData []byte, sigs [][3]*
 }
 
 // SendMainStakingSync sends staking sync to rootchain contract
-func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, stakingManager common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (er error) {
+func (c *ContractCaller) SendMainStakingSync(ctx context.Context, syncMethod string, signedData []byte, sigs [][3]*big.Int, stakingManager common.Address, stakingManagerInstance *stakemanager.Stakemanager, rootChain string) (er error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.StakeManagerABI.Pack(syncMethod, signedData, sigs)
 	if err != nil {
 		Logger.Error("Unable to pack tx for submitStakingSync", "error", err, "syncMethod", syncMethod)
 		return err
 	}
-	var client *ethclient.Client
-	switch rootChain {
-	case hmtypes.RootChainTypeEth:
-		client = GetMainClient()
-	case hmtypes.RootChainTypeBsc:
-		client = GetBscClient()
-	}
-	auth, err := GenerateAuthObj(client, stakingManager, data)
+	client := GetRootChainClient(rootChain)
+	auth, err := GenerateRootChainAuthObj(ctx, client, stakingManager, data, rootChain)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -334,20 +441,22 @@ func (c *ContractCaller) SendMainStakingSync(syncMethod string, signedData []byt
 		Logger.Error("Error while submitting staking sync", "error", err)
 		return err
 	}
+	rootChainNonces.Track(NonceAccount{RootChain: rootChain, From: auth.From}, tx)
 	Logger.Info("Submitted new staking sync to stake chain successfully", "txHash", tx.Hash().String())
 	return
 }
 
 // SendTronStakingSync sends staking sync to tron contract
-func (c *ContractCaller) SendTronStakingSync(syncMethod string, signedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (er error) {
+func (c *ContractCaller) SendTronStakingSync(ctx context.Context, syncMethod string, signedData []byte, sigs [][3]*big.Int, stakingManagerAddress string) (er error) {
+	ctx, cancel := callContext(ctx)
+	defer cancel()
+
 	data, err := c.StakeManagerABI.Pack(syncMethod, signedData, sigs)
 	if err != nil {
 		return err
 	}
-	privateKey := GetPrivKey()
-
 	// trigger
-	trx, err := c.TronChainRPC.TriggerContract(privateKey.PubKey().Address().String(), stakingManagerAddress, data)
+	trx, err := c.TronChainRPC.TriggerContract(ctx, GetPubKey().Address().String(), stakingManagerAddress, data)
 	if err != nil {
 		return err
 	}
@@ -358,7 +467,7 @@ func (c *ContractCaller) SendTronStakingSync(syncMethod string, signedData []byt
 		return err
 	}
 
-	signature, err := ethCrypto.Sign(hash, privateKey[:])
+	signature, err := GetSigner().Sign(ctx, hash)
 	if err != nil {
 		return err
 	}
@@ -374,7 +483,7 @@ func (c *ContractCaller) SendTronStakingSync(syncMethod string, signedData []byt
 		"data", hex.EncodeToString(signedData),
 	)
 
-	err = c.TronChainRPC.BroadcastTransaction(context.Background(), trx)
+	err = c.TronChainRPC.BroadcastTransaction(ctx, trx)
 	if err != nil {
 		return err
 	}
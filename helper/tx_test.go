@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicFeeCaps(t *testing.T) {
+	gwei := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1000000000)) }
+
+	// fee cap leaves room for base fee to double
+	tipCap, feeCap, err := dynamicFeeCaps(gwei(50), gwei(2), gwei(30), gwei(400))
+	require.NoError(t, err)
+	require.Equal(t, gwei(2), tipCap)
+	require.Equal(t, gwei(102), feeCap)
+
+	// priority fee is bounded by max priority fee, 0 leaves it unbounded
+	tipCap, _, err = dynamicFeeCaps(gwei(50), gwei(40), gwei(30), gwei(400))
+	require.NoError(t, err)
+	require.Equal(t, gwei(30), tipCap)
+
+	tipCap, _, err = dynamicFeeCaps(gwei(50), gwei(40), big.NewInt(0), gwei(400))
+	require.NoError(t, err)
+	require.Equal(t, gwei(40), tipCap)
+
+	// fee cap is bounded by max gas price
+	_, feeCap, err = dynamicFeeCaps(gwei(300), gwei(2), gwei(30), gwei(400))
+	require.NoError(t, err)
+	require.Equal(t, gwei(400), feeCap)
+
+	// base fee and priority fee above max gas price can't be paid
+	_, _, err = dynamicFeeCaps(gwei(399), gwei(2), gwei(30), gwei(400))
+	require.Error(t, err)
+}
+
+func TestIsDynamicFeeEnabled(t *testing.T) {
+	conf := GetConfig()
+	defer SetTestConfig(conf)
+
+	testConf := conf
+	testConf.DynamicFeeRootChains = "eth, bsc"
+	SetTestConfig(testConf)
+
+	require.True(t, IsDynamicFeeEnabled("eth"))
+	require.True(t, IsDynamicFeeEnabled("bsc"))
+	require.False(t, IsDynamicFeeEnabled("tron"))
+	require.False(t, IsDynamicFeeEnabled(""))
+}
+
+func TestRootChainGasLimit(t *testing.T) {
+	// estimate is raised by multiplier
+	gasLimit, err := rootChainGasLimit(100000, 1.2, 5000000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(120000), gasLimit)
+
+	// multiplier below 1 never lowers estimate, 0 max gas limit leaves it unbounded
+	gasLimit, err = rootChainGasLimit(100000, 0.5, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100000), gasLimit)
+
+	// margin is bounded by max gas limit
+	gasLimit, err = rootChainGasLimit(4500000, 1.2, 5000000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000000), gasLimit)
+
+	// estimate above max gas limit can't be sent
+	_, err = rootChainGasLimit(5000001, 1.2, 5000000)
+	require.Error(t, err)
+}
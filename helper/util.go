@@ -3,6 +3,8 @@ package helper
 import (
 	"bufio"
 	"bytes"
+	gocontext "context"
+	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
@@ -27,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/viper"
 	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -356,7 +359,7 @@ func GetSignedTxBytes(cliCtx context.CLIContext, txBldr authTypes.TxBuilder, msg
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithFunc(signHash, msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -406,7 +409,7 @@ func GetSignedTxBytesWithCLI(cliCtx context.CLIContext, txBldr authTypes.TxBuild
 
 	fromName := cliCtx.GetFromName()
 	if fromName == "" {
-		return txBldr.BuildAndSign(GetPrivKey(), msgs)
+		return txBldr.BuildAndSignWithFunc(signHash, msgs)
 	}
 
 	if cliCtx.Simulate {
@@ -539,7 +542,7 @@ func SignStdTx(
 		return txBldr.SignStdTxWithPassphrase(fromName, passphrase, stdTx, appendSig)
 	}
 
-	return txBldr.SignStdTx(GetPrivKey(), stdTx, appendSig)
+	return txBldr.SignStdTxWithFunc(signHash, stdTx)
 }
 
 // ReadStdTxFromFile and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
@@ -879,3 +882,27 @@ func Hash(s []byte) ([]byte, error) {
 	bs := h.Sum(nil)
 	return bs, nil
 }
+
+// SignData signs keccak256 hash of data with node's validator key
+func SignData(data []byte) ([]byte, error) {
+	return signHash(ethCrypto.Keccak256(data))
+}
+
+// signHash signs hash with node's validator key
+func signHash(hash []byte) ([]byte, error) {
+	return GetSigner().Sign(gocontext.Background(), hash)
+}
+
+// SignDataWithKey signs keccak256 hash of data with given private key
+func SignDataWithKey(data []byte, privKey *ecdsa.PrivateKey) ([]byte, error) {
+	return ethCrypto.Sign(ethCrypto.Keccak256(data), privKey)
+}
+
+// RecoverSigner returns address of key which signed keccak256 hash of data
+func RecoverSigner(data []byte, sig []byte) ([]byte, error) {
+	pubKey, err := ethCrypto.SigToPub(ethCrypto.Keccak256(data), sig)
+	if err != nil {
+		return nil, err
+	}
+	return ethCrypto.PubkeyToAddress(*pubKey).Bytes(), nil
+}
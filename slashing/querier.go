@@ -224,7 +224,7 @@ func querySlashingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	}
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetTronTransactionReceipt(hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
+	receipt, err := contractCallerObj.GetTronTransactionReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
 
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("Transaction is not confirmed yet. Please wait for sometime and try again"))
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bytes"
+	gocontext "context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -92,7 +93,7 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// get main tx receipt
-			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(),
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(gocontext.Background(), hmTypes.HexToHeimdallHash(txhash).EthHash(),
 				chainmanagerParams.MainchainTxConfirmations, hmTypes.RootChainTypeEth)
 			if err != nil || receipt == nil {
 				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
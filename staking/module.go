@@ -1,6 +1,7 @@
 package staking
 
 import (
+	gocontext "context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -84,7 +85,7 @@ func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error {
 	// validate validators
 	validators := data.Validators
 	for _, v := range validators {
-		val, err := contractCaller.GetValidatorInfo(v.ID, stakingInfoInstance)
+		val, err := contractCaller.GetValidatorInfo(gocontext.Background(), v.ID, stakingInfoInstance)
 		if err != nil {
 			return err
 		}
@@ -171,7 +171,7 @@ func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper K
 	}
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetTronTransactionReceipt(hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
+	receipt, err := contractCallerObj.GetTronTransactionReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
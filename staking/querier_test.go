@@ -17,6 +17,7 @@ import (
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -210,7 +211,7 @@ func (suite *QuerierTestSuite) TestHandleQueryStakingSequence() {
 
 	app.StakingKeeper.SetStakingSequence(ctx, sequence.String())
 
-	suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+	suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 	path := []string{types.QueryStakingSequence}
 
@@ -87,7 +87,7 @@ func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k K
 		err             error
 	)
 	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	receipt, err = contractCaller.GetTronTransactionReceipt(ctx.Context(), msg.TxHash.Hex())
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
@@ -226,7 +226,7 @@ func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Kee
 		err             error
 	)
 	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	receipt, err = contractCaller.GetTronTransactionReceipt(ctx.Context(), msg.TxHash.Hex())
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
@@ -291,7 +291,7 @@ func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k K
 		err             error
 	)
 	// get event log on tron
-	receipt, err = contractCaller.GetTronTransactionReceipt(msg.TxHash.Hex())
+	receipt, err = contractCaller.GetTronTransactionReceipt(ctx.Context(), msg.TxHash.Hex())
 	if err != nil || receipt == nil {
 		return hmCommon.ErrorSideTx(k.Codespace(), common.CodeWaitFrConfirmation)
 	}
@@ -348,7 +348,7 @@ func SideHandleMsgStakingSync(ctx sdk.Context, msg types.MsgStakingSync, k Keepe
 	case hmTypes.RootChainTypeEth:
 		stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
+		nonce = contractCaller.GetMainStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeBsc:
 		bscChain, err := k.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
 		if err != nil {
@@ -357,10 +357,10 @@ func SideHandleMsgStakingSync(ctx sdk.Context, msg types.MsgStakingSync, k Keepe
 		}
 		stakingManagerAddress := bscChain.StakingManagerAddress.EthAddress()
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
+		nonce = contractCaller.GetMainStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
 		stakingManagerAddress := chainParams.TronStakingManagerAddress
-		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
+		nonce = contractCaller.GetTronStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerAddress)
 	}
 	if nonce >= msg.Nonce {
 		k.Logger(ctx).Error("Nonce in message is not match with nonce in root", "msgNonce",
@@ -391,7 +391,7 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 	case hmTypes.RootChainTypeEth:
 		stakingManagerAddress := chainParams.StakingManagerAddress.EthAddress()
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
+		nonce = contractCaller.GetMainStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeBsc:
 		bscChain, err := k.chainKeeper.GetChainParams(ctx, hmTypes.RootChainTypeBsc)
 		if err != nil {
@@ -400,10 +400,10 @@ func SideHandleMsgStakingSyncAck(ctx sdk.Context, msg types.MsgStakingSyncAck, k
 		}
 		stakingManagerAddress := bscChain.StakingManagerAddress.EthAddress()
 		stakingManagerInstance, _ := contractCaller.GetStakeManagerInstance(stakingManagerAddress, msg.RootChain)
-		nonce = contractCaller.GetMainStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerInstance)
+		nonce = contractCaller.GetMainStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerInstance)
 	case hmTypes.RootChainTypeTron:
 		stakingManagerAddress := chainParams.TronStakingManagerAddress
-		nonce = contractCaller.GetTronStakingSyncNonce(msg.ValidatorID.Uint64(), stakingManagerAddress)
+		nonce = contractCaller.GetTronStakingSyncNonce(ctx.Context(), msg.ValidatorID.Uint64(), stakingManagerAddress)
 	}
 	if nonce < msg.Nonce {
 		k.Logger(ctx).Error("Nonce in message is bigger than nonce in root", "msgNonce", msg.Nonce, "nonceFromRoot", nonce)
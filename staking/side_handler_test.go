@@ -21,6 +21,7 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -118,8 +119,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -156,8 +157,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(nil, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(nil, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -185,8 +186,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			nonce.Uint64(),
 		)
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(nil, nil)
 
@@ -224,8 +225,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -263,8 +264,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -302,8 +303,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -341,8 +342,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -380,8 +381,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -419,8 +420,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -458,8 +459,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorJoin() {
 			SignerPubkey:    pubkey.Bytes()[1:],
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", txHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, txHash.String()).Return(txreceipt, nil)
 
 		suite.contractCaller.On("DecodeValidatorJoinEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, msgValJoin.LogIndex).Return(stakinginfoStaked, nil)
 
@@ -492,8 +493,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(oldSigner.ID), newSigner[0].PubKey, msgTxHash, 0, blockNumber.Uint64(), nonce.Uint64())
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -517,8 +518,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 		suite.contractCaller.On("DecodeSignerUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(nil, nil)
 
 		result := suite.sideHandler(ctx, msg)
@@ -540,8 +541,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		)
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -564,8 +565,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(6), newSigner[0].PubKey, msgTxHash, 0, blockNumber.Uint64(), nonce.Uint64())
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -588,8 +589,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(oldSigner.ID), hmTypes.NewPubKey([]byte{123}), msgTxHash, 0, blockNumber.Uint64(), nonce.Uint64())
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -612,8 +613,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		msg := types.NewMsgSignerUpdate(hmTypes.ZeroHeimdallAddress, uint64(oldSigner.ID), newSigner[0].PubKey, msgTxHash, 0, blockNumber.Uint64(), nonce.Uint64())
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -636,8 +637,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgSignerUpdate() {
 		msg := types.NewMsgSignerUpdate(newSigner[0].Signer, uint64(oldSigner.ID), newSigner[0].PubKey, msgTxHash, 0, blockNumber.Uint64(), uint64(12))
 
 		txreceipt := &ethTypes.Receipt{BlockNumber: blockNumber}
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 			ValidatorId:  new(big.Int).SetUint64(oldSigner.ID.Uint64()),
@@ -673,8 +674,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -709,8 +710,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(nil, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(nil, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -745,8 +746,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		validators[0].EndEpoch = 10
 
@@ -775,8 +776,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
 			User:              validators[0].Signer.EthAddress(),
@@ -810,8 +811,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -846,8 +847,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -881,8 +882,8 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 			BlockNumber: blockNumber,
 		}
 
-		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
-		suite.contractCaller.On("GetTronTransactionReceipt", msgTxHash.String()).Return(txreceipt, nil)
+		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, msgTxHash.String()).Return(txreceipt, nil)
 
 		amount, _ := big.NewInt(0).SetString("10000000000000000000", 10)
 		stakinginfoUnstakeInit := &stakinginfo.StakinginfoUnstakeInit{
@@ -939,7 +940,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			nonce.Uint64())
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -965,7 +966,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			nonce.Uint64())
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -992,7 +993,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
 //
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //		suite.contractCaller.On("DecodeValidatorStakeUpdateEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txreceipt, uint64(0)).Return(nil, nil)
 //
 //		result := suite.sideHandler(ctx, msg)
@@ -1012,7 +1013,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			nonce.Uint64())
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -1038,7 +1039,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			nonce.Uint64())
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -1064,7 +1065,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			nonce.Uint64())
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -1090,7 +1091,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgValidatorExit() {
 //			uint64(9))
 //
 //		txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-//		suite.contractCaller.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
+//		suite.contractCaller.On("GetConfirmedTxReceipt", mock.Anything, msgTxHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txreceipt, nil)
 //
 //		stakinginfoStakeUpdate := &stakinginfo.StakinginfoStakeUpdate{
 //			ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
@@ -35,6 +35,14 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/topup/account-proof/{address}",
 		dividendAccountProofHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/topup/dividend-accounts",
+		dividendAccountsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/topup/account-tree-proof/{root}/{address}",
+		dividendAccountTreeProofHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // Returns topup tx status information
@@ -244,3 +252,94 @@ func VerifyAccountProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// Returns dividend accounts of page ordered by user address
+func dividendAccountsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get page
+		page, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("page"))
+		if !ok {
+			return
+		}
+
+		// get limit
+		limit, ok := rest.ParseUint64OrReturnBadRequest(w, vars.Get("limit"))
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit, ""))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccounts), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching dividend accounts", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no dividend account found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No Dividend Accounts found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns Merkle path of dividend account read from account tree of root chain
+func dividendAccountTreeProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		root := vars["root"]
+		if hmTypes.GetRootChainID(root) == 0 {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid root chain %v", root))
+			return
+		}
+
+		// get address
+		userAddress := hmTypes.HexToHeimdallAddress(vars["address"])
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountTreeProofParams(userAddress, root))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountTreeProof), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching merkle proof", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if account proof not found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proof found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -18,6 +18,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	// account root is computed once for all genesis accounts
+	keeper.moduleCommunicator.UpdateAccountRoot(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
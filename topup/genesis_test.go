@@ -1,6 +1,7 @@
 package topup_test
 
 import (
+	"math/big"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -8,8 +9,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/topup"
 	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -53,3 +57,25 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	require.LessOrEqual(t, len(topupSequences), len(actualParams.TopupSequences))
 }
+
+// TestInitGenesisAccountRoot test account root is persisted for genesis dividend accounts
+func (suite *GenesisTestSuite) TestInitGenesisAccountRoot() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	dividendAccounts := []hmTypes.DividendAccount{
+		hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("123"), big.NewInt(0).String()),
+		hmTypes.NewDividendAccount(hmTypes.HexToHeimdallAddress("456"), big.NewInt(10).String()),
+	}
+	topup.InitGenesis(ctx, app.TopupKeeper, types.GenesisState{DividentAccounts: dividendAccounts})
+
+	store := ctx.KVStore(app.GetKey(checkpointTypes.StoreKey))
+	require.False(t, store.Has(checkpoint.AccountRootDirtyKey))
+	require.True(t, store.Has(checkpoint.GetAccountRootKey(hmTypes.GetRootChainID(hmTypes.RootChainTypeStake))))
+
+	expected, err := checkpointTypes.GetAccountRootHash(app.TopupKeeper.GetAllDividendAccounts(ctx), checkpointTypes.DefaultAccountHashStrategy)
+	require.NoError(t, err)
+
+	accountRoot, err := app.CheckpointKeeper.GetAccountRoot(ctx, hmTypes.RootChainTypeStake)
+	require.NoError(t, err)
+	require.Equal(t, expected, accountRoot)
+}
@@ -26,6 +26,13 @@ var (
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
 )
 
+// ModuleCommunicator manages different module interaction
+type ModuleCommunicator interface {
+	DividendAccountsChanged(ctx sdk.Context, user hmTypes.HeimdallAddress)
+	UpdateAccountRoot(ctx sdk.Context)
+	GetAccountTreeProof(ctx sdk.Context, rootChain string, user hmTypes.HeimdallAddress) ([]byte, uint64, error)
+}
+
 // Keeper stores all related data
 type Keeper struct {
 	// The (unexposed) key used to access the store from the Context.
@@ -42,6 +49,8 @@ type Keeper struct {
 	bk bank.Keeper
 	// staking keeper
 	sk staking.Keeper
+	// module communicator
+	moduleCommunicator ModuleCommunicator
 }
 
 // NewKeeper create new keeper
@@ -53,15 +62,17 @@ func NewKeeper(
 	chainKeeper chainmanager.Keeper,
 	bankKeeper bank.Keeper,
 	stakingKeeper staking.Keeper,
+	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	return Keeper{
-		cdc:         cdc,
-		key:         storeKey,
-		paramSpace:  paramSpace,
-		codespace:   codespace,
-		chainKeeper: chainKeeper,
-		bk:          bankKeeper,
-		sk:          stakingKeeper,
+		cdc:                cdc,
+		key:                storeKey,
+		paramSpace:         paramSpace,
+		codespace:          codespace,
+		chainKeeper:        chainKeeper,
+		bk:                 bankKeeper,
+		sk:                 stakingKeeper,
+		moduleCommunicator: moduleCommunicator,
 	}
 }
 
@@ -140,6 +151,9 @@ func (k *Keeper) AddDividendAccount(ctx sdk.Context, dividendAccount hmTypes.Div
 
 	store.Set(GetDividendAccountMapKey(dividendAccount.User.Bytes()), bz)
 	k.Logger(ctx).Debug("DividendAccount Stored", "key", hex.EncodeToString(GetDividendAccountMapKey(dividendAccount.User.Bytes())), "dividendAccount", dividendAccount.String())
+
+	// notify dividend account change
+	k.moduleCommunicator.DividendAccountsChanged(ctx, dividendAccount.User)
 	return nil
 }
 
@@ -183,6 +197,21 @@ func (k *Keeper) GetAllDividendAccounts(ctx sdk.Context) (dividendAccounts []hmT
 	return
 }
 
+// GetDividendAccountList returns dividend accounts of page ordered by user address
+func (k *Keeper) GetDividendAccountList(ctx sdk.Context, page uint64, limit uint64) (dividendAccounts []hmTypes.DividendAccount) {
+	// have max limit
+	if limit > 100 {
+		limit = 100
+	}
+
+	k.IterateDividendAccountsPaginatedAndApplyFn(ctx, page, limit, func(dividendAccount hmTypes.DividendAccount) error {
+		dividendAccounts = append(dividendAccounts, dividendAccount)
+		return nil
+	})
+
+	return
+}
+
 // AddFeeToDividendAccount adds fee to dividend account for withdrawal
 func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, userAddress hmTypes.HeimdallAddress, fee *big.Int) sdk.Error {
 	// Get or create dividend account
@@ -227,3 +256,26 @@ func (k *Keeper) IterateDividendAccountsByPrefixAndApplyFn(ctx sdk.Context, pref
 		}
 	}
 }
+
+// IterateDividendAccountsPaginatedAndApplyFn iterate dividendAccounts of page and apply the given function,
+// so callers stream accounts without loading all of them.
+func (k *Keeper) IterateDividendAccountsPaginatedAndApplyFn(ctx sdk.Context, page uint64, limit uint64, f func(dividendAccount hmTypes.DividendAccount) error) {
+	store := ctx.KVStore(k.key)
+
+	// get paginated iterator
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, DividendAccountMapKey, uint(page), uint(limit))
+	defer iterator.Close()
+
+	// loop through dividendAccounts
+	for ; iterator.Valid(); iterator.Next() {
+		// unmarshall dividendAccount
+		dividendAccount, err := hmTypes.UnMarshallDividendAccount(k.cdc, iterator.Value())
+		if err != nil {
+			continue
+		}
+		// call function and return if required
+		if err := f(dividendAccount); err != nil {
+			return
+		}
+	}
+}
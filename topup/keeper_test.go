@@ -82,11 +82,11 @@ func (suite *KeeperTestSuite) TestDividendAccountTree() {
 		)
 	}
 
-	accountRoot, err := checkpointTypes.GetAccountRootHash(divAccounts)
+	accountRoot, err := checkpointTypes.GetAccountRootHash(divAccounts, checkpointTypes.DefaultAccountHashStrategy)
 	require.NotNil(t, accountRoot)
 	require.NoError(t, err)
 
-	accountProof, _, err := checkpointTypes.GetAccountProof(divAccounts, hmTypes.HexToHeimdallAddress("1234"))
+	accountProof, _, err := checkpointTypes.GetAccountProof(divAccounts, hmTypes.HexToHeimdallAddress("1234"), checkpointTypes.DefaultAccountHashStrategy)
 	require.NotNil(t, accountProof)
 	require.NoError(t, err)
 
@@ -29,6 +29,10 @@ func NewQuerier(k Keeper, contractCaller helper.IContractCaller) sdk.Querier {
 			return handleQueryAccountProof(ctx, req, k, contractCaller)
 		case types.QueryVerifyAccountProof:
 			return handleQueryVerifyAccountProof(ctx, req, k)
+		case types.QueryDividendAccounts:
+			return handleQueryDividendAccounts(ctx, req, k)
+		case types.QueryAccountTreeProof:
+			return handleQueryAccountTreeProof(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown topup query endpoint")
 		}
@@ -43,7 +47,7 @@ func querySequence(ctx sdk.Context, req abci.RequestQuery, k Keeper, contractCal
 	}
 
 	// get main tx receipt
-	receipt, err := contractCallerObj.GetTronTransactionReceipt(hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
+	receipt, err := contractCallerObj.GetTronTransactionReceipt(ctx.Context(), hmTypes.HexToHeimdallHash(params.TxHash).TronHash().Hex())
 	if err != nil || receipt == nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("Transaction is not confirmed yet. Please wait for sometime and try again"))
 	}
@@ -90,7 +94,7 @@ func handleQueryDividendAccount(ctx sdk.Context, req abci.RequestQuery, keeper K
 func handleDividendAccountRoot(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// Calculate new account root hash
 	dividendAccounts := keeper.GetAllDividendAccounts(ctx)
-	accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts, checkpointTypes.GetAccountHashStrategy(hmTypes.RootChainTypeEth))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch accountroothash ", err.Error()))
 	}
@@ -112,17 +116,20 @@ func handleQueryAccountProof(ctx sdk.Context, req abci.RequestQuery, keeper Keep
 	stakingInfoAddress := chainParams.ChainParams.StakingInfoAddress.EthAddress()
 	stakingInfoInstance, _ := contractCallerObj.GetStakingInfoInstance(stakingInfoAddress, hmTypes.RootChainTypeEth)
 
-	accountRootOnChain, err := contractCallerObj.CurrentAccountStateRoot(stakingInfoInstance)
+	accountRootOnChain, err := contractCallerObj.CurrentAccountStateRoot(ctx.Context(), stakingInfoInstance)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch account root from onchain ", err.Error()))
 	}
 
+	// proof is built with same strategy as account root of staking info contract
+	hashStrategy := checkpointTypes.GetAccountHashStrategy(hmTypes.RootChainTypeEth)
+
 	dividendAccounts := keeper.GetAllDividendAccounts(ctx)
-	currentStateAccountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	currentStateAccountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts, hashStrategy)
 
 	if bytes.Equal(accountRootOnChain[:], currentStateAccountRoot) {
 		// Calculate new account root hash
-		merkleProof, index, err := checkpointTypes.GetAccountProof(dividendAccounts, params.UserAddress)
+		merkleProof, index, err := checkpointTypes.GetAccountProof(dividendAccounts, params.UserAddress, hashStrategy)
 		if err != nil {
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could fetch account proof", err.Error()))
 		}
@@ -150,7 +157,7 @@ func handleQueryVerifyAccountProof(ctx sdk.Context, req abci.RequestQuery, keepe
 	dividendAccounts := keeper.GetAllDividendAccounts(ctx)
 
 	// Verify account proof
-	accountProofStatus, err := checkpointTypes.VerifyAccountProof(dividendAccounts, params.UserAddress, params.AccountProof)
+	accountProofStatus, err := checkpointTypes.VerifyAccountProof(dividendAccounts, params.UserAddress, params.AccountProof, checkpointTypes.GetAccountHashStrategy(hmTypes.RootChainTypeEth))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not verify merkle proof ", err.Error()))
 	}
@@ -162,3 +169,45 @@ func handleQueryVerifyAccountProof(ctx sdk.Context, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+func handleQueryDividendAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params hmTypes.QueryPaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Page == 0 || params.Limit == 0 {
+		return nil, sdk.ErrUnknownRequest("page and limit must be greater than 0")
+	}
+
+	dividendAccounts := keeper.GetDividendAccountList(ctx, params.Page, params.Limit)
+
+	bz, err := json.Marshal(dividendAccounts)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAccountTreeProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountTreeProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.RootChain == "" {
+		params.RootChain = hmTypes.RootChainTypeEth
+	}
+
+	// proof is read from persisted account tree instead of tree over all dividend accounts
+	proof, index, err := keeper.moduleCommunicator.GetAccountTreeProof(ctx, params.RootChain, params.UserAddress)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("could not fetch account proof", err.Error()))
+	}
+
+	bz, err := json.Marshal(hmTypes.NewDividendAccountProof(params.UserAddress, proof, index))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -88,7 +88,7 @@ func (suite *QuerierTestSuite) TestQuerySequence() {
 	app.TopupKeeper.SetTopupSequence(ctx, sequence.String())
 
 	// mock external calls
-	suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+	suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 
 	path := []string{types.QuerySequence}
 	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySequence)
@@ -164,12 +164,12 @@ func (suite *QuerierTestSuite) TestHandleQueryAccountProof() {
 	app.TopupKeeper.AddDividendAccount(ctx, dividendAccount)
 	dividendAccounts := app.TopupKeeper.GetAllDividendAccounts(ctx)
 
-	accRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	accRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts, checkpointTypes.DefaultAccountHashStrategy)
 	copy(accountRoot[:], accRoot)
 
 	// mock contracts
 	suite.contractCaller.On("GetStakingInfoInstance", mock.Anything, hmTypes.RootChainTypeEth).Return(stakingInfo, nil)
-	suite.contractCaller.On("CurrentAccountStateRoot", stakingInfo).Return(accountRoot, nil)
+	suite.contractCaller.On("CurrentAccountStateRoot", mock.Anything, stakingInfo).Return(accountRoot, nil)
 
 	req := abci.RequestQuery{
 		Path: route,
@@ -205,3 +205,77 @@ func (suite *QuerierTestSuite) TestHandleQueryVerifyAccountProof() {
 	require.NotNil(t, res)
 	require.Equal(t, "true", string(res))
 }
+
+func (suite *QuerierTestSuite) TestHandleQueryDividendAccounts() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	for i := 5; i > 0; i-- {
+		dividendAccount := hmTypes.NewDividendAccount(hmTypes.BytesToHeimdallAddress([]byte{byte(i)}), big.NewInt(int64(i)).String())
+		require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, dividendAccount))
+	}
+
+	path := []string{types.QueryDividendAccounts}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDividendAccounts)
+
+	// pages are ordered by user address
+	var dividendAccounts []hmTypes.DividendAccount
+	for page := uint64(1); page <= 3; page++ {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(hmTypes.NewQueryPaginationParams(page, 2, "")),
+		}
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+
+		var pageAccounts []hmTypes.DividendAccount
+		require.NoError(t, json.Unmarshal(res, &pageAccounts))
+		dividendAccounts = append(dividendAccounts, pageAccounts...)
+	}
+	require.Equal(t, app.TopupKeeper.GetAllDividendAccounts(ctx), dividendAccounts)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(hmTypes.NewQueryPaginationParams(0, 2, "")),
+	}
+	_, err := querier(ctx, path, req)
+	require.NotNil(t, err)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryAccountTreeProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	for i := 1; i <= 5; i++ {
+		dividendAccount := hmTypes.NewDividendAccount(hmTypes.BytesToHeimdallAddress([]byte{byte(i)}), big.NewInt(int64(i)).String())
+		require.NoError(t, app.TopupKeeper.AddDividendAccount(ctx, dividendAccount))
+	}
+	dividendAccounts := app.TopupKeeper.GetAllDividendAccounts(ctx)
+
+	path := []string{types.QueryAccountTreeProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountTreeProof)
+
+	// proof from account tree matches proof from tree over all accounts
+	for _, dividendAccount := range dividendAccounts {
+		req := abci.RequestQuery{
+			Path: route,
+			Data: app.Codec().MustMarshalJSON(types.NewQueryAccountTreeProofParams(dividendAccount.User, hmTypes.RootChainTypeEth)),
+		}
+		res, err := querier(ctx, path, req)
+		require.NoError(t, err)
+
+		var accountProof hmTypes.DividendAccountProof
+		require.NoError(t, json.Unmarshal(res, &accountProof))
+
+		expected, index, proofErr := checkpointTypes.GetAccountProof(dividendAccounts, dividendAccount.User, checkpointTypes.GetAccountHashStrategy(hmTypes.RootChainTypeEth))
+		require.NoError(t, proofErr)
+		require.Equal(t, index, accountProof.Index)
+		require.Equal(t, hmTypes.HexBytes(expected), accountProof.Proof)
+	}
+
+	// unknown account has no proof
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryAccountTreeProofParams(hmTypes.BytesToHeimdallAddress([]byte{9}), hmTypes.RootChainTypeEth)),
+	}
+	_, err := querier(ctx, path, req)
+	require.NotNil(t, err)
+}
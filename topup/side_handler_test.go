@@ -113,7 +113,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
 
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
 
 		// execute handler
@@ -146,7 +146,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			blockNumber,
 		)
 
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(nil, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(nil, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), nil, logIndex).Return(nil, nil)
 
 		// execute handler
@@ -179,7 +179,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			blockNumber,
 		)
 
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(nil, nil)
 
 		// execute handler
@@ -217,7 +217,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			User: ethCommon.BytesToAddress(addr1.Bytes()),
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
 
 		// execute handler
@@ -255,7 +255,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			User: ethCommon.BytesToAddress(addr2.Bytes()),
 			Fee:  coins.AmountOf(authTypes.FeeToken).BigInt(),
 		}
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
 
 		// execute handler
@@ -293,7 +293,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgTopup() {
 			User: ethCommon.BytesToAddress(addr1.Bytes()),
 			Fee:  big.NewInt(1), // different fee
 		}
-		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything).Return(txReceipt, nil)
+		suite.contractCaller.On("GetTronTransactionReceipt", mock.Anything, mock.Anything).Return(txReceipt, nil)
 		suite.contractCaller.On("DecodeValidatorTopupFeesEvent", chainParams.ChainParams.StateSenderAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
 
 		// execute handler
@@ -8,6 +8,8 @@ const (
 	QueryDividendAccountRoot = "dividend-account-root"
 	QueryAccountProof        = "dividend-account-proof"
 	QueryVerifyAccountProof  = "verify-account-proof"
+	QueryDividendAccounts    = "dividend-accounts"
+	QueryAccountTreeProof    = "dividend-account-tree-proof"
 )
 
 // QuerySequenceParams defines the params for querying an account Sequence.
@@ -51,3 +53,14 @@ type QueryVerifyAccountProofParams struct {
 func NewQueryVerifyAccountProofParams(userAddress types.HeimdallAddress, accountProof string) QueryVerifyAccountProofParams {
 	return QueryVerifyAccountProofParams{UserAddress: userAddress, AccountProof: accountProof}
 }
+
+// QueryAccountTreeProofParams defines the params for querying account proof from account tree of root chain.
+type QueryAccountTreeProofParams struct {
+	UserAddress types.HeimdallAddress `json:"user_addr"`
+	RootChain   string                `json:"root_chain"`
+}
+
+// NewQueryAccountTreeProofParams creates a new instance of QueryAccountTreeProofParams.
+func NewQueryAccountTreeProofParams(userAddress types.HeimdallAddress, rootChain string) QueryAccountTreeProofParams {
+	return QueryAccountTreeProofParams{UserAddress: userAddress, RootChain: rootChain}
+}
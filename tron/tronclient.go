@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -21,19 +20,20 @@ type Client struct {
 }
 
 // NewClient creates a client that uses the given RPC client.
-func NewClient(url string) *Client {
+func NewClient(url string) (*Client, error) {
 	conn, err := grpc.Dial(url, grpc.WithInsecure())
 	if err != nil {
-		os.Exit(0)
+		return nil, fmt.Errorf("unable to dial tron grpc %s: %w", url, err)
 	}
 	rootchainABI, err := getABI(rootchain.RootchainABI)
 	if err != nil {
-		os.Exit(0)
+		conn.Close()
+		return nil, fmt.Errorf("unable to parse rootchain abi: %w", err)
 	}
 	return &Client{
 		client:       pb.NewWalletClient(conn),
 		rootchainABI: rootchainABI,
-	}
+	}, nil
 }
 
 //
@@ -43,8 +43,8 @@ func getABI(data string) (abi.ABI, error) {
 	return abi.JSON(strings.NewReader(data))
 }
 
-func (tc *Client) TriggerContract(ownerAddress, contractAddress string, data []byte) (*pb.Transaction, error) {
-	response, err := tc.client.TriggerContract(context.Background(),
+func (tc *Client) TriggerContract(ctx context.Context, ownerAddress, contractAddress string, data []byte) (*pb.Transaction, error) {
+	response, err := tc.client.TriggerContract(ctx,
 		&pb.TriggerSmartContract{
 			OwnerAddress:    common.FromHex("41" + ownerAddress),
 			ContractAddress: common.FromHex(contractAddress),
@@ -62,8 +62,8 @@ func (tc *Client) TriggerContract(ownerAddress, contractAddress string, data []b
 	return response.Transaction, nil
 }
 
-func (tc *Client) TriggerConstantContract(contractAddress string, data []byte) ([]byte, error) {
-	response, err := tc.client.TriggerConstantContract(context.Background(),
+func (tc *Client) TriggerConstantContract(ctx context.Context, contractAddress string, data []byte) ([]byte, error) {
+	response, err := tc.client.TriggerConstantContract(ctx,
 		&pb.TriggerSmartContract{
 			OwnerAddress:    nil,
 			ContractAddress: common.FromHex(contractAddress),
@@ -92,7 +92,7 @@ func (tc *Client) GetNowBlock(ctx context.Context) (int64, error) {
 // CurrentHeaderBlock is a free data retrieval call binding the contract method 0xec7e4855.
 //
 // Solidity: function currentHeaderBlock() view returns(uint256)
-func (tc *Client) CurrentHeaderBlock(contractAddress string, childBlockInterval uint64) (uint64, error) {
+func (tc *Client) CurrentHeaderBlock(ctx context.Context, contractAddress string, childBlockInterval uint64) (uint64, error) {
 	// Pack the input
 	btsPack, err := tc.rootchainABI.Pack("currentHeaderBlock")
 	if err != nil {
@@ -100,7 +100,7 @@ func (tc *Client) CurrentHeaderBlock(contractAddress string, childBlockInterval
 	}
 
 	// Call
-	data, err := tc.TriggerConstantContract(contractAddress, btsPack)
+	data, err := tc.TriggerConstantContract(ctx, contractAddress, btsPack)
 	if err != nil {
 		return 0, err
 	}
@@ -119,13 +119,13 @@ func (tc *Client) CurrentHeaderBlock(contractAddress string, childBlockInterval
 // GetLastChildBlock is a free data retrieval call binding the contract method 0xb87e1b66.
 //
 // Solidity: function getLastChildBlock() view returns(uint256)
-func (tc *Client) GetLastChildBlock(contractAddress string) (uint64, error) {
+func (tc *Client) GetLastChildBlock(ctx context.Context, contractAddress string) (uint64, error) {
 	// Pack the input
 	btsPack, err := tc.rootchainABI.Pack("getLastChildBlock")
 	if err != nil {
 		return 0, err
 	}
-	data, err := tc.TriggerConstantContract(contractAddress, btsPack)
+	data, err := tc.TriggerConstantContract(ctx, contractAddress, btsPack)
 	if err != nil {
 		return 0, err
 	}
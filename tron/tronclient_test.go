@@ -0,0 +1,17 @@
+package tron
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	// grpc dials lazily, client is created without a reachable node
+	client, err := NewClient("localhost:50051")
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	_, ok := client.rootchainABI.Methods["submitCheckpoint"]
+	require.True(t, ok)
+}
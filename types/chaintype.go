@@ -6,15 +6,27 @@ const (
 	RootChainTypeBsc  = "bsc"
 
 	RootChainTypeStake = RootChainTypeTron
+
+	// RootChainTypeTest is a root chain without contracts, used in tests and local devnets.
+	// It is not part of chain id map, so it is never iterated with real root chains.
+	RootChainTypeTest = "test"
 )
 
+const testRootChainID byte = 0xff
+
 var chainIDMap = map[string]byte{RootChainTypeTron: 1, RootChainTypeEth: 2, RootChainTypeBsc: 3}
 
 func GetRootChainID(rootChain string) byte {
+	if rootChain == RootChainTypeTest {
+		return testRootChainID
+	}
 	return chainIDMap[rootChain]
 }
 
 func GetRootChainName(rootChainID uint64) string {
+	if rootChainID == uint64(testRootChainID) {
+		return RootChainTypeTest
+	}
 	for chainName, chainID := range chainIDMap {
 		if uint64(chainID) == rootChainID {
 			return chainName
@@ -0,0 +1,26 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker applies scheduled upgrade plan once chain reaches its height. It must run before
+// begin blockers of other modules, so they see state migrated by upgrade handler in upgrade block.
+// Binary without handler of plan halts, operators must switch to binary having it.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, ok := k.GetUpgradePlan(ctx)
+	if !ok || !plan.ShouldExecute(ctx) {
+		return
+	}
+
+	if !k.HasHandler(plan.Name) {
+		upgradeMsg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		k.Logger(ctx).Error(upgradeMsg)
+		panic(upgradeMsg)
+	}
+
+	k.Logger(ctx).Info("Applying upgrade", "name", plan.Name, "height", ctx.BlockHeight())
+	k.ApplyUpgrade(ctx, plan)
+}
@@ -0,0 +1,6 @@
+package cli
+
+const (
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "upgrade-info"
+)
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+	return queryCmd
+}
+
+// GetCmdQueryPlan implements the scheduled upgrade plan query command.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Show scheduled upgrade plan",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent), nil)
+			if err != nil {
+				return err
+			}
+
+			var plan *types.Plan
+			if err := json.Unmarshal(res, &plan); err != nil {
+				return err
+			}
+			if plan == nil {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			return cliCtx.PrintOutput(*plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the query command of height upgrade was applied at.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [name]",
+		Short: "Show height upgrade was applied at",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, err := cliCtx.Codec.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied), params)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			if err := json.Unmarshal(res, &height); err != nil {
+				return err
+			}
+			if height == 0 {
+				return fmt.Errorf("upgrade %s was not applied", args[0])
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
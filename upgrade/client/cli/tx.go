@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	govCli "github.com/maticnetwork/heimdall/gov/client/cli"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+var logger = helper.Logger.With("module", "upgrade/client/cli")
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software
+// upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+Once passed, upgrade is applied at upgrade height by binaries registering handler
+with its name. Binaries without it halt at upgrade height. Proposal replaces upgrade
+scheduled before.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade checkpoint --upgrade-height=1000000 --upgrade-info="v1.2.0" --title="Checkpoint upgrade" --description="Enable checkpoint upgrade" --deposit="1000000000000000000btt" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), plan)
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "Height upgrade is applied at")
+	cmd.Flags().String(FlagUpgradeInfo, "", "Binaries or other details of upgrade, for operators")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a cancel
+// software upgrade proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal canceling scheduled software upgrade",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content := types.NewCancelSoftwareUpgradeProposal(viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription))
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal broadcasts proposal with content and deposit set in flags
+func submitProposal(cliCtx context.CLIContext, content govTypes.Content) error {
+	deposit, err := sdk.ParseCoins(viper.GetString(govCli.FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	validatorID := viper.GetUint64(govCli.FlagValidatorID)
+	if validatorID == 0 {
+		return fmt.Errorf("Valid validator ID required")
+	}
+
+	from := helper.GetFromAddress(cliCtx)
+
+	msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+}
+
+// addProposalFlags adds flags of proposal title, description, deposit and proposer validator
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govCli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "Deposit of proposal")
+	cmd.Flags().Int(govCli.FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(govCli.FlagValidatorID); err != nil {
+		logger.Error("addProposalFlags | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+}
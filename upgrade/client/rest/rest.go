@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type (
+	// SoftwareUpgradeProposalReq defines a software upgrade proposal request body.
+	SoftwareUpgradeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Plan        types.Plan              `json:"plan" yaml:"plan"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+
+	// CancelSoftwareUpgradeProposalReq defines a cancel software upgrade proposal request body.
+	CancelSoftwareUpgradeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+)
+
+// RegisterRoutes registers the upgrade module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/upgrade/current", planHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/upgrade/applied/{name}", appliedHandlerFn(cliCtx)).Methods("GET")
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the software
+// upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "upgrade",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel
+// software upgrade REST handler with a given sub-route.
+func CancelProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "cancel_upgrade",
+		Handler:  postCancelProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SoftwareUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, req.Plan)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelSoftwareUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package upgrade coordinates hard forks by height.
+
+A software upgrade proposal schedules a Plan with a name and a height. Binaries
+register a Handler under that name with Keeper.SetUpgradeHandler. At plan height,
+BeginBlocker runs the handler, which migrates stores, and records the upgrade as
+applied. A binary without the handler halts at plan height, so operators must
+switch to a binary that has it. A scheduled plan can be replaced by a new
+proposal or canceled by a cancel software upgrade proposal until it is applied.
+
+Code changing consensus rules checks Keeper.IsApplied instead of comparing block
+height against hard-coded fork heights.
+*/
+package upgrade
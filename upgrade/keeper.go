@@ -0,0 +1,120 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// Handler migrates state when upgrade plan is applied
+type Handler func(ctx sdk.Context, plan types.Plan)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	cdc             *codec.Codec
+	storeKey        sdk.StoreKey
+	codespace       sdk.CodespaceType
+	upgradeHandlers map[string]Handler
+}
+
+// NewKeeper constructs an upgrade keeper, upgrade state is kept in store of storeKey under its own prefixes
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		codespace:       codespace,
+		upgradeHandlers: make(map[string]Handler),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetUpgradeHandler registers handler applying upgrade plan with name
+func (k Keeper) SetUpgradeHandler(name string, handler Handler) {
+	if _, ok := k.upgradeHandlers[name]; ok {
+		panic(fmt.Sprintf("upgrade handler %s already registered", name))
+	}
+	k.upgradeHandlers[name] = handler
+}
+
+// HasHandler returns true if handler applying upgrade plan with name is registered
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules upgrade plan, replacing plan scheduled before
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(k.codespace, fmt.Sprintf("upgrade height %d must be greater than current height %d", plan.Height, ctx.BlockHeight()))
+	}
+
+	if height, ok := k.GetDoneHeight(ctx, plan.Name); ok {
+		return types.ErrUpgradeApplied(k.codespace, plan.Name, height)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+	return nil
+}
+
+// GetUpgradePlan returns scheduled upgrade plan, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan removes scheduled upgrade plan
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+// GetDoneHeight returns height upgrade with name was applied at and if it was applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DoneKey(name))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// IsApplied returns true once upgrade with name was applied
+func (k Keeper) IsApplied(ctx sdk.Context, name string) bool {
+	_, ok := k.GetDoneHeight(ctx, name)
+	return ok
+}
+
+// ApplyUpgrade runs handler of upgrade plan, records it applied at current height and clears plan
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("no handler registered for upgrade %s", plan.Name))
+	}
+
+	handler(ctx, plan)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DoneKey(plan.Name), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	k.ClearUpgradePlan(ctx)
+}
@@ -0,0 +1,107 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type testInput struct {
+	ctx    sdk.Context
+	keeper upgrade.Keeper
+}
+
+func newTestInput(t *testing.T) testInput {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+
+	keyGov := sdk.NewKVStoreKey("gov")
+	cms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	keeper := upgrade.NewKeeper(cdc, keyGov, types.DefaultCodespace)
+
+	return testInput{ctx, keeper}
+}
+
+func TestScheduleUpgrade(t *testing.T) {
+	input := newTestInput(t)
+
+	require.Error(t, input.keeper.ScheduleUpgrade(input.ctx, types.NewPlan("", 20, "")))
+	require.Error(t, input.keeper.ScheduleUpgrade(input.ctx, types.NewPlan("test", 10, "")))
+
+	plan := types.NewPlan("test", 20, "info")
+	require.NoError(t, input.keeper.ScheduleUpgrade(input.ctx, plan))
+
+	stored, ok := input.keeper.GetUpgradePlan(input.ctx)
+	require.True(t, ok)
+	require.Equal(t, plan, stored)
+}
+
+func TestBeginBlocker(t *testing.T) {
+	input := newTestInput(t)
+
+	var appliedAt int64
+	input.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, _ types.Plan) {
+		appliedAt = ctx.BlockHeight()
+	})
+	require.NoError(t, input.keeper.ScheduleUpgrade(input.ctx, types.NewPlan("test", 20, "")))
+
+	// nothing happens before upgrade height
+	upgrade.BeginBlocker(input.ctx.WithBlockHeight(19), input.keeper)
+	require.Zero(t, appliedAt)
+	require.False(t, input.keeper.IsApplied(input.ctx, "test"))
+
+	upgrade.BeginBlocker(input.ctx.WithBlockHeight(20), input.keeper)
+	require.Equal(t, int64(20), appliedAt)
+
+	height, ok := input.keeper.GetDoneHeight(input.ctx, "test")
+	require.True(t, ok)
+	require.Equal(t, int64(20), height)
+
+	_, ok = input.keeper.GetUpgradePlan(input.ctx)
+	require.False(t, ok)
+
+	// applied upgrade cannot be scheduled again
+	require.Error(t, input.keeper.ScheduleUpgrade(input.ctx.WithBlockHeight(21), types.NewPlan("test", 30, "")))
+}
+
+func TestBeginBlockerWithoutHandler(t *testing.T) {
+	input := newTestInput(t)
+
+	require.NoError(t, input.keeper.ScheduleUpgrade(input.ctx, types.NewPlan("unknown", 20, "")))
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(input.ctx.WithBlockHeight(20), input.keeper)
+	})
+	require.False(t, input.keeper.IsApplied(input.ctx, "unknown"))
+}
+
+func TestProposalHandler(t *testing.T) {
+	input := newTestInput(t)
+	handler := upgrade.NewSoftwareUpgradeProposalHandler(input.keeper)
+
+	plan := types.NewPlan("test", 20, "")
+	require.NoError(t, handler(input.ctx, types.NewSoftwareUpgradeProposal("Test", "description", plan)))
+
+	stored, ok := input.keeper.GetUpgradePlan(input.ctx)
+	require.True(t, ok)
+	require.Equal(t, plan, stored)
+
+	require.NoError(t, handler(input.ctx, types.NewCancelSoftwareUpgradeProposal("Test", "description")))
+
+	_, ok = input.keeper.GetUpgradePlan(input.ctx)
+	require.False(t, ok)
+}
@@ -0,0 +1,56 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+	upgradeCli "github.com/maticnetwork/heimdall/upgrade/client/cli"
+	upgradeRest "github.com/maticnetwork/heimdall/upgrade/client/rest"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+var (
+	_ module.AppModuleBasic        = AppModuleBasic{}
+	_ hmModule.HeimdallModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic app module basics object. Upgrade has no genesis state, plans are
+// scheduled by governance and applied in app begin blocker.
+type AppModuleBasic struct{}
+
+// Name module name
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec register module codec
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) { types.RegisterCodec(cdc) }
+
+// DefaultGenesis default genesis state
+func (AppModuleBasic) DefaultGenesis() json.RawMessage { return nil }
+
+// ValidateGenesis module validate genesis
+func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error { return nil }
+
+// VerifyGenesis module
+func (AppModuleBasic) VerifyGenesis(bz map[string]json.RawMessage) error { return nil }
+
+// RegisterRESTRoutes register rest routes
+func (AppModuleBasic) RegisterRESTRoutes(cliCtx context.CLIContext, rtr *mux.Router) {
+	upgradeRest.RegisterRoutes(cliCtx, rtr)
+}
+
+// GetTxCmd get the root tx command of this module
+func (AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command { return nil }
+
+// GetQueryCmd get the root query command of this module
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return upgradeCli.GetQueryCmd(cdc)
+}
@@ -0,0 +1,41 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewSoftwareUpgradeProposalHandler new software upgrade proposal handler
+func NewSoftwareUpgradeProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.SoftwareUpgradeProposal) sdk.Error {
+	k.Logger(ctx).Info("Scheduling upgrade", "name", p.Plan.Name, "height", p.Plan.Height)
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, _ types.CancelSoftwareUpgradeProposal) sdk.Error {
+	if plan, ok := k.GetUpgradePlan(ctx); ok {
+		k.Logger(ctx).Info("Canceling upgrade", "name", plan.Name, "height", plan.Height)
+	}
+
+	k.ClearUpgradePlan(ctx)
+	return nil
+}
@@ -0,0 +1,53 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// NewQuerier creates a querier for upgrade REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryCurrent:
+			return queryCurrent(ctx, keeper)
+		case types.QueryApplied:
+			return queryApplied(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+// queryCurrent returns scheduled upgrade plan, null if there is none
+func queryCurrent(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	var result *types.Plan
+	if plan, ok := keeper.GetUpgradePlan(ctx); ok {
+		result = &plan
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// queryApplied returns height upgrade was applied at, 0 if it was not applied
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppliedParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	height, _ := keeper.GetDoneHeight(ctx, params.Name)
+	bz, err := json.Marshal(height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "upgrade"
+
+	CodeInvalidPlan    sdk.CodeType = 1
+	CodeUpgradeApplied sdk.CodeType = 2
+)
+
+// ErrInvalidPlan returns an error for invalid upgrade plan
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, msg)
+}
+
+// ErrUpgradeApplied returns an error for upgrade which was applied already
+func ErrUpgradeApplied(codespace sdk.CodespaceType, name string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeApplied, "upgrade %s was applied at height %d", name, height)
+}
@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// RouterKey is the proposal route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+// Keys for upgrade state. It is kept in gov store, as mounting a new store would change
+// app hash of running chains.
+//
+// - 0x30: Plan
+//
+// - 0x31<name_Bytes>: height upgrade was applied at
+var (
+	PlanKey       = []byte{0x30}
+	DoneKeyPrefix = []byte{0x31}
+)
+
+// DoneKey returns key of height upgrade with name was applied at
+func DoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies upgrade applied at height by handler registered under its name
+type Plan struct {
+	Name   string `json:"name" yaml:"name"`     // name of upgrade, binary applying plan must register handler with it
+	Height int64  `json:"height" yaml:"height"` // height upgrade is applied at, in begin block
+	Info   string `json:"info" yaml:"info"`     // binaries or other details of upgrade, for operators
+}
+
+// NewPlan creates new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic validates upgrade plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "upgrade name cannot be blank")
+	}
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "upgrade height must be greater than 0")
+	}
+	return nil
+}
+
+// ShouldExecute returns true once chain reached upgrade height
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && ctx.BlockHeight() >= p.Height
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s
+`, p.Name, p.Height, p.Info)
+}
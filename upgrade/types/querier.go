@@ -0,0 +1,17 @@
+package types
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// QueryAppliedParams defines the params for querying height upgrade was applied at.
+type QueryAppliedParams struct {
+	Name string
+}
+
+// NewQueryAppliedParams creates a new instance of QueryAppliedParams.
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}